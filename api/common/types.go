@@ -102,6 +102,11 @@ type Status struct {
 
 	// +listType=atomic
 	Conditions []Condition `json:"conditions,omitempty"`
+
+	// The manifests overlays selected for the resource, in rendering order.
+	// +optional
+	// +listType=atomic
+	AppliedOverlays []string `json:"appliedOverlays,omitempty"`
 }
 
 func (s *Status) GetConditions() []Condition {
@@ -130,6 +135,103 @@ type ComponentReleaseStatus struct {
 	// +listType=map
 	// +listMapKey=name
 	Releases []ComponentRelease `yaml:"releases,omitempty" json:"releases,omitempty"`
+
+	// Images running in the Deployments of the component, reported when the component tracks
+	// its running images.
+	// +listType=map
+	// +listMapKey=deployment
+	// +listMapKey=container
+	// +optional
+	Images []ComponentImage `yaml:"-" json:"images,omitempty"`
+}
+
+// ComponentImage is the image of a container of a Deployment of a component, as declared in the
+// rendered manifests and as running in the cluster.
+// +kubebuilder:object:generate=true
+type ComponentImage struct {
+	// Deployment running the image.
+	// +required
+	// +kubebuilder:validation:Required
+	Deployment string `json:"deployment"`
+
+	// Container running the image.
+	// +required
+	// +kubebuilder:validation:Required
+	Container string `json:"container"`
+
+	// Image declared in the rendered manifests.
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Digests of the image running in the pods of the Deployment, more than one while a rollout
+	// is in progress or when a tag has been overwritten.
+	// +optional
+	Digests []string `json:"digests,omitempty"`
+
+	// Revision is the upstream git commit of the running image, read from its
+	// org.opencontainers.image.revision label, or vcs-ref label, when present.
+	// +optional
+	Revision string `json:"revision,omitempty"`
+
+	// Mismatch describes how the running image differs from the declared one, if it does.
+	// +optional
+	Mismatch string `json:"mismatch,omitempty"`
+}
+
+// ComponentStatusSummary is a compact, machine-readable summary of the status of a component.
+// +kubebuilder:object:generate=true
+type ComponentStatusSummary struct {
+	// Name of the component.
+	// +required
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// ManagementState of the component in the DataScienceCluster.
+	// +optional
+	ManagementState operatorv1.ManagementState `json:"managementState,omitempty"`
+
+	// Phase of the component resource.
+	// +optional
+	Phase string `json:"phase,omitempty"`
+
+	// Ready is the status of the Ready condition of the component resource.
+	// +optional
+	Ready metav1.ConditionStatus `json:"ready,omitempty"`
+
+	// FailingCondition is the top failing condition of the component resource, False conditions
+	// are reported before Unknown ones and the most recent transition first.
+	// +optional
+	FailingCondition *ConditionSummary `json:"failingCondition,omitempty"`
+
+	// GenerationLag is the number of generations of the component resource not yet observed by
+	// its controller.
+	// +optional
+	GenerationLag int64 `json:"generationLag,omitempty"`
+
+	// LastTransitionTime is the last transition time of the Ready condition of the component resource.
+	// +optional
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+
+	// Releases deployed by the component.
+	// +optional
+	Releases []ComponentRelease `json:"releases,omitempty"`
+
+	// Images running in the Deployments of the component.
+	// +optional
+	Images []ComponentImage `json:"images,omitempty"`
+}
+
+// ConditionSummary identifies a condition and the reason of its status.
+// +kubebuilder:object:generate=true
+type ConditionSummary struct {
+	// Type of the condition.
+	Type string `json:"type"`
+	// Reason of the condition status.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// Message of the condition status.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 type WithStatus interface {
@@ -146,6 +248,11 @@ type WithReleases interface {
 	SetReleaseStatus(status []ComponentRelease)
 }
 
+type WithImages interface {
+	GetImageStatus() *[]ComponentImage
+	SetImageStatus(status []ComponentImage)
+}
+
 type PlatformObject interface {
 	client.Object
 	WithStatus
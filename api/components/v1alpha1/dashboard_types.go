@@ -34,8 +34,13 @@ var _ common.PlatformObject = (*Dashboard)(nil)
 
 // DashboardCommonSpec spec defines the shared desired state of Dashboard
 type DashboardCommonSpec struct {
-	// dashboard spec exposed to DSC api
-	// dashboard spec exposed only to internal api
+	// Gateway selects the gateway declared in the GatewayConfig additionalGateways that the
+	// dashboard HTTPRoutes attach to. When empty, the default data-science-gateway is used.
+	// The GatewayAvailable condition is False when the gateway is not declared.
+	// +optional
+	// +kubebuilder:validation:MaxLength=32
+	// +kubebuilder:validation:Pattern=`^([a-z0-9]([-a-z0-9]*[a-z0-9])?)?$`
+	Gateway string `json:"gateway,omitempty"`
 }
 
 // DashboardSpec defines the desired state of Dashboard
@@ -106,6 +106,14 @@ func (c *DataSciencePipelines) SetReleaseStatus(releases []common.ComponentRelea
 	c.Status.Releases = releases
 }
 
+func (c *DataSciencePipelines) GetImageStatus() *[]common.ComponentImage {
+	return &c.Status.Images
+}
+
+func (c *DataSciencePipelines) SetImageStatus(images []common.ComponentImage) {
+	c.Status.Images = images
+}
+
 // +kubebuilder:object:root=true
 
 // DataSciencePipelinesList contains a list of DataSciencePipelines
@@ -123,3 +123,11 @@ func (f *FeastOperator) GetReleaseStatus() *[]common.ComponentRelease {
 func (f *FeastOperator) SetReleaseStatus(releases []common.ComponentRelease) {
 	f.Status.Releases = releases
 }
+
+func (f *FeastOperator) GetImageStatus() *[]common.ComponentImage {
+	return &f.Status.Images
+}
+
+func (f *FeastOperator) SetImageStatus(images []common.ComponentImage) {
+	f.Status.Images = images
+}
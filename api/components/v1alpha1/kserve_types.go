@@ -53,6 +53,14 @@ type KserveCommonSpec struct {
 	NIM NimSpec `json:"nim,omitempty"`
 	// Configures and enables Models as a Service integration
 	ModelsAsService DSCModelsAsServiceSpec `json:"modelsAsService,omitempty"`
+
+	// Gateway selects the gateway declared in the GatewayConfig additionalGateways used as
+	// KServe ingress gateway. When empty, the gateway shipped with the KServe manifests is used.
+	// The GatewayAvailable condition is False when the gateway is not declared.
+	// +optional
+	// +kubebuilder:validation:MaxLength=32
+	// +kubebuilder:validation:Pattern=`^([a-z0-9]([-a-z0-9]*[a-z0-9])?)?$`
+	Gateway string `json:"gateway,omitempty"`
 }
 
 // nimSpec enables NVIDIA NIM integration
@@ -118,6 +126,14 @@ func (c *Kserve) SetReleaseStatus(releases []common.ComponentRelease) {
 	c.Status.Releases = releases
 }
 
+func (c *Kserve) GetImageStatus() *[]common.ComponentImage {
+	return &c.Status.Images
+}
+
+func (c *Kserve) SetImageStatus(images []common.ComponentImage) {
+	c.Status.Images = images
+}
+
 // +kubebuilder:object:root=true
 
 // KserveList contains a list of Kserve
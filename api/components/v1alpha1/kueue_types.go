@@ -100,6 +100,12 @@ func (c *Kueue) SetReleaseStatus(releases []common.ComponentRelease) {
 	c.Status.Releases = releases
 }
 
+func (c *Kueue) GetImageStatus() *[]common.ComponentImage { return &c.Status.Images }
+
+func (c *Kueue) SetImageStatus(images []common.ComponentImage) {
+	c.Status.Images = images
+}
+
 // KueueManagementSpec struct defines the component's management configuration.
 // +kubebuilder:object:generate=true
 type KueueManagementSpec struct {
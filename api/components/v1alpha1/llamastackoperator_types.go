@@ -78,6 +78,14 @@ func (c *LlamaStackOperator) SetReleaseStatus(releases []common.ComponentRelease
 	c.Status.Releases = releases
 }
 
+func (c *LlamaStackOperator) GetImageStatus() *[]common.ComponentImage {
+	return &c.Status.Images
+}
+
+func (c *LlamaStackOperator) SetImageStatus(images []common.ComponentImage) {
+	c.Status.Images = images
+}
+
 // +kubebuilder:object:root=true
 
 // LlamaStackOperatorList contains a list of LlamaStackOperator
@@ -66,6 +66,14 @@ func (c *MLflowOperator) SetReleaseStatus(releases []common.ComponentRelease) {
 	c.Status.Releases = releases
 }
 
+func (c *MLflowOperator) GetImageStatus() *[]common.ComponentImage {
+	return &c.Status.Images
+}
+
+func (c *MLflowOperator) SetImageStatus(images []common.ComponentImage) {
+	c.Status.Images = images
+}
+
 // +kubebuilder:object:root=true
 
 // MLflowOperatorList contains a list of MLflowOperator
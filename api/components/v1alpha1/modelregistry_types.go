@@ -87,6 +87,14 @@ func (c *ModelRegistry) SetReleaseStatus(releases []common.ComponentRelease) {
 	c.Status.Releases = releases
 }
 
+func (c *ModelRegistry) GetImageStatus() *[]common.ComponentImage {
+	return &c.Status.Images
+}
+
+func (c *ModelRegistry) SetImageStatus(images []common.ComponentImage) {
+	c.Status.Images = images
+}
+
 // +kubebuilder:object:root=true
 
 // ModelRegistryList contains a list of ModelRegistry
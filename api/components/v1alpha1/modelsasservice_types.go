@@ -58,6 +58,8 @@ type ModelsAsServiceSpec struct {
 
 // GatewaySpec defines the reference to the global Gateway (Gw API) where
 // models should be published to when exposed as services.
+// Gateways declared in the GatewayConfig additionalGateways live in the openshift-ingress
+// namespace and are named data-science-gateway-<name>.
 type GatewaySpec struct {
 	// Namespace is the namespace name where the gateway.networking.k8s.io/v1/Gateway resource is.
 	Namespace string `json:"namespace,omitempty"`
@@ -68,7 +70,8 @@ type GatewaySpec struct {
 
 // ModelsAsServiceStatus defines the observed state of ModelsAsService
 type ModelsAsServiceStatus struct {
-	common.Status `json:",inline"`
+	common.Status                 `json:",inline"`
+	common.ComponentReleaseStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -95,6 +98,18 @@ func (c *ModelsAsService) SetConditions(conditions []common.Condition) {
 	c.Status.SetConditions(conditions)
 }
 
+func (c *ModelsAsService) GetReleaseStatus() *[]common.ComponentRelease { return &c.Status.Releases }
+
+func (c *ModelsAsService) SetReleaseStatus(releases []common.ComponentRelease) {
+	c.Status.Releases = releases
+}
+
+func (c *ModelsAsService) GetImageStatus() *[]common.ComponentImage { return &c.Status.Images }
+
+func (c *ModelsAsService) SetImageStatus(images []common.ComponentImage) {
+	c.Status.Images = images
+}
+
 // DSCModelsAsServiceSpec enables ModelsAsService integration
 type DSCModelsAsServiceSpec struct {
 	// +kubebuilder:validation:Enum=Managed;Removed
@@ -97,6 +97,12 @@ func (c *Ray) SetReleaseStatus(releases []common.ComponentRelease) {
 	c.Status.Releases = releases
 }
 
+func (c *Ray) GetImageStatus() *[]common.ComponentImage { return &c.Status.Images }
+
+func (c *Ray) SetImageStatus(images []common.ComponentImage) {
+	c.Status.Images = images
+}
+
 // DSCRay contains all the configuration exposed in DSC instance for Ray component
 type DSCRay struct {
 	common.ManagementSpec `json:",inline"`
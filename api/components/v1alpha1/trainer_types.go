@@ -99,6 +99,14 @@ func (c *Trainer) SetReleaseStatus(releases []common.ComponentRelease) {
 	c.Status.Releases = releases
 }
 
+func (c *Trainer) GetImageStatus() *[]common.ComponentImage {
+	return &c.Status.Images
+}
+
+func (c *Trainer) SetImageStatus(images []common.ComponentImage) {
+	c.Status.Images = images
+}
+
 // DSCTrainer contains all the configuration exposed in DSC instance for Trainer component
 type DSCTrainer struct {
 	common.ManagementSpec `json:",inline"`
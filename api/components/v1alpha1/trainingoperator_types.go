@@ -99,6 +99,14 @@ func (c *TrainingOperator) SetReleaseStatus(releases []common.ComponentRelease)
 	c.Status.Releases = releases
 }
 
+func (c *TrainingOperator) GetImageStatus() *[]common.ComponentImage {
+	return &c.Status.Images
+}
+
+func (c *TrainingOperator) SetImageStatus(images []common.ComponentImage) {
+	c.Status.Images = images
+}
+
 // DSCTrainingOperator contains all the configuration exposed in DSC instance for TrainingOperator component
 type DSCTrainingOperator struct {
 	common.ManagementSpec `json:",inline"`
@@ -118,6 +118,12 @@ func (c *TrustyAI) SetReleaseStatus(releases []common.ComponentRelease) {
 	c.Status.Releases = releases
 }
 
+func (c *TrustyAI) GetImageStatus() *[]common.ComponentImage { return &c.Status.Images }
+
+func (c *TrustyAI) SetImageStatus(images []common.ComponentImage) {
+	c.Status.Images = images
+}
+
 // DSCTrustyAI contains all the configuration exposed in DSC instance for TrustyAI component
 type DSCTrustyAI struct {
 	common.ManagementSpec `json:",inline"`
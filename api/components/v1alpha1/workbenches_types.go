@@ -39,6 +39,79 @@ type WorkbenchesSpec struct {
 	// workbenches spec exposed only to internal api
 }
 
+// NotebookInjectionProfile selects workbenches and the resources injected into them by the
+// notebook webhook. The injected resources are removed from the workbenches the profile no
+// longer applies to, on their next update.
+type NotebookInjectionProfile struct {
+	// Name of the profile.
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+
+	// NamespaceSelector selects the namespaces of the workbenches the profile applies to, all the
+	// namespaces when not set.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// Selector selects the workbenches the profile applies to by label, all the workbenches when
+	// not set.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// TrustedCABundle mounts the odh-trusted-ca-bundle ConfigMap of the namespace, and sets
+	// SSL_CERT_FILE, REQUESTS_CA_BUNDLE and PIP_CERT to the cluster trusted CA bundle.
+	// +optional
+	TrustedCABundle bool `json:"trustedCABundle,omitempty"`
+
+	// Proxy sets HTTP_PROXY, HTTPS_PROXY and NO_PROXY from the cluster-wide Proxy configuration.
+	// +optional
+	Proxy bool `json:"proxy,omitempty"`
+
+	// Pipelines sets KF_PIPELINES_ENDPOINT to the route of the pipeline server of the namespace
+	// and mounts a service account token to authenticate to it.
+	// +optional
+	Pipelines bool `json:"pipelines,omitempty"`
+
+	// ImagePullSecrets added to the workbenches.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=16
+	ImagePullSecrets []string `json:"imagePullSecrets,omitempty"`
+}
+
+// NotebookCullingPolicy configures the stop of the idle workbenches by the notebook controller.
+// The idle timeout can be overridden per namespace with the
+// opendatahub.io/notebook-culling-idle-timeout annotation, a zero duration disabling the culling
+// in the namespace. The stops of the culler admitted by the notebook webhook are counted by the
+// workbenches_notebook_cull_attempts_total metric.
+type NotebookCullingPolicy struct {
+	// IdleTimeout is the inactivity duration after which a workbench is stopped (e.g., "30m", "4h").
+	// +kubebuilder:default="4h"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1m')",message="IdleTimeout must be at least 1m"
+	IdleTimeout metav1.Duration `json:"idleTimeout,omitempty"`
+
+	// CheckInterval is the interval at which the activity of the workbenches is checked.
+	// +kubebuilder:default="1m"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1m')",message="CheckInterval must be at least 1m"
+	CheckInterval metav1.Duration `json:"checkInterval,omitempty"`
+
+	// ExemptNamespaces are the namespaces whose workbenches are never stopped.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=64
+	ExemptNamespaces []string `json:"exemptNamespaces,omitempty"`
+
+	// ExemptSelector selects by label the workbenches that are never stopped.
+	// +optional
+	ExemptSelector *metav1.LabelSelector `json:"exemptSelector,omitempty"`
+
+	// GPUOnly restricts the culling to the workbenches requesting GPUs.
+	// +optional
+	GPUOnly bool `json:"gpuOnly,omitempty"`
+}
+
 // WorkbenchesCommonStatus defines the shared observed state of Workbenches
 type WorkbenchesCommonStatus struct {
 	common.ComponentReleaseStatus `json:",inline"`
@@ -85,6 +158,12 @@ func (c *Workbenches) SetReleaseStatus(releases []common.ComponentRelease) {
 	c.Status.Releases = releases
 }
 
+func (c *Workbenches) GetImageStatus() *[]common.ComponentImage { return &c.Status.Images }
+
+func (c *Workbenches) SetImageStatus(images []common.ComponentImage) {
+	c.Status.Images = images
+}
+
 // +kubebuilder:object:root=true
 
 // WorkbenchesList contains a list of Workbenches
@@ -27,4 +27,17 @@ type WorkbenchesCommonSpec struct {
 	// +kubebuilder:validation:Pattern="^([a-z0-9]([-a-z0-9]*[a-z0-9])?)?$"
 	// +kubebuilder:validation:MaxLength=63
 	WorkbenchNamespace string `json:"workbenchNamespace,omitempty"`
+
+	// InjectionProfiles configure the resources injected into the workbenches by the notebook
+	// webhook, e.g. the trusted CA bundle or the cluster-wide proxy.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=32
+	InjectionProfiles []NotebookInjectionProfile `json:"injectionProfiles,omitempty"`
+
+	// Culling configures the stop of the idle workbenches. When not set, the culler configuration
+	// is left to the ODH Dashboard, the culling settings applied while it was set are dropped.
+	// +optional
+	Culling *NotebookCullingPolicy `json:"culling,omitempty"`
 }
@@ -21,6 +21,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -622,7 +623,7 @@ func (in *DSCTrustyAIStatus) DeepCopy() *DSCTrustyAIStatus {
 func (in *DSCWorkbenches) DeepCopyInto(out *DSCWorkbenches) {
 	*out = *in
 	out.ManagementSpec = in.ManagementSpec
-	out.WorkbenchesCommonSpec = in.WorkbenchesCommonSpec
+	in.WorkbenchesCommonSpec.DeepCopyInto(&out.WorkbenchesCommonSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DSCWorkbenches.
@@ -1934,6 +1935,7 @@ func (in *ModelsAsServiceSpec) DeepCopy() *ModelsAsServiceSpec {
 func (in *ModelsAsServiceStatus) DeepCopyInto(out *ModelsAsServiceStatus) {
 	*out = *in
 	in.Status.DeepCopyInto(&out.Status)
+	in.ComponentReleaseStatus.DeepCopyInto(&out.ComponentReleaseStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelsAsServiceStatus.
@@ -1961,6 +1963,63 @@ func (in *NimSpec) DeepCopy() *NimSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotebookCullingPolicy) DeepCopyInto(out *NotebookCullingPolicy) {
+	*out = *in
+	out.IdleTimeout = in.IdleTimeout
+	out.CheckInterval = in.CheckInterval
+	if in.ExemptNamespaces != nil {
+		in, out := &in.ExemptNamespaces, &out.ExemptNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExemptSelector != nil {
+		in, out := &in.ExemptSelector, &out.ExemptSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotebookCullingPolicy.
+func (in *NotebookCullingPolicy) DeepCopy() *NotebookCullingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(NotebookCullingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotebookInjectionProfile) DeepCopyInto(out *NotebookInjectionProfile) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotebookInjectionProfile.
+func (in *NotebookInjectionProfile) DeepCopy() *NotebookInjectionProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(NotebookInjectionProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Ray) DeepCopyInto(out *Ray) {
 	*out = *in
@@ -2490,7 +2549,7 @@ func (in *Workbenches) DeepCopyInto(out *Workbenches) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -2515,6 +2574,18 @@ func (in *Workbenches) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkbenchesCommonSpec) DeepCopyInto(out *WorkbenchesCommonSpec) {
 	*out = *in
+	if in.InjectionProfiles != nil {
+		in, out := &in.InjectionProfiles, &out.InjectionProfiles
+		*out = make([]NotebookInjectionProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Culling != nil {
+		in, out := &in.Culling, &out.Culling
+		*out = new(NotebookCullingPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkbenchesCommonSpec.
@@ -2578,7 +2649,7 @@ func (in *WorkbenchesList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkbenchesSpec) DeepCopyInto(out *WorkbenchesSpec) {
 	*out = *in
-	out.WorkbenchesCommonSpec = in.WorkbenchesCommonSpec
+	in.WorkbenchesCommonSpec.DeepCopyInto(&out.WorkbenchesCommonSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkbenchesSpec.
@@ -164,7 +164,9 @@ func (c *DataScienceCluster) ConvertTo(dstRaw conversion.Hub) error {
 				},
 			},
 		},
-		Release: c.Status.Release,
+		Release:           c.Status.Release,
+		ComponentsReady:   c.Status.ComponentsReady,
+		ComponentsSummary: c.Status.ComponentsSummary,
 	}
 
 	return nil
@@ -223,7 +225,9 @@ func (c *DataScienceCluster) ConvertFrom(srcRaw conversion.Hub) error {
 			FeastOperator:        src.Status.Components.FeastOperator,
 			LlamaStackOperator:   src.Status.Components.LlamaStackOperator,
 		},
-		Release: src.Status.Release,
+		Release:           src.Status.Release,
+		ComponentsReady:   src.Status.ComponentsReady,
+		ComponentsSummary: src.Status.ComponentsSummary,
 	}
 
 	return nil
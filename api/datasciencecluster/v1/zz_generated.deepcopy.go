@@ -21,6 +21,7 @@ limitations under the License.
 package v1
 
 import (
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	corev1 "k8s.io/api/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -29,7 +30,7 @@ import (
 func (in *Components) DeepCopyInto(out *Components) {
 	*out = *in
 	out.Dashboard = in.Dashboard
-	out.Workbenches = in.Workbenches
+	in.Workbenches.DeepCopyInto(&out.Workbenches)
 	out.ModelMeshServing = in.ModelMeshServing
 	in.DataSciencePipelines.DeepCopyInto(&out.DataSciencePipelines)
 	out.Kserve = in.Kserve
@@ -192,6 +193,13 @@ func (in *DataScienceClusterStatus) DeepCopyInto(out *DataScienceClusterStatus)
 	}
 	in.Components.DeepCopyInto(&out.Components)
 	in.Release.DeepCopyInto(&out.Release)
+	if in.ComponentsSummary != nil {
+		in, out := &in.ComponentsSummary, &out.ComponentsSummary
+		*out = make([]common.ComponentStatusSummary, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataScienceClusterStatus.
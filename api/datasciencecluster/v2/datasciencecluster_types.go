@@ -129,6 +129,17 @@ type DataScienceClusterStatus struct {
 
 	// Version and release type
 	Release common.Release `json:"release,omitempty"`
+
+	// ComponentsReady reports the number of ready components over the number of managed
+	// components, for example 3/4.
+	// +optional
+	ComponentsReady string `json:"componentsReady,omitempty"`
+
+	// ComponentsSummary is a compact summary of the status of each component.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	ComponentsSummary []common.ComponentStatusSummary `json:"componentsSummary,omitempty"`
 }
 
 func (s *DataScienceClusterStatus) GetConditions() []common.Condition {
@@ -145,6 +156,8 @@ func (s *DataScienceClusterStatus) SetConditions(conditions []common.Condition)
 // +kubebuilder:resource:scope=Cluster,shortName=dsc
 // +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`,description="Ready"
 // +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`,description="Reason"
+// +kubebuilder:printcolumn:name="Components",type=string,JSONPath=`.status.componentsReady`,description="Ready components over managed components"
+// +kubebuilder:printcolumn:name="Not Ready",type=string,JSONPath=`.status.componentsSummary[?(@.ready!="True")].name`,description="Components not ready",priority=1
 
 // DataScienceCluster is the Schema for the datascienceclusters API.
 type DataScienceCluster struct {
@@ -30,6 +30,7 @@ func (c *DSCInitialization) ConvertTo(dstRaw conversion.Hub) error {
 	dst.Spec = dsciv2.DSCInitializationSpec{
 		ApplicationsNamespace: c.Spec.ApplicationsNamespace,
 		Monitoring:            c.Spec.Monitoring,
+		NetworkPolicy:         c.Spec.NetworkPolicy,
 	}
 	if c.Spec.TrustedCABundle != nil {
 		dst.Spec.TrustedCABundle = &dsciv2.TrustedCABundleSpec{
@@ -44,11 +45,12 @@ func (c *DSCInitialization) ConvertTo(dstRaw conversion.Hub) error {
 	}
 
 	dst.Status = dsciv2.DSCInitializationStatus{
-		Phase:          c.Status.Phase,
-		Conditions:     c.Status.Conditions,
-		RelatedObjects: c.Status.RelatedObjects,
-		ErrorMessage:   c.Status.ErrorMessage,
-		Release:        c.Status.Release,
+		Phase:           c.Status.Phase,
+		Conditions:      c.Status.Conditions,
+		RelatedObjects:  c.Status.RelatedObjects,
+		ErrorMessage:    c.Status.ErrorMessage,
+		Release:         c.Status.Release,
+		NetworkPolicies: c.Status.NetworkPolicies,
 	}
 
 	return nil
@@ -63,6 +65,7 @@ func (c *DSCInitialization) ConvertFrom(srcRaw conversion.Hub) error {
 	c.Spec = DSCInitializationSpec{
 		ApplicationsNamespace: src.Spec.ApplicationsNamespace,
 		Monitoring:            src.Spec.Monitoring,
+		NetworkPolicy:         src.Spec.NetworkPolicy,
 	}
 	if src.Spec.TrustedCABundle != nil {
 		c.Spec.TrustedCABundle = &TrustedCABundleSpec{
@@ -77,11 +80,12 @@ func (c *DSCInitialization) ConvertFrom(srcRaw conversion.Hub) error {
 	}
 
 	c.Status = DSCInitializationStatus{
-		Phase:          src.Status.Phase,
-		Conditions:     src.Status.Conditions,
-		RelatedObjects: src.Status.RelatedObjects,
-		ErrorMessage:   src.Status.ErrorMessage,
-		Release:        src.Status.Release,
+		Phase:           src.Status.Phase,
+		Conditions:      src.Status.Conditions,
+		RelatedObjects:  src.Status.RelatedObjects,
+		ErrorMessage:    src.Status.ErrorMessage,
+		Release:         src.Status.Release,
+		NetworkPolicies: src.Status.NetworkPolicies,
 	}
 
 	return nil
@@ -18,6 +18,7 @@ package v1
 
 import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
 	operatorv1 "github.com/openshift/api/operator/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -69,6 +70,10 @@ type DSCInitializationStatus struct {
 
 	// Version and release type
 	Release common.Release `json:"release,omitempty"`
+
+	// NetworkPolicies lists the NetworkPolicies generated for the applications namespace.
+	// +optional
+	NetworkPolicies []serviceApi.GeneratedNetworkPolicy `json:"networkPolicies,omitempty"`
 }
 
 // GetConditions returns the conditions slice
@@ -46,6 +46,12 @@ type DSCInitializationSpec struct {
 	// Additionally, this fields allows admins to add custom CA bundles to the configmap using the .CustomCABundle field.
 	// +optional
 	TrustedCABundle *TrustedCABundleSpec `json:"trustedCABundle,omitempty"`
+	// NetworkPolicy configures the NetworkPolicies generated for the applications namespace.
+	// Additional peers and egress rules are added as separate policies next to the default
+	// ingress policy. Ingress.Enabled=false removes the default ingress policy generated on
+	// Open Data Hub, the policies shipped with the platform manifests are not affected.
+	// +optional
+	NetworkPolicy *serviceApi.NetworkPolicyConfig `json:"networkPolicy,omitempty"`
 	// Internal development useful field to test customizations.
 	// This is not recommended to be used in production environment.
 	// +optional
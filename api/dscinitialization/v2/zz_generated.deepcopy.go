@@ -22,6 +22,7 @@ package v2
 
 import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
 	"k8s.io/api/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -94,6 +95,11 @@ func (in *DSCInitializationSpec) DeepCopyInto(out *DSCInitializationSpec) {
 		*out = new(TrustedCABundleSpec)
 		**out = **in
 	}
+	if in.NetworkPolicy != nil {
+		in, out := &in.NetworkPolicy, &out.NetworkPolicy
+		*out = new(v1alpha1.NetworkPolicyConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DevFlags != nil {
 		in, out := &in.DevFlags, &out.DevFlags
 		*out = new(DevFlags)
@@ -127,6 +133,13 @@ func (in *DSCInitializationStatus) DeepCopyInto(out *DSCInitializationStatus) {
 		copy(*out, *in)
 	}
 	in.Release.DeepCopyInto(&out.Release)
+	if in.NetworkPolicies != nil {
+		in, out := &in.NetworkPolicies, &out.NetworkPolicies
+		*out = make([]v1alpha1.GeneratedNetworkPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DSCInitializationStatus.
@@ -63,6 +63,12 @@ type GatewayConfigSpec struct {
 	// +optional
 	Certificate *infrav1.CertificateSpec `json:"certificate,omitempty"`
 
+	// CertificateLifecycle configures how the gateway TLS certificate is issued and rotated.
+	// Only applies when IngressMode is LoadBalancer; in OcpRoute mode the certificate is
+	// managed by the OpenShift service CA.
+	// +optional
+	CertificateLifecycle *CertificateLifecycleConfig `json:"certificateLifecycle,omitempty"`
+
 	// Domain specifies the host name for intercepting incoming requests.
 	// Most likely, you will want to use a wildcard name, like *.example.com.
 	// If not set, the domain of the OpenShift Ingress is used.
@@ -105,6 +111,16 @@ type GatewayConfigSpec struct {
 	// +optional
 	ProviderCASecretName string `json:"providerCASecretName,omitempty"`
 
+	// AdditionalGateways declares gateways served next to the default data-science-gateway,
+	// for example an internal gateway or a dedicated one for model inference.
+	// Additional gateways are not protected by the kube-auth-proxy authentication filter,
+	// workloads attached to them are responsible for their own authentication.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=8
+	AdditionalGateways []AdditionalGatewaySpec `json:"additionalGateways,omitempty"`
+
 	// VerifyProviderCertificate controls TLS certificate verification for the authentication provider.
 	// When true (default), certificates are verified against the system trust store and providerCASecretName.
 	// When false, certificate verification is disabled (development/testing only).
@@ -115,24 +131,85 @@ type GatewayConfigSpec struct {
 	VerifyProviderCertificate *bool `json:"verifyProviderCertificate,omitempty"`
 }
 
-// NetworkPolicyConfig defines network policy configuration for kube-auth-proxy.
-// When nil or when Ingress is nil, NetworkPolicy ingress rules are enabled by default
-// to restrict access to kube-auth-proxy pods.
-type NetworkPolicyConfig struct {
-	// Ingress defines ingress NetworkPolicy rules.
-	// When nil, ingress rules are applied by default (allows traffic from Gateway pods and monitoring namespaces).
-	// When specified, Enabled must be set to true to apply rules or false to skip NetworkPolicy creation.
-	// Set Enabled=false only in development environments or when using alternative network security controls.
+// AdditionalGatewaySpec defines a named gateway managed next to the default data-science-gateway.
+type AdditionalGatewaySpec struct {
+	// Name identifies the gateway. The Gateway resource is named data-science-gateway-<name>
+	// and components select it through this name.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=32
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// IngressMode specifies how the Gateway is exposed externally.
+	// +optional
+	// +kubebuilder:default=OcpRoute
+	IngressMode IngressMode `json:"ingressMode,omitempty"`
+
+	// Certificate specifies configuration of the TLS certificate securing communication for the gateway.
+	// +optional
+	Certificate *infrav1.CertificateSpec `json:"certificate,omitempty"`
+
+	// CertificateLifecycle configures how the gateway TLS certificate is issued and rotated.
 	// +optional
-	Ingress *IngressPolicyConfig `json:"ingress,omitempty"`
+	CertificateLifecycle *CertificateLifecycleConfig `json:"certificateLifecycle,omitempty"`
+
+	// Domain specifies the base domain of the gateway host name.
+	// If not set, the domain of the OpenShift Ingress is used.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^(\*\.)?([a-z0-9]([-a-z0-9]*[a-z0-9])?\.)*[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Domain string `json:"domain,omitempty"`
+
+	// Subdomain of the gateway host name, defaults to the Gateway resource name.
+	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^([a-z0-9]([-a-z0-9]*[a-z0-9])?)$`
+	Subdomain string `json:"subdomain,omitempty"`
+
+	// NetworkPolicy configuration for the gateway pods.
+	// When enabled, ingress is restricted to the HTTPS listener and health ports, and to
+	// metrics scraping from the monitoring namespaces.
+	// +optional
+	NetworkPolicy *NetworkPolicyConfig `json:"networkPolicy,omitempty"`
 }
 
-// IngressPolicyConfig defines ingress NetworkPolicy rules
-type IngressPolicyConfig struct {
-	// Enabled determines whether ingress rules are applied.
-	// When true, creates NetworkPolicy allowing traffic only from Gateway pods and monitoring namespaces.
+// CertificateLifecycleConfig defines issuance and rotation settings for the gateway certificate.
+// +kubebuilder:validation:XValidation:rule="!has(self.duration) || !has(self.renewBefore) || duration(self.renewBefore) < duration(self.duration)",message="renewBefore must be shorter than duration"
+type CertificateLifecycleConfig struct {
+	// IssuerRef references a cert-manager Issuer or ClusterIssuer.
+	// When set and the cert-manager Certificate CRD is installed, the gateway certificate is
+	// requested from this issuer and Certificate.Type is ignored.
+	// When the CRD is not installed, the operator falls back to Certificate.Type.
+	// +optional
+	IssuerRef *CertificateIssuerRef `json:"issuerRef,omitempty"`
+
+	// Duration is the requested lifetime of self-signed and cert-manager issued certificates.
+	// +optional
+	// +kubebuilder:default="8760h"
+	Duration metav1.Duration `json:"duration,omitempty"`
+
+	// RenewBefore is how long before expiry the certificate is rotated.
+	// Must be shorter than Duration.
+	// +optional
+	// +kubebuilder:default="720h"
+	RenewBefore metav1.Duration `json:"renewBefore,omitempty"`
+}
+
+// CertificateIssuerRef identifies the issuer used to request the gateway certificate.
+type CertificateIssuerRef struct {
+	// Name of the issuer resource.
 	// +kubebuilder:validation:Required
-	Enabled bool `json:"enabled"`
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Kind of the issuer resource. An Issuer must live in the openshift-ingress namespace.
+	// +optional
+	// +kubebuilder:default=ClusterIssuer
+	Kind string `json:"kind,omitempty"`
+
+	// Group of the issuer resource, to support external cert-manager issuers.
+	// +optional
+	// +kubebuilder:default="cert-manager.io"
+	Group string `json:"group,omitempty"`
 }
 
 // OIDCConfig defines OIDC provider configuration
@@ -174,6 +251,50 @@ type CookieConfig struct {
 // GatewayConfigStatus defines the observed state of GatewayConfig
 type GatewayConfigStatus struct {
 	common.Status `json:",inline"`
+
+	// Certificate reports the TLS certificate currently served by the gateway.
+	// +optional
+	Certificate *GatewayCertificateStatus `json:"certificate,omitempty"`
+
+	// AdditionalGateways reports the state of the gateways declared in spec.additionalGateways.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	AdditionalGateways []AdditionalGatewayStatus `json:"additionalGateways,omitempty"`
+
+	// NetworkPolicies lists the NetworkPolicies generated for the gateways and kube-auth-proxy.
+	// +optional
+	NetworkPolicies []GeneratedNetworkPolicy `json:"networkPolicies,omitempty"`
+}
+
+// AdditionalGatewayStatus describes a gateway declared in spec.additionalGateways.
+type AdditionalGatewayStatus struct {
+	// Name of the gateway as declared in the spec.
+	Name string `json:"name"`
+
+	// GatewayName is the name of the Gateway resource in openshift-ingress.
+	GatewayName string `json:"gatewayName,omitempty"`
+
+	// Hostname served by the gateway.
+	Hostname string `json:"hostname,omitempty"`
+
+	// Certificate reports the TLS certificate served by the gateway.
+	// +optional
+	Certificate *GatewayCertificateStatus `json:"certificate,omitempty"`
+}
+
+// GatewayCertificateStatus describes the TLS certificate served by the gateway.
+type GatewayCertificateStatus struct {
+	// SecretName is the name of the secret in openshift-ingress holding the certificate.
+	SecretName string `json:"secretName,omitempty"`
+
+	// Issuer describes where the certificate comes from, for example SelfSigned
+	// or ClusterIssuer/letsencrypt.
+	Issuer string `json:"issuer,omitempty"`
+
+	// NotAfter is the expiry time of the certificate.
+	// +optional
+	NotAfter *metav1.Time `json:"notAfter,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -182,6 +303,7 @@ type GatewayConfigStatus struct {
 // +kubebuilder:validation:XValidation:rule="self.metadata.name == 'default-gateway'",message="GatewayConfig name must be default-gateway"
 // +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`,description="Ready"
 // +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`,description="Reason"
+// +kubebuilder:printcolumn:name="Certificate Expiry",type=date,JSONPath=`.status.certificate.notAfter`,description="Certificate expiry",priority=1
 
 // GatewayConfig is the Schema for the gatewayconfigs API
 type GatewayConfig struct {
@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	corev1 "k8s.io/api/core/v1"
 	resource "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
@@ -76,6 +77,59 @@ type MonitoringStatus struct {
 	common.Status `json:",inline"`
 
 	URL string `json:"url,omitempty"`
+
+	// InstrumentationPolicies reports the coverage of the instrumentation policies.
+	// +optional
+	InstrumentationPolicies []InstrumentationPolicyStatus `json:"instrumentationPolicies,omitempty"`
+
+	// Backends lists the backends deployed by the monitoring service with their endpoints and health.
+	// +optional
+	// +listType=map
+	// +listMapKey=kind
+	Backends []MonitoringBackendStatus `json:"backends,omitempty"`
+
+	// ServiceLevelObjectives reports the error budget remaining of the SLOs.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	ServiceLevelObjectives []ServiceLevelObjectiveStatus `json:"serviceLevelObjectives,omitempty"`
+}
+
+// MonitoringBackendStatus reports the endpoints, version and health of a backend deployed by the
+// monitoring service.
+type MonitoringBackendStatus struct {
+	// Kind of the backend resource, e.g. MonitoringStack or TempoStack.
+	Kind string `json:"kind"`
+	// Name of the backend resource.
+	Name string `json:"name"`
+	// Namespace of the backend resource.
+	Namespace string `json:"namespace"`
+	// Version reported by the backend, when available.
+	// +optional
+	Version string `json:"version,omitempty"`
+	// Ready is True when the backend is ready, False when it is not and Unknown when its
+	// readiness can't be determined from its status.
+	Ready metav1.ConditionStatus `json:"ready"`
+	// Reason for the readiness of the backend.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// Message explaining the readiness of the backend.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// Endpoints exposed by the backend.
+	// +optional
+	Endpoints []MonitoringEndpoint `json:"endpoints,omitempty"`
+}
+
+// MonitoringEndpoint is an endpoint exposed by a monitoring backend.
+type MonitoringEndpoint struct {
+	// Name identifies the endpoint, e.g. query or otlp-grpc.
+	Name string `json:"name"`
+	// URL of the endpoint.
+	URL string `json:"url"`
+	// External is true when the endpoint is reachable from outside the cluster.
+	// +optional
+	External bool `json:"external,omitempty"`
 }
 
 // Traces enables and defines the configuration for traces collection
@@ -94,6 +148,110 @@ type Traces struct {
 	// The configuration follows the OpenTelemetry Collector exporter format.
 	// +optional
 	Exporters map[string]runtime.RawExtension `json:"exporters,omitempty"`
+	// InstrumentationPolicies select the namespaces in which the workloads are auto-instrumented,
+	// each selected namespace gets an Instrumentation configured by the first matching policy.
+	// When no policy is set, only the monitoring namespace is instrumented.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=20
+	InstrumentationPolicies []InstrumentationPolicy `json:"instrumentationPolicies,omitempty"`
+}
+
+// InstrumentationWorkloadKind is a kind of data science workload an instrumentation policy can select.
+// +kubebuilder:validation:Enum=Notebook;InferenceService;RayCluster;PipelineRun
+type InstrumentationWorkloadKind string
+
+const (
+	// WorkloadKindNotebook selects the namespaces running workbenches.
+	WorkloadKindNotebook InstrumentationWorkloadKind = "Notebook"
+	// WorkloadKindInferenceService selects the namespaces running KServe model servers.
+	WorkloadKindInferenceService InstrumentationWorkloadKind = "InferenceService"
+	// WorkloadKindRayCluster selects the namespaces running Ray clusters.
+	WorkloadKindRayCluster InstrumentationWorkloadKind = "RayCluster"
+	// WorkloadKindPipelineRun selects the namespaces running pipelines, executed as Argo Workflows.
+	WorkloadKindPipelineRun InstrumentationWorkloadKind = "PipelineRun"
+)
+
+// InstrumentationPolicy defines the auto-instrumentation settings of the selected namespaces.
+// A namespace is selected when it matches the namespace selector and contains at least one
+// workload of the given kinds, the conditions that are not set always match.
+// The reserved namespaces (openshift-*, kube-*, default and openshift) are never selected.
+// +kubebuilder:validation:XValidation:rule="has(self.namespaceSelector) || has(self.workloadKinds)",message="at least one of namespaceSelector or workloadKinds must be set"
+type InstrumentationPolicy struct {
+	// Name identifies the policy in the status.
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern="^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+	Name string `json:"name"`
+	// NamespaceSelector selects the namespaces by label.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	// WorkloadKinds selects the namespaces containing at least one workload of these kinds.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	WorkloadKinds []InstrumentationWorkloadKind `json:"workloadKinds,omitempty"`
+	// SampleRatio determines the sampling rate for the traces of the selected namespaces.
+	// Defaults to the sample ratio of the traces configuration.
+	// +optional
+	// +kubebuilder:validation:Pattern="^(0(\\.[0-9]+)?|1(\\.0+)?)$"
+	SampleRatio string `json:"sampleRatio,omitempty"`
+	// AutoInstrumentation configures the language auto-instrumentation. The workloads still have
+	// to opt in with the instrumentation.opentelemetry.io/inject-<language> pod annotation.
+	// +optional
+	AutoInstrumentation *AutoInstrumentation `json:"autoInstrumentation,omitempty"`
+	// ResourceAttributes are added to the telemetry emitted by the instrumented workloads.
+	// +optional
+	// +kubebuilder:validation:MaxProperties=20
+	ResourceAttributes map[string]string `json:"resourceAttributes,omitempty"`
+}
+
+// AutoInstrumentation defines the auto-instrumentation settings of each language.
+type AutoInstrumentation struct {
+	// +optional
+	Java *LanguageInstrumentation `json:"java,omitempty"`
+	// +optional
+	NodeJS *LanguageInstrumentation `json:"nodejs,omitempty"`
+	// +optional
+	Python *LanguageInstrumentation `json:"python,omitempty"`
+	// +optional
+	DotNet *LanguageInstrumentation `json:"dotnet,omitempty"`
+	// +optional
+	Go *LanguageInstrumentation `json:"go,omitempty"`
+}
+
+// LanguageInstrumentation defines the auto-instrumentation settings of a language.
+type LanguageInstrumentation struct {
+	// Image overrides the auto-instrumentation image provided by the OpenTelemetry operator.
+	// +optional
+	Image string `json:"image,omitempty"`
+	// Env defines additional environment variables for the instrumented containers.
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+}
+
+// InstrumentationPolicyStatus reports the namespaces instrumented by a policy.
+type InstrumentationPolicyStatus struct {
+	// Name of the policy.
+	Name string `json:"name"`
+	// InstrumentedNamespaces is the number of namespaces instrumented by the policy.
+	InstrumentedNamespaces int32 `json:"instrumentedNamespaces"`
+	// Namespaces lists the instrumented namespaces, truncated to the first 50 in alphabetical order.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// Logs enables and defines the configuration for logs collection. The collector receives the
+// logs over OTLP and forwards them to the exporters, there is no built-in logs storage.
+type Logs struct {
+	// Exporters defines the logs exporters for sending logs to external observability tools.
+	// Each key represents the exporter name, and the value contains the exporter configuration.
+	// The configuration follows the OpenTelemetry Collector exporter format.
+	// At least one exporter is required, maximum 10 exporters allowed, each config must be less
+	// than 10KB (enforced at reconciliation time).
+	// +kubebuilder:validation:MinProperties=1
+	// +kubebuilder:validation:XValidation:rule="size(self) <= 10",message="maximum 10 exporters allowed"
+	Exporters map[string]runtime.RawExtension `json:"exporters"`
 }
 
 // TracesTLS defines TLS configuration for trace ingestion and query APIs
@@ -146,6 +304,85 @@ type TracesStorage struct {
 
 // Alerting configuration for Prometheus
 type Alerting struct {
+	// ServiceLevelObjectives are the SLOs for which recording rules and multi-window,
+	// multi-burn-rate alerts are generated.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=50
+	ServiceLevelObjectives []ServiceLevelObjective `json:"serviceLevelObjectives,omitempty"`
+}
+
+// ServiceLevelObjective defines an SLO as a target ratio of good events over a window, and the
+// indicator measuring the error events.
+// +kubebuilder:validation:XValidation:rule="has(self.ratio) != has(self.latency)",message="exactly one of ratio or latency must be set"
+type ServiceLevelObjective struct {
+	// Name of the SLO, set as the slo label of the generated series and alerts.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+	// Component the SLO applies to, e.g. dashboard or kserve, set as the component label of the
+	// generated series and alerts.
+	// +optional
+	Component string `json:"component,omitempty"`
+	// Description of the SLO, used in the annotations of the alerts.
+	// +optional
+	Description string `json:"description,omitempty"`
+	// Target is the percentage of good events over the window, e.g. "99.9".
+	// +kubebuilder:validation:Pattern=`^[0-9]{1,2}(\.[0-9]+)?$`
+	Target string `json:"target"`
+	// Window is the period over which the SLO is evaluated, e.g. "30d" or "4w".
+	// +kubebuilder:default="30d"
+	// +kubebuilder:validation:Pattern=`^[1-9][0-9]*(d|w)$`
+	Window string `json:"window,omitempty"`
+	// Ratio measures the SLO as the ratio of error events over total events, e.g. the availability
+	// of a route or the success ratio of pipeline runs.
+	// +optional
+	Ratio *RatioIndicator `json:"ratio,omitempty"`
+	// Latency measures the SLO as the ratio of requests slower than a threshold, e.g. the p95
+	// latency of a model server.
+	// +optional
+	Latency *LatencyIndicator `json:"latency,omitempty"`
+}
+
+// RatioIndicator measures an SLO from PromQL queries. The queries must return a single series,
+// e.g. aggregated with sum, and the $window placeholder is replaced by the range of each
+// evaluation window, e.g. `sum(rate(haproxy_backend_http_responses_total{route="odh-dashboard",code="5xx"}[$window]))`.
+type RatioIndicator struct {
+	// ErrorQuery returns the rate of error events.
+	// +kubebuilder:validation:MinLength=1
+	ErrorQuery string `json:"errorQuery"`
+	// TotalQuery returns the rate of all the events.
+	// +kubebuilder:validation:MinLength=1
+	TotalQuery string `json:"totalQuery"`
+}
+
+// LatencyIndicator measures an SLO from a Prometheus histogram.
+type LatencyIndicator struct {
+	// Metric is the name of the histogram, without the _bucket suffix, e.g. "revision_app_request_latencies".
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z_:][a-zA-Z0-9_:]*$`
+	Metric string `json:"metric"`
+	// Selector is a comma-separated list of label matchers restricting the series of the
+	// histogram, e.g. `namespace="models",service_name="granite"`.
+	// +optional
+	Selector string `json:"selector,omitempty"`
+	// Threshold is the latency objective, matching the le label of a bucket of the histogram,
+	// e.g. "0.5".
+	// +kubebuilder:validation:MinLength=1
+	Threshold string `json:"threshold"`
+}
+
+// ServiceLevelObjectiveStatus reports the error budget of an SLO.
+type ServiceLevelObjectiveStatus struct {
+	// Name of the SLO.
+	Name string `json:"name"`
+	// ErrorBudgetRemaining is the ratio of the error budget left over the window, e.g. "0.75".
+	// It is negative once the budget is exhausted, and not set when it could not be evaluated.
+	// +optional
+	ErrorBudgetRemaining string `json:"errorBudgetRemaining,omitempty"`
+	// Message explains why the error budget could not be evaluated.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -33,6 +33,8 @@ type MonitoringCommonSpec struct {
 	Metrics *Metrics `json:"metrics,omitempty"`
 	// Tracing configuration for OpenTelemetry instrumentation
 	Traces *Traces `json:"traces,omitempty"`
+	// Logs configuration for the OpenTelemetry logs pipeline
+	Logs *Logs `json:"logs,omitempty"`
 	// Alerting configuration for Prometheus
 	Alerting *Alerting `json:"alerting,omitempty"`
 	// CollectorReplicas specifies the number of replicas in opentelemetry-collector. If not set, it defaults
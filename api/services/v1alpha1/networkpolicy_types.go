@@ -0,0 +1,146 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NetworkPolicyMode defines how the NetworkPolicies generated by the operator are applied.
+// +kubebuilder:validation:Enum=Enforce;Audit
+type NetworkPolicyMode string
+
+const (
+	// NetworkPolicyModeEnforce applies the generated NetworkPolicies to all the selected pods.
+	NetworkPolicyModeEnforce NetworkPolicyMode = "Enforce"
+	// NetworkPolicyModeAudit labels the generated NetworkPolicies as audit policies and only
+	// applies them to pods labeled opendatahub.io/network-policy-audit=true, so the rules can
+	// be reviewed and tried out on selected pods before being enforced.
+	NetworkPolicyModeAudit NetworkPolicyMode = "Audit"
+)
+
+// NetworkPolicyConfig defines the configuration of the NetworkPolicies generated by the operator.
+// When nil or when Ingress is nil, NetworkPolicy ingress rules are enabled by default
+// to restrict access to the selected pods.
+type NetworkPolicyConfig struct {
+	// Mode defines whether the generated NetworkPolicies are enforced or only audited.
+	// +optional
+	// +kubebuilder:default=Enforce
+	Mode NetworkPolicyMode `json:"mode,omitempty"`
+
+	// Ingress defines ingress NetworkPolicy rules.
+	// When nil, ingress rules are applied by default (allows traffic from Gateway pods and monitoring namespaces).
+	// When specified, Enabled must be set to true to apply rules or false to skip NetworkPolicy creation.
+	// Set Enabled=false only in development environments or when using alternative network security controls.
+	// +optional
+	Ingress *IngressPolicyConfig `json:"ingress,omitempty"`
+
+	// Egress defines egress NetworkPolicy rules.
+	// When nil, egress traffic is not restricted.
+	// +optional
+	Egress *EgressPolicyConfig `json:"egress,omitempty"`
+}
+
+// IngressPolicyConfig defines ingress NetworkPolicy rules
+type IngressPolicyConfig struct {
+	// Enabled determines whether ingress rules are applied.
+	// When true, creates NetworkPolicy allowing traffic only from Gateway pods and monitoring namespaces.
+	// +kubebuilder:validation:Required
+	Enabled bool `json:"enabled"`
+
+	// AdditionalPeers are allowed to reach the selected pods in addition to the default peers.
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	AdditionalPeers []NetworkPolicyPeer `json:"additionalPeers,omitempty"`
+}
+
+// EgressPolicyConfig defines egress NetworkPolicy rules.
+type EgressPolicyConfig struct {
+	// Enabled determines whether egress rules are applied.
+	// When true, egress traffic of the selected pods is denied by default and only allowed to the
+	// API server, DNS and monitoring namespaces, unless disabled below, and to AdditionalPeers.
+	// +kubebuilder:validation:Required
+	Enabled bool `json:"enabled"`
+
+	// AllowAPIServer allows traffic to the Kubernetes API server.
+	// +optional
+	// +kubebuilder:default=true
+	AllowAPIServer *bool `json:"allowAPIServer,omitempty"`
+
+	// AllowDNS allows traffic to the cluster DNS.
+	// +optional
+	// +kubebuilder:default=true
+	AllowDNS *bool `json:"allowDNS,omitempty"`
+
+	// AllowMonitoring allows traffic to the monitoring namespaces.
+	// +optional
+	// +kubebuilder:default=true
+	AllowMonitoring *bool `json:"allowMonitoring,omitempty"`
+
+	// AdditionalPeers the selected pods are allowed to reach, for example an external OIDC provider.
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	AdditionalPeers []NetworkPolicyPeer `json:"additionalPeers,omitempty"`
+}
+
+// NetworkPolicyPeer describes a peer allowed by a generated NetworkPolicy, either pods selected
+// by namespace and pod selectors or an IP block.
+// +kubebuilder:validation:XValidation:rule="has(self.cidr) != (has(self.namespaceSelector) || has(self.podSelector))",message="exactly one of cidr or namespaceSelector/podSelector must be set"
+// +kubebuilder:validation:XValidation:rule="!has(self.except) || has(self.cidr)",message="except requires cidr"
+type NetworkPolicyPeer struct {
+	// NamespaceSelector selects the namespaces of the peer, all namespaces when empty.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// PodSelector selects the pods of the peer. When NamespaceSelector is not set, pods
+	// are selected in the namespace of the NetworkPolicy.
+	// +optional
+	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty"`
+
+	// CIDR is a range of IP addresses, for example 10.0.0.0/16.
+	// +optional
+	// +kubebuilder:validation:MaxLength=43
+	CIDR string `json:"cidr,omitempty"`
+
+	// Except lists ranges of IP addresses excluded from CIDR.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Except []string `json:"except,omitempty"`
+
+	// Ports restricts the traffic to the given ports, all ports are allowed when empty.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Ports []networkingv1.NetworkPolicyPort `json:"ports,omitempty"`
+}
+
+// GeneratedNetworkPolicy identifies a NetworkPolicy generated by the operator.
+type GeneratedNetworkPolicy struct {
+	// Name of the NetworkPolicy.
+	Name string `json:"name"`
+
+	// Namespace of the NetworkPolicy.
+	Namespace string `json:"namespace"`
+
+	// PolicyTypes of the NetworkPolicy.
+	// +optional
+	PolicyTypes []networkingv1.PolicyType `json:"policyTypes,omitempty"`
+
+	// Mode the NetworkPolicy is applied with.
+	// +optional
+	Mode NetworkPolicyMode `json:"mode,omitempty"`
+}
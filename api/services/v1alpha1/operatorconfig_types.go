@@ -0,0 +1,256 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	operatorv1 "github.com/openshift/api/operator/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+)
+
+const (
+	OperatorConfigServiceName  = "operatorconfig"
+	OperatorConfigInstanceName = "default-operatorconfig"
+	OperatorConfigKind         = "OperatorConfig"
+
+	// MaxReconcileConcurrency is the upper bound of spec.reconcile.maxConcurrentReconciles.
+	MaxReconcileConcurrency = 16
+)
+
+// Check that the component implements common.PlatformObject.
+var _ common.PlatformObject = (*OperatorConfig)(nil)
+
+// OperatorConfigSpec defines the configuration of the operator. Logging and reconcile settings
+// are applied at runtime, the other settings are applied when the operator restarts.
+type OperatorConfigSpec struct {
+	// Logging configures the operator logs.
+	// +optional
+	Logging OperatorLoggingSpec `json:"logging,omitempty"`
+
+	// LeaderElection configures the leader election of the operator replicas.
+	// Applied when the operator restarts.
+	// +optional
+	LeaderElection *OperatorLeaderElectionSpec `json:"leaderElection,omitempty"`
+
+	// Components lists the components the operator is allowed to manage, all when empty.
+	// Applied when the operator restarts.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=64
+	Components []string `json:"components,omitempty"`
+
+	// Services lists the services the operator is allowed to run, all when empty.
+	// Applied when the operator restarts.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=64
+	Services []string `json:"services,omitempty"`
+
+	// Cache configures the namespaces watched by the operator cache.
+	// Applied when the operator restarts.
+	// +optional
+	Cache OperatorCacheSpec `json:"cache,omitempty"`
+
+	// Webhooks configures the operator admission webhooks.
+	// Applied when the operator restarts.
+	// +optional
+	Webhooks OperatorWebhooksSpec `json:"webhooks,omitempty"`
+
+	// DefaultManagementStates overrides the management state of the components of the
+	// DataScienceCluster created by the operator, keyed by the fields of spec.components.
+	// Applied when the default DataScienceCluster is created.
+	// +optional
+	// +kubebuilder:validation:MaxProperties=64
+	DefaultManagementStates map[string]operatorv1.ManagementState `json:"defaultManagementStates,omitempty"`
+
+	// Reconcile configures the controllers of the operator.
+	// +optional
+	Reconcile OperatorReconcileSpec `json:"reconcile,omitempty"`
+}
+
+// OperatorLoggingSpec configures the operator logs.
+type OperatorLoggingSpec struct {
+	// Level is the log level: debug, info, error or a positive verbosity.
+	// Applied at runtime.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^(debug|info|error|[1-9][0-9]*)?$`
+	Level string `json:"level,omitempty"`
+
+	// Mode is the log format: development or production.
+	// Applied when the operator restarts.
+	// +optional
+	// +kubebuilder:validation:Enum=devel;development;prod;production;""
+	Mode string `json:"mode,omitempty"`
+}
+
+// OperatorLeaderElectionSpec configures the leader election of the operator replicas.
+type OperatorLeaderElectionSpec struct {
+	// Enabled enables the leader election.
+	// +kubebuilder:validation:Required
+	Enabled bool `json:"enabled"`
+}
+
+// OperatorCacheSpec configures the namespaces watched by the operator cache.
+type OperatorCacheSpec struct {
+	// Namespaces are added to the namespaces watched for the namespaced resources the operator
+	// caches, such as secrets, configmaps and deployments.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=64
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// OperatorWebhooksSpec configures the operator admission webhooks.
+type OperatorWebhooksSpec struct {
+	// Enabled registers the admission webhooks, true when not set.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Deprecations configures the deprecation phase of the deprecated APIs, the APIs not listed
+	// are denied. Applied at runtime.
+	// +optional
+	// +listType=map
+	// +listMapKey=group
+	// +listMapKey=kind
+	// +kubebuilder:validation:MaxItems=16
+	Deprecations []DeprecatedAPISpec `json:"deprecations,omitempty"`
+}
+
+// DeprecationPhase is a phase of the deprecation of an API: Warn allows the creation and the
+// update of resources with admission warnings, DenyNew denies their creation and DenyAll denies
+// their creation and their update.
+// +kubebuilder:validation:Enum=Warn;DenyNew;DenyAll
+type DeprecationPhase string
+
+// DeprecatedAPISpec configures the deprecation of an API.
+type DeprecatedAPISpec struct {
+	// Group of the deprecated API, e.g. dashboard.opendatahub.io.
+	// +kubebuilder:validation:Required
+	Group string `json:"group"`
+
+	// Kind of the deprecated API, e.g. AcceleratorProfile.
+	// +kubebuilder:validation:Required
+	Kind string `json:"kind"`
+
+	// Phase is applied until the first transition of the schedule.
+	// +optional
+	// +kubebuilder:default=DenyAll
+	Phase DeprecationPhase `json:"phase,omitempty"`
+
+	// Schedule moves the API to later phases at the given dates, in chronological order.
+	// +optional
+	// +kubebuilder:validation:MaxItems=8
+	Schedule []DeprecationPhaseTransition `json:"schedule,omitempty"`
+}
+
+// DeprecationPhaseTransition moves a deprecated API to a phase from the given date.
+type DeprecationPhaseTransition struct {
+	// Phase applied from the date.
+	// +kubebuilder:validation:Required
+	Phase DeprecationPhase `json:"phase"`
+
+	// From is the date the phase applies from.
+	// +kubebuilder:validation:Required
+	From metav1.Time `json:"from"`
+}
+
+// OperatorReconcileSpec configures the controllers of the operator.
+type OperatorReconcileSpec struct {
+	// MaxConcurrentReconciles is the number of resources each controller reconciles concurrently.
+	// Applied at runtime.
+	// +optional
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=16
+	MaxConcurrentReconciles int32 `json:"maxConcurrentReconciles,omitempty"`
+
+	// ReleaseStatus configures the images reported in the release status of the components.
+	// Applied at runtime.
+	// +optional
+	// +kubebuilder:default=Images
+	ReleaseStatus ReleaseStatusMode `json:"releaseStatus,omitempty"`
+}
+
+// ReleaseStatusMode configures the images reported in the release status of the components:
+// Images reports the images and the digests running in their Deployments, Revisions also reads
+// the upstream revision of the running images from their registry.
+// +kubebuilder:validation:Enum=Images;Revisions
+type ReleaseStatusMode string
+
+const (
+	ReleaseStatusImages    ReleaseStatusMode = "Images"
+	ReleaseStatusRevisions ReleaseStatusMode = "Revisions"
+)
+
+// OperatorConfigStatus defines the observed state of OperatorConfig
+type OperatorConfigStatus struct {
+	common.Status `json:",inline"`
+
+	// PendingRestart lists the settings that differ from the running configuration and are
+	// applied when the operator restarts.
+	// +optional
+	// +listType=set
+	PendingRestart []string `json:"pendingRestart,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'default-operatorconfig'",message="OperatorConfig name must be default-operatorconfig"
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`,description="Ready"
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`,description="Reason"
+// +kubebuilder:printcolumn:name="Pending Restart",type=string,JSONPath=`.status.pendingRestart`,description="Settings applied on restart"
+
+// OperatorConfig is the Schema for the operatorconfigs API
+type OperatorConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OperatorConfigSpec   `json:"spec,omitempty"`
+	Status OperatorConfigStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// OperatorConfigList contains a list of OperatorConfig
+type OperatorConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OperatorConfig `json:"items"`
+}
+
+func (c *OperatorConfig) GetStatus() *common.Status {
+	return &c.Status.Status
+}
+
+func (c *OperatorConfig) GetConditions() []common.Condition {
+	return c.Status.GetConditions()
+}
+
+func (c *OperatorConfig) SetConditions(conditions []common.Condition) {
+	c.Status.SetConditions(conditions)
+}
+
+// WebhooksEnabled returns true unless the admission webhooks are explicitly disabled.
+func (s *OperatorConfigSpec) WebhooksEnabled() bool {
+	return s.Webhooks.Enabled == nil || *s.Webhooks.Enabled
+}
+
+func init() {
+	SchemeBuilder.Register(&OperatorConfig{}, &OperatorConfigList{})
+}
@@ -22,12 +22,73 @@ package v1alpha1
 
 import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdditionalGatewaySpec) DeepCopyInto(out *AdditionalGatewaySpec) {
+	*out = *in
+	if in.Certificate != nil {
+		in, out := &in.Certificate, &out.Certificate
+		*out = new(v1.CertificateSpec)
+		**out = **in
+	}
+	if in.CertificateLifecycle != nil {
+		in, out := &in.CertificateLifecycle, &out.CertificateLifecycle
+		*out = new(CertificateLifecycleConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkPolicy != nil {
+		in, out := &in.NetworkPolicy, &out.NetworkPolicy
+		*out = new(NetworkPolicyConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdditionalGatewaySpec.
+func (in *AdditionalGatewaySpec) DeepCopy() *AdditionalGatewaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AdditionalGatewaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdditionalGatewayStatus) DeepCopyInto(out *AdditionalGatewayStatus) {
+	*out = *in
+	if in.Certificate != nil {
+		in, out := &in.Certificate, &out.Certificate
+		*out = new(GatewayCertificateStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdditionalGatewayStatus.
+func (in *AdditionalGatewayStatus) DeepCopy() *AdditionalGatewayStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AdditionalGatewayStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Alerting) DeepCopyInto(out *Alerting) {
 	*out = *in
+	if in.ServiceLevelObjectives != nil {
+		in, out := &in.ServiceLevelObjectives, &out.ServiceLevelObjectives
+		*out = make([]ServiceLevelObjective, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Alerting.
@@ -140,6 +201,83 @@ func (in *AuthStatus) DeepCopy() *AuthStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoInstrumentation) DeepCopyInto(out *AutoInstrumentation) {
+	*out = *in
+	if in.Java != nil {
+		in, out := &in.Java, &out.Java
+		*out = new(LanguageInstrumentation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeJS != nil {
+		in, out := &in.NodeJS, &out.NodeJS
+		*out = new(LanguageInstrumentation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Python != nil {
+		in, out := &in.Python, &out.Python
+		*out = new(LanguageInstrumentation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DotNet != nil {
+		in, out := &in.DotNet, &out.DotNet
+		*out = new(LanguageInstrumentation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Go != nil {
+		in, out := &in.Go, &out.Go
+		*out = new(LanguageInstrumentation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoInstrumentation.
+func (in *AutoInstrumentation) DeepCopy() *AutoInstrumentation {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoInstrumentation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateIssuerRef) DeepCopyInto(out *CertificateIssuerRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateIssuerRef.
+func (in *CertificateIssuerRef) DeepCopy() *CertificateIssuerRef {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateIssuerRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateLifecycleConfig) DeepCopyInto(out *CertificateLifecycleConfig) {
+	*out = *in
+	if in.IssuerRef != nil {
+		in, out := &in.IssuerRef, &out.IssuerRef
+		*out = new(CertificateIssuerRef)
+		**out = **in
+	}
+	out.Duration = in.Duration
+	out.RenewBefore = in.RenewBefore
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateLifecycleConfig.
+func (in *CertificateLifecycleConfig) DeepCopy() *CertificateLifecycleConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateLifecycleConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CookieConfig) DeepCopyInto(out *CookieConfig) {
 	*out = *in
@@ -174,6 +312,100 @@ func (in *DSCIMonitoring) DeepCopy() *DSCIMonitoring {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeprecatedAPISpec) DeepCopyInto(out *DeprecatedAPISpec) {
+	*out = *in
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = make([]DeprecationPhaseTransition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeprecatedAPISpec.
+func (in *DeprecatedAPISpec) DeepCopy() *DeprecatedAPISpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DeprecatedAPISpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeprecationPhaseTransition) DeepCopyInto(out *DeprecationPhaseTransition) {
+	*out = *in
+	in.From.DeepCopyInto(&out.From)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeprecationPhaseTransition.
+func (in *DeprecationPhaseTransition) DeepCopy() *DeprecationPhaseTransition {
+	if in == nil {
+		return nil
+	}
+	out := new(DeprecationPhaseTransition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressPolicyConfig) DeepCopyInto(out *EgressPolicyConfig) {
+	*out = *in
+	if in.AllowAPIServer != nil {
+		in, out := &in.AllowAPIServer, &out.AllowAPIServer
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowDNS != nil {
+		in, out := &in.AllowDNS, &out.AllowDNS
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowMonitoring != nil {
+		in, out := &in.AllowMonitoring, &out.AllowMonitoring
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AdditionalPeers != nil {
+		in, out := &in.AdditionalPeers, &out.AdditionalPeers
+		*out = make([]NetworkPolicyPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressPolicyConfig.
+func (in *EgressPolicyConfig) DeepCopy() *EgressPolicyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressPolicyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayCertificateStatus) DeepCopyInto(out *GatewayCertificateStatus) {
+	*out = *in
+	if in.NotAfter != nil {
+		in, out := &in.NotAfter, &out.NotAfter
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayCertificateStatus.
+func (in *GatewayCertificateStatus) DeepCopy() *GatewayCertificateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayCertificateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GatewayConfig) DeepCopyInto(out *GatewayConfig) {
 	*out = *in
@@ -246,6 +478,11 @@ func (in *GatewayConfigSpec) DeepCopyInto(out *GatewayConfigSpec) {
 		*out = new(v1.CertificateSpec)
 		**out = **in
 	}
+	if in.CertificateLifecycle != nil {
+		in, out := &in.CertificateLifecycle, &out.CertificateLifecycle
+		*out = new(CertificateLifecycleConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	out.Cookie = in.Cookie
 	out.AuthProxyTimeout = in.AuthProxyTimeout
 	if in.NetworkPolicy != nil {
@@ -253,6 +490,13 @@ func (in *GatewayConfigSpec) DeepCopyInto(out *GatewayConfigSpec) {
 		*out = new(NetworkPolicyConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AdditionalGateways != nil {
+		in, out := &in.AdditionalGateways, &out.AdditionalGateways
+		*out = make([]AdditionalGatewaySpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.VerifyProviderCertificate != nil {
 		in, out := &in.VerifyProviderCertificate, &out.VerifyProviderCertificate
 		*out = new(bool)
@@ -274,6 +518,25 @@ func (in *GatewayConfigSpec) DeepCopy() *GatewayConfigSpec {
 func (in *GatewayConfigStatus) DeepCopyInto(out *GatewayConfigStatus) {
 	*out = *in
 	in.Status.DeepCopyInto(&out.Status)
+	if in.Certificate != nil {
+		in, out := &in.Certificate, &out.Certificate
+		*out = new(GatewayCertificateStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdditionalGateways != nil {
+		in, out := &in.AdditionalGateways, &out.AdditionalGateways
+		*out = make([]AdditionalGatewayStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NetworkPolicies != nil {
+		in, out := &in.NetworkPolicies, &out.NetworkPolicies
+		*out = make([]GeneratedNetworkPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayConfigStatus.
@@ -286,9 +549,36 @@ func (in *GatewayConfigStatus) DeepCopy() *GatewayConfigStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeneratedNetworkPolicy) DeepCopyInto(out *GeneratedNetworkPolicy) {
+	*out = *in
+	if in.PolicyTypes != nil {
+		in, out := &in.PolicyTypes, &out.PolicyTypes
+		*out = make([]networkingv1.PolicyType, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratedNetworkPolicy.
+func (in *GeneratedNetworkPolicy) DeepCopy() *GeneratedNetworkPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(GeneratedNetworkPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IngressPolicyConfig) DeepCopyInto(out *IngressPolicyConfig) {
 	*out = *in
+	if in.AdditionalPeers != nil {
+		in, out := &in.AdditionalPeers, &out.AdditionalPeers
+		*out = make([]NetworkPolicyPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressPolicyConfig.
@@ -302,101 +592,257 @@ func (in *IngressPolicyConfig) DeepCopy() *IngressPolicyConfig {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Metrics) DeepCopyInto(out *Metrics) {
+func (in *InstrumentationPolicy) DeepCopyInto(out *InstrumentationPolicy) {
 	*out = *in
-	if in.Storage != nil {
-		in, out := &in.Storage, &out.Storage
-		*out = new(MetricsStorage)
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Exporters != nil {
-		in, out := &in.Exporters, &out.Exporters
-		*out = make(map[string]runtime.RawExtension, len(*in))
+	if in.WorkloadKinds != nil {
+		in, out := &in.WorkloadKinds, &out.WorkloadKinds
+		*out = make([]InstrumentationWorkloadKind, len(*in))
+		copy(*out, *in)
+	}
+	if in.AutoInstrumentation != nil {
+		in, out := &in.AutoInstrumentation, &out.AutoInstrumentation
+		*out = new(AutoInstrumentation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceAttributes != nil {
+		in, out := &in.ResourceAttributes, &out.ResourceAttributes
+		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
-			(*out)[key] = *val.DeepCopy()
+			(*out)[key] = val
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Metrics.
-func (in *Metrics) DeepCopy() *Metrics {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstrumentationPolicy.
+func (in *InstrumentationPolicy) DeepCopy() *InstrumentationPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(Metrics)
+	out := new(InstrumentationPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MetricsStorage) DeepCopyInto(out *MetricsStorage) {
+func (in *InstrumentationPolicyStatus) DeepCopyInto(out *InstrumentationPolicyStatus) {
 	*out = *in
-	out.Size = in.Size.DeepCopy()
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsStorage.
-func (in *MetricsStorage) DeepCopy() *MetricsStorage {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstrumentationPolicyStatus.
+func (in *InstrumentationPolicyStatus) DeepCopy() *InstrumentationPolicyStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MetricsStorage)
+	out := new(InstrumentationPolicyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Monitoring) DeepCopyInto(out *Monitoring) {
+func (in *LanguageInstrumentation) DeepCopyInto(out *LanguageInstrumentation) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]corev1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Monitoring.
-func (in *Monitoring) DeepCopy() *Monitoring {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LanguageInstrumentation.
+func (in *LanguageInstrumentation) DeepCopy() *LanguageInstrumentation {
 	if in == nil {
 		return nil
 	}
-	out := new(Monitoring)
+	out := new(LanguageInstrumentation)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Monitoring) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LatencyIndicator) DeepCopyInto(out *LatencyIndicator) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LatencyIndicator.
+func (in *LatencyIndicator) DeepCopy() *LatencyIndicator {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(LatencyIndicator)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MonitoringCommonSpec) DeepCopyInto(out *MonitoringCommonSpec) {
+func (in *Logs) DeepCopyInto(out *Logs) {
 	*out = *in
-	if in.Metrics != nil {
-		in, out := &in.Metrics, &out.Metrics
-		*out = new(Metrics)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Traces != nil {
-		in, out := &in.Traces, &out.Traces
-		*out = new(Traces)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Alerting != nil {
-		in, out := &in.Alerting, &out.Alerting
-		*out = new(Alerting)
-		**out = **in
+	if in.Exporters != nil {
+		in, out := &in.Exporters, &out.Exporters
+		*out = make(map[string]runtime.RawExtension, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringCommonSpec.
-func (in *MonitoringCommonSpec) DeepCopy() *MonitoringCommonSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Logs.
+func (in *Logs) DeepCopy() *Logs {
 	if in == nil {
 		return nil
 	}
-	out := new(MonitoringCommonSpec)
+	out := new(Logs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Metrics) DeepCopyInto(out *Metrics) {
+	*out = *in
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(MetricsStorage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Exporters != nil {
+		in, out := &in.Exporters, &out.Exporters
+		*out = make(map[string]runtime.RawExtension, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Metrics.
+func (in *Metrics) DeepCopy() *Metrics {
+	if in == nil {
+		return nil
+	}
+	out := new(Metrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricsStorage) DeepCopyInto(out *MetricsStorage) {
+	*out = *in
+	out.Size = in.Size.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsStorage.
+func (in *MetricsStorage) DeepCopy() *MetricsStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricsStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Monitoring) DeepCopyInto(out *Monitoring) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Monitoring.
+func (in *Monitoring) DeepCopy() *Monitoring {
+	if in == nil {
+		return nil
+	}
+	out := new(Monitoring)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Monitoring) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitoringBackendStatus) DeepCopyInto(out *MonitoringBackendStatus) {
+	*out = *in
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]MonitoringEndpoint, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringBackendStatus.
+func (in *MonitoringBackendStatus) DeepCopy() *MonitoringBackendStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitoringBackendStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitoringCommonSpec) DeepCopyInto(out *MonitoringCommonSpec) {
+	*out = *in
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = new(Metrics)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Traces != nil {
+		in, out := &in.Traces, &out.Traces
+		*out = new(Traces)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Logs != nil {
+		in, out := &in.Logs, &out.Logs
+		*out = new(Logs)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Alerting != nil {
+		in, out := &in.Alerting, &out.Alerting
+		*out = new(Alerting)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringCommonSpec.
+func (in *MonitoringCommonSpec) DeepCopy() *MonitoringCommonSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitoringCommonSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitoringEndpoint) DeepCopyInto(out *MonitoringEndpoint) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringEndpoint.
+func (in *MonitoringEndpoint) DeepCopy() *MonitoringEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitoringEndpoint)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -453,6 +899,25 @@ func (in *MonitoringSpec) DeepCopy() *MonitoringSpec {
 func (in *MonitoringStatus) DeepCopyInto(out *MonitoringStatus) {
 	*out = *in
 	in.Status.DeepCopyInto(&out.Status)
+	if in.InstrumentationPolicies != nil {
+		in, out := &in.InstrumentationPolicies, &out.InstrumentationPolicies
+		*out = make([]InstrumentationPolicyStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Backends != nil {
+		in, out := &in.Backends, &out.Backends
+		*out = make([]MonitoringBackendStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ServiceLevelObjectives != nil {
+		in, out := &in.ServiceLevelObjectives, &out.ServiceLevelObjectives
+		*out = make([]ServiceLevelObjectiveStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringStatus.
@@ -471,7 +936,12 @@ func (in *NetworkPolicyConfig) DeepCopyInto(out *NetworkPolicyConfig) {
 	if in.Ingress != nil {
 		in, out := &in.Ingress, &out.Ingress
 		*out = new(IngressPolicyConfig)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = new(EgressPolicyConfig)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -485,6 +955,43 @@ func (in *NetworkPolicyConfig) DeepCopy() *NetworkPolicyConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyPeer) DeepCopyInto(out *NetworkPolicyPeer) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodSelector != nil {
+		in, out := &in.PodSelector, &out.PodSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Except != nil {
+		in, out := &in.Except, &out.Except
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]networkingv1.NetworkPolicyPort, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyPeer.
+func (in *NetworkPolicyPeer) DeepCopy() *NetworkPolicyPeer {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyPeer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OIDCConfig) DeepCopyInto(out *OIDCConfig) {
 	*out = *in
@@ -501,6 +1008,274 @@ func (in *OIDCConfig) DeepCopy() *OIDCConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorCacheSpec) DeepCopyInto(out *OperatorCacheSpec) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorCacheSpec.
+func (in *OperatorCacheSpec) DeepCopy() *OperatorCacheSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorCacheSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorConfig) DeepCopyInto(out *OperatorConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorConfig.
+func (in *OperatorConfig) DeepCopy() *OperatorConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OperatorConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorConfigList) DeepCopyInto(out *OperatorConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OperatorConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorConfigList.
+func (in *OperatorConfigList) DeepCopy() *OperatorConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OperatorConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorConfigSpec) DeepCopyInto(out *OperatorConfigSpec) {
+	*out = *in
+	out.Logging = in.Logging
+	if in.LeaderElection != nil {
+		in, out := &in.LeaderElection, &out.LeaderElection
+		*out = new(OperatorLeaderElectionSpec)
+		**out = **in
+	}
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Cache.DeepCopyInto(&out.Cache)
+	in.Webhooks.DeepCopyInto(&out.Webhooks)
+	if in.DefaultManagementStates != nil {
+		in, out := &in.DefaultManagementStates, &out.DefaultManagementStates
+		*out = make(map[string]operatorv1.ManagementState, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	out.Reconcile = in.Reconcile
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorConfigSpec.
+func (in *OperatorConfigSpec) DeepCopy() *OperatorConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorConfigStatus) DeepCopyInto(out *OperatorConfigStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	if in.PendingRestart != nil {
+		in, out := &in.PendingRestart, &out.PendingRestart
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorConfigStatus.
+func (in *OperatorConfigStatus) DeepCopy() *OperatorConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorLeaderElectionSpec) DeepCopyInto(out *OperatorLeaderElectionSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorLeaderElectionSpec.
+func (in *OperatorLeaderElectionSpec) DeepCopy() *OperatorLeaderElectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorLeaderElectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorLoggingSpec) DeepCopyInto(out *OperatorLoggingSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorLoggingSpec.
+func (in *OperatorLoggingSpec) DeepCopy() *OperatorLoggingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorLoggingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorReconcileSpec) DeepCopyInto(out *OperatorReconcileSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorReconcileSpec.
+func (in *OperatorReconcileSpec) DeepCopy() *OperatorReconcileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorReconcileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorWebhooksSpec) DeepCopyInto(out *OperatorWebhooksSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Deprecations != nil {
+		in, out := &in.Deprecations, &out.Deprecations
+		*out = make([]DeprecatedAPISpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorWebhooksSpec.
+func (in *OperatorWebhooksSpec) DeepCopy() *OperatorWebhooksSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorWebhooksSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RatioIndicator) DeepCopyInto(out *RatioIndicator) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RatioIndicator.
+func (in *RatioIndicator) DeepCopy() *RatioIndicator {
+	if in == nil {
+		return nil
+	}
+	out := new(RatioIndicator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceLevelObjective) DeepCopyInto(out *ServiceLevelObjective) {
+	*out = *in
+	if in.Ratio != nil {
+		in, out := &in.Ratio, &out.Ratio
+		*out = new(RatioIndicator)
+		**out = **in
+	}
+	if in.Latency != nil {
+		in, out := &in.Latency, &out.Latency
+		*out = new(LatencyIndicator)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLevelObjective.
+func (in *ServiceLevelObjective) DeepCopy() *ServiceLevelObjective {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceLevelObjective)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceLevelObjectiveStatus) DeepCopyInto(out *ServiceLevelObjectiveStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLevelObjectiveStatus.
+func (in *ServiceLevelObjectiveStatus) DeepCopy() *ServiceLevelObjectiveStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceLevelObjectiveStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Traces) DeepCopyInto(out *Traces) {
 	*out = *in
@@ -517,6 +1292,13 @@ func (in *Traces) DeepCopyInto(out *Traces) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.InstrumentationPolicies != nil {
+		in, out := &in.InstrumentationPolicies, &out.InstrumentationPolicies
+		*out = make([]InstrumentationPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Traces.
@@ -13,19 +13,44 @@ func newLogger() *logrus.Logger {
 	return logger
 }
 
+var (
+	generateOpts generator.Options
+	generateAll  bool
+)
+
 var generateCmd = &cobra.Command{
 	Use:   "generate [component-name]",
 	Short: "Generates boilerplate folders/files for a new component",
-	Args:  cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
+	Long: `Generates boilerplate folders/files for a new component.
+
+The command can be run again on an existing component, e.g. to add the optional
+parts: files which already exist are left untouched and the edits of the existing
+sources are skipped when already applied.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
 		logger := newLogger()
 		componentName := args[0]
-		if err := generator.GenerateComponent(logger, componentName); err != nil {
+
+		opts := generateOpts
+		if generateAll {
+			opts = generator.Options{Tests: true, Monitoring: true, Webhook: true, E2E: true}
+		}
+
+		if err := generator.GenerateComponent(logger, componentName, opts); err != nil {
 			logger.Errorf("Failed to generate component: %v", err)
+			return err
 		}
+
+		return nil
 	},
 }
 
 func init() { //nolint:gochecknoinits
+	generateCmd.Flags().BoolVar(&generateOpts.Tests, "with-tests", false, "Generate the unit tests of the component handler and of the webhook")
+	generateCmd.Flags().BoolVar(&generateOpts.Monitoring, "with-monitoring", false, "Generate the PrometheusRule template and the alerting unit tests of the component")
+	generateCmd.Flags().BoolVar(&generateOpts.Webhook, "with-webhook", false, "Generate a validating webhook for the component resource")
+	generateCmd.Flags().BoolVar(&generateOpts.E2E, "with-e2e", false, "Generate the e2e test suite of the component")
+	generateCmd.Flags().BoolVar(&generateAll, "all", false, "Generate all the optional parts of the component")
+
 	rootCmd.AddCommand(generateCmd)
 }
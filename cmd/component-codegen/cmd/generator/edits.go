@@ -0,0 +1,341 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+const modulePath = "github.com/opendatahub-io/opendatahub-operator/v2"
+
+// edit replaces the bytes of a source file in [start, end) with text. Edits are applied at
+// positions found in the AST, rather than by printing the modified AST, so the comments and
+// the layout of the file are preserved.
+type edit struct {
+	start int
+	end   int
+	text  string
+}
+
+func insertAt(offset int, text string) edit {
+	return edit{start: offset, end: offset, text: text}
+}
+
+func parseSource(fp string) (*token.FileSet, *ast.File, []byte, error) {
+	src, err := os.ReadFile(fp)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error reading file: %w", err)
+	}
+
+	fs := token.NewFileSet()
+
+	node, err := parser.ParseFile(fs, fp, src, parser.ParseComments)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error parsing file: %w", err)
+	}
+
+	return fs, node, src, nil
+}
+
+// applyEdits applies the edits, which must not overlap, to src.
+func applyEdits(src []byte, edits []edit) []byte {
+	slices.SortFunc(edits, func(a, b edit) int {
+		return b.start - a.start
+	})
+
+	out := slices.Clone(src)
+	for _, e := range edits {
+		out = slices.Concat(out[:e.start], []byte(e.text), out[e.end:])
+	}
+
+	return out
+}
+
+// writeEdits applies the edits to src, formats the result and writes it to fp.
+func writeEdits(fp string, src []byte, edits []edit) error {
+	formatted, err := format.Source(applyEdits(src, edits))
+	if err != nil {
+		return fmt.Errorf("error formatting code: %w", err)
+	}
+
+	return os.WriteFile(fp, formatted, FilePerm)
+}
+
+// writeSource applies the edits to src and writes the result to fp, formatting Go sources.
+func writeSource(fp string, src []byte, edits []edit) error {
+	if filepath.Ext(fp) == ".go" {
+		return writeEdits(fp, src, edits)
+	}
+
+	return os.WriteFile(fp, applyEdits(src, edits), FilePerm)
+}
+
+// appendElement returns the edit adding an element at the end of a multi-line composite
+// literal.
+func appendElement(fs *token.FileSet, lit *ast.CompositeLit, element string) edit {
+	return insertAt(fs.Position(lit.Rbrace).Offset, element+",\n")
+}
+
+func hasKey(lit *ast.CompositeLit, key string) bool {
+	return slices.ContainsFunc(lit.Elts, func(e ast.Expr) bool {
+		kv, ok := e.(*ast.KeyValueExpr)
+		return ok && types.ExprString(kv.Key) == key
+	})
+}
+
+func hasElement(lit *ast.CompositeLit, element string) bool {
+	return slices.ContainsFunc(lit.Elts, func(e ast.Expr) bool {
+		return types.ExprString(e) == element
+	})
+}
+
+func hasImport(file *ast.File, path string) bool {
+	return slices.ContainsFunc(file.Imports, func(s *ast.ImportSpec) bool {
+		p, err := strconv.Unquote(s.Path.Value)
+		return err == nil && p == path
+	})
+}
+
+// addConversion wires the component in the conversion of the v1 DataScienceCluster. As the
+// component does not exist in v1, it is converted as Removed.
+func addConversion(logger *logrus.Logger, componentName string) error {
+	fs, file, src, err := parseSource(DscConversionPath)
+	if err != nil {
+		return err
+	}
+
+	removed := "{ManagementSpec: common.ManagementSpec{ManagementState: operatorv1.Removed}}"
+	values := map[string]string{
+		"dscv2.Components":       fmt.Sprintf("componentApi.DSC%s%s", componentName, removed),
+		"dscv2.ComponentsStatus": fmt.Sprintf("componentApi.DSC%sStatus%s", componentName, removed),
+	}
+
+	var edits []edit
+
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Name.Name != "ConvertTo" {
+			continue
+		}
+
+		ast.Inspect(fn, func(n ast.Node) bool {
+			lit, ok := n.(*ast.CompositeLit)
+			if !ok || lit.Type == nil {
+				return true
+			}
+
+			value, ok := values[types.ExprString(lit.Type)]
+			if ok && !hasKey(lit, componentName) {
+				edits = append(edits, appendElement(fs, lit, componentName+": "+value))
+			}
+
+			return true
+		})
+	}
+
+	if len(edits) == 0 {
+		logger.Infof("%s already wired in %s", componentName, DscConversionPath)
+		return nil
+	}
+
+	if err := writeEdits(DscConversionPath, src, edits); err != nil {
+		return err
+	}
+
+	logger.Infof("Successfully added %s to %s", componentName, DscConversionPath)
+	return nil
+}
+
+// addMonitoringEmbed embeds the monitoring folder of the component in the FS from which the
+// monitoring service reads the PrometheusRule templates.
+func addMonitoringEmbed(logger *logrus.Logger, lc string) error {
+	fs, file, src, err := parseSource(componentsFilePath)
+	if err != nil {
+		return err
+	}
+
+	directive := fmt.Sprintf("//go:embed %s/monitoring", lc)
+
+	for _, decl := range file.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.VAR || !declares(gd, "ComponentRulesFS") {
+			continue
+		}
+
+		if gd.Doc != nil && slices.ContainsFunc(gd.Doc.List, func(c *ast.Comment) bool { return c.Text == directive }) {
+			logger.Infof("Monitoring rules of %s already embedded in %s", lc, componentsFilePath)
+			return nil
+		}
+
+		err := writeEdits(componentsFilePath, src, []edit{
+			insertAt(fs.Position(gd.Pos()).Offset, directive+"\n"),
+		})
+		if err != nil {
+			return err
+		}
+
+		logger.Infof("Successfully embedded monitoring rules of %s in %s", lc, componentsFilePath)
+		return nil
+	}
+
+	return fmt.Errorf("ComponentRulesFS not found in %s", componentsFilePath)
+}
+
+// addWebhookRegistration adds the webhooks of the component to RegisterAllWebhooks.
+func addWebhookRegistration(logger *logrus.Logger, lc string) error {
+	fs, file, src, err := parseSource(webhookFilePath)
+	if err != nil {
+		return err
+	}
+
+	alias := lc + "webhook"
+	importPath := fmt.Sprintf("%s/%s/%s", modulePath, Webhooks, lc)
+	register := alias + ".RegisterWebhooks"
+
+	var edits []edit
+
+	if !hasImport(file, importPath) {
+		for _, decl := range file.Decls {
+			if gd, ok := decl.(*ast.GenDecl); ok && gd.Tok == token.IMPORT && gd.Rparen.IsValid() {
+				edits = append(edits, insertAt(fs.Position(gd.Rparen).Offset, fmt.Sprintf("%s %q\n", alias, importPath)))
+				break
+			}
+		}
+	}
+
+	found := false
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		lit, ok := n.(*ast.CompositeLit)
+		if !ok || lit.Type == nil || types.ExprString(lit.Type) != "[]func(ctrl.Manager) error" {
+			return true
+		}
+
+		found = true
+		if !hasElement(lit, register) {
+			edits = append(edits, appendElement(fs, lit, register))
+		}
+
+		return false
+	})
+
+	if !found {
+		return fmt.Errorf("webhook registrations not found in %s", webhookFilePath)
+	}
+
+	if len(edits) == 0 {
+		logger.Infof("Webhooks of %s already registered in %s", lc, webhookFilePath)
+		return nil
+	}
+
+	if err := writeEdits(webhookFilePath, src, edits); err != nil {
+		return err
+	}
+
+	logger.Infof("Successfully registered webhooks of %s in %s", lc, webhookFilePath)
+	return nil
+}
+
+// addE2ETestSuite adds the e2e test suite of the component to the first scenario of the
+// components test group, which runs in parallel with the other independent components.
+func addE2ETestSuite(logger *logrus.Logger, componentName string) error {
+	fs, file, src, err := parseSource(e2eSuitePath)
+	if err != nil {
+		return err
+	}
+
+	key := fmt.Sprintf("componentApi.%sComponentName", componentName)
+
+	var scenarios *ast.CompositeLit
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		vs, ok := n.(*ast.ValueSpec)
+		if !ok {
+			return true
+		}
+
+		for i, name := range vs.Names {
+			if name.Name != "Components" || i >= len(vs.Values) {
+				continue
+			}
+
+			if group, ok := vs.Values[i].(*ast.CompositeLit); ok {
+				scenarios = field(group, "scenarios")
+			}
+		}
+
+		return false
+	})
+
+	if scenarios == nil || len(scenarios.Elts) == 0 {
+		return fmt.Errorf("components test scenarios not found in %s", e2eSuitePath)
+	}
+
+	for _, e := range scenarios.Elts {
+		if s, ok := e.(*ast.CompositeLit); ok && hasKey(s, key) {
+			logger.Infof("e2e test suite of %s already registered in %s", componentName, e2eSuitePath)
+			return nil
+		}
+	}
+
+	first, ok := scenarios.Elts[0].(*ast.CompositeLit)
+	if !ok {
+		return fmt.Errorf("unexpected components test scenario in %s", e2eSuitePath)
+	}
+
+	err = writeEdits(e2eSuitePath, src, []edit{
+		appendElement(fs, first, fmt.Sprintf("%s: %sTestSuite", key, lowerFirst(componentName))),
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.Infof("Successfully registered e2e test suite of %s in %s", componentName, e2eSuitePath)
+	return nil
+}
+
+func declares(gd *ast.GenDecl, name string) bool {
+	return slices.ContainsFunc(gd.Specs, func(s ast.Spec) bool {
+		vs, ok := s.(*ast.ValueSpec)
+		return ok && slices.ContainsFunc(vs.Names, func(n *ast.Ident) bool { return n.Name == name })
+	})
+}
+
+// field returns the value of the field name of a struct literal, if it is a composite literal.
+func field(lit *ast.CompositeLit, name string) *ast.CompositeLit {
+	for _, e := range lit.Elts {
+		kv, ok := e.(*ast.KeyValueExpr)
+		if !ok || types.ExprString(kv.Key) != name {
+			continue
+		}
+
+		if v, ok := kv.Value.(*ast.CompositeLit); ok {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// containsLine reports whether the file at fp contains a line equal to line, ignoring the
+// surrounding spaces.
+func containsLine(fp string, line string) (bool, error) {
+	data, err := os.ReadFile(fp)
+	if err != nil {
+		return false, fmt.Errorf("error reading file: %w", err)
+	}
+
+	return slices.ContainsFunc(strings.Split(string(data), "\n"), func(l string) bool {
+		return strings.TrimSpace(l) == line
+	}), nil
+}
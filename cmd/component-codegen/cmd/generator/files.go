@@ -19,6 +19,9 @@ func generateFilesFromTemplate(logger *logrus.Logger, componentName string, p Pa
 	outputPath := strings.ToLower(p.OutputPath)
 	templatePath := p.TemplatePath
 	componentFileName := strings.ToLower(componentName) + suffix
+	if p.FileName != "" {
+		componentFileName = p.FileName
+	}
 	op := filepath.Join(outputPath, componentFileName)
 	if fileExists(op) {
 		logger.Warnf("File already exists: %s", op)
@@ -30,8 +33,13 @@ func generateFilesFromTemplate(logger *logrus.Logger, componentName string, p Pa
 		return fmt.Errorf("error reading template: %w", err)
 	}
 
-	funcMap := template.FuncMap{"lowercase": strings.ToLower}
-	tmpl, err := template.New("template").Funcs(funcMap).Parse(string(content))
+	funcMap := template.FuncMap{"lowercase": strings.ToLower, "lowerfirst": lowerFirst}
+	tmpl := template.New("template").Funcs(funcMap)
+	if p.Delims != [2]string{} {
+		tmpl = tmpl.Delims(p.Delims[0], p.Delims[1])
+	}
+
+	tmpl, err = tmpl.Parse(string(content))
 	if err != nil {
 		return fmt.Errorf("error parsing template: %w", err)
 	}
@@ -2,33 +2,114 @@ package generator
 
 import (
 	"path/filepath"
+	"strings"
 
 	"github.com/sirupsen/logrus"
 )
 
 const (
-	cmdDir          = "cmd/component-codegen"
-	ApisDir         = "api/components/v1alpha1"
-	Controllers     = "internal/controller/components"
-	DscTypesPath    = "api/datasciencecluster/v2/datasciencecluster_types.go"
-	templatesDir    = cmdDir + "/templates"
-	mainFilePath    = "cmd/main.go"
-	projectFilePath = "PROJECT"
+	cmdDir             = "cmd/component-codegen"
+	ApisDir            = "api/components/v1alpha1"
+	Controllers        = "internal/controller/components"
+	Webhooks           = "internal/webhook"
+	E2ETests           = "tests/e2e"
+	DscTypesPath       = "api/datasciencecluster/v2/datasciencecluster_types.go"
+	DscDeepCopyPath    = "api/datasciencecluster/v2/zz_generated.deepcopy.go"
+	DscConversionPath  = "api/datasciencecluster/v1/datasciencecluster_conversion.go"
+	templatesDir       = cmdDir + "/templates"
+	mainFilePath       = "cmd/main.go"
+	projectFilePath    = "PROJECT"
+	componentsFilePath = Controllers + "/components.go"
+	webhookFilePath    = Webhooks + "/webhook.go"
+	e2eSuitePath       = E2ETests + "/controller_test.go"
+	deepCopyFilePath   = ApisDir + "/zz_generated.deepcopy.go"
+	rbacFilePath       = "internal/controller/datasciencecluster/kubebuilder_rbac.go"
+	upgradeDir         = "pkg/upgrade"
+	upgradeFilePath    = upgradeDir + "/upgrade.go"
 )
 
+// yamlDelims are the delimiters of the templates generating files which are Go templates
+// themselves, such as the PrometheusRule templates.
+var yamlDelims = [2]string{"[[", "]]"}
+
 type PathConfig struct {
 	Suffix       string
 	OutputPath   string
 	TemplatePath string
+	// FileName overrides the name of the generated file, which defaults to the lowercase
+	// component name followed by Suffix.
+	FileName string
+	Delims   [2]string
+}
+
+// Options selects the optional parts of the component to scaffold on top of the API types
+// and the controller.
+type Options struct {
+	// Tests scaffolds the unit tests of the component handler and of the webhook.
+	Tests bool
+	// Monitoring scaffolds the PrometheusRule template expected by the monitoring service
+	// and its alerting unit tests.
+	Monitoring bool
+	// Webhook scaffolds a validating webhook for the component resource.
+	Webhook bool
+	// E2E scaffolds the e2e test suite of the component.
+	E2E bool
 }
 
-func GenerateComponent(logger *logrus.Logger, componentName string) error {
+// GenerateComponent scaffolds a new component. Files which already exist are left untouched
+// and the edits of the existing sources are skipped when already applied, so the command can
+// be run again, e.g. to add optional parts.
+func GenerateComponent(logger *logrus.Logger, componentName string, opts Options) error {
+	lc := strings.ToLower(componentName)
+
 	paths := []PathConfig{
-		{"_types.go", filepath.Join(ApisDir), filepath.Join(templatesDir, "types.go.tmpl")},
-		{".go", filepath.Join(Controllers, componentName), filepath.Join(templatesDir, "component_handler.go.tmpl")},
-		{"_support.go", filepath.Join(Controllers, componentName), filepath.Join(templatesDir, "component_support.go.tmpl")},
-		{"_controller_actions.go", filepath.Join(Controllers, componentName), filepath.Join(templatesDir, "component_controller_actions.go.tmpl")},
-		{"_controller.go", filepath.Join(Controllers, componentName), filepath.Join(templatesDir, "component_controller.go.tmpl")},
+		{Suffix: "_types.go", OutputPath: ApisDir, TemplatePath: filepath.Join(templatesDir, "types.go.tmpl")},
+		{Suffix: ".go", OutputPath: filepath.Join(Controllers, componentName), TemplatePath: filepath.Join(templatesDir, "component_handler.go.tmpl")},
+		{Suffix: "_support.go", OutputPath: filepath.Join(Controllers, componentName), TemplatePath: filepath.Join(templatesDir, "component_support.go.tmpl")},
+		{Suffix: "_controller_actions.go", OutputPath: filepath.Join(Controllers, componentName), TemplatePath: filepath.Join(templatesDir, "component_controller_actions.go.tmpl")},
+		{Suffix: "_controller.go", OutputPath: filepath.Join(Controllers, componentName), TemplatePath: filepath.Join(templatesDir, "component_controller.go.tmpl")},
+	}
+
+	if opts.Tests {
+		paths = append(paths,
+			PathConfig{Suffix: "_test.go", OutputPath: filepath.Join(Controllers, componentName), TemplatePath: filepath.Join(templatesDir, "component_test.go.tmpl")},
+		)
+	}
+
+	if opts.Monitoring {
+		paths = append(paths,
+			PathConfig{
+				Suffix:       "-prometheusrules.tmpl.yaml",
+				OutputPath:   filepath.Join(Controllers, componentName, "monitoring"),
+				TemplatePath: filepath.Join(templatesDir, "component_prometheusrules.tmpl.yaml.tmpl"),
+				Delims:       yamlDelims,
+			},
+			PathConfig{
+				Suffix:       "-alerting.unit-tests.yaml",
+				OutputPath:   filepath.Join(Controllers, componentName, "monitoring"),
+				TemplatePath: filepath.Join(templatesDir, "component_alerting.unit-tests.yaml.tmpl"),
+				Delims:       yamlDelims,
+			},
+		)
+	}
+
+	if opts.Webhook {
+		paths = append(paths,
+			PathConfig{FileName: "register.go", OutputPath: filepath.Join(Webhooks, componentName), TemplatePath: filepath.Join(templatesDir, "webhook_register.go.tmpl")},
+			PathConfig{FileName: "validating.go", OutputPath: filepath.Join(Webhooks, componentName), TemplatePath: filepath.Join(templatesDir, "webhook_validating.go.tmpl")},
+		)
+
+		if opts.Tests {
+			paths = append(paths,
+				PathConfig{FileName: "validating_test.go", OutputPath: filepath.Join(Webhooks, componentName), TemplatePath: filepath.Join(templatesDir, "webhook_validating_test.go.tmpl")},
+			)
+		}
+	}
+
+	if opts.E2E {
+		paths = append(paths,
+			PathConfig{Suffix: "_test.go", OutputPath: E2ETests, TemplatePath: filepath.Join(templatesDir, "e2e_test.go.tmpl")},
+		)
 	}
 
 	for _, p := range paths {
@@ -44,6 +125,28 @@ func GenerateComponent(logger *logrus.Logger, componentName string) error {
 		}
 	}
 
+	if err := addConversion(logger, componentName); err != nil {
+		return err
+	}
+
+	if opts.Monitoring {
+		if err := addMonitoringEmbed(logger, lc); err != nil {
+			return err
+		}
+	}
+
+	if opts.Webhook {
+		if err := addWebhookRegistration(logger, lc); err != nil {
+			return err
+		}
+	}
+
+	if opts.E2E {
+		if err := addE2ETestSuite(logger, componentName); err != nil {
+			return err
+		}
+	}
+
 	if err := addKubeBuilderRBAC(logger, componentName); err != nil {
 		return err
 	}
@@ -0,0 +1,298 @@
+package generator
+
+import (
+	"go/parser"
+	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+)
+
+// repoRoot is the root of the operator repository, resolved before the tests change the
+// working directory.
+var repoRoot, _ = filepath.Abs("../../../..")
+
+// editedFiles are the existing sources edited by the generator.
+var editedFiles = []string{
+	DscTypesPath,
+	DscDeepCopyPath,
+	DscConversionPath,
+	mainFilePath,
+	projectFilePath,
+	componentsFilePath,
+	webhookFilePath,
+	e2eSuitePath,
+	rbacFilePath,
+	upgradeFilePath,
+	deepCopyFilePath,
+}
+
+func setupRepo(t *testing.T) string {
+	t.Helper()
+
+	root := repoRoot
+	dir := t.TempDir()
+
+	for _, f := range editedFiles {
+		data, err := os.ReadFile(filepath.Join(root, f))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.MkdirAll(filepath.Join(dir, filepath.Dir(f)), os.ModePerm); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(filepath.Join(dir, f), data, FilePerm); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Join(dir, cmdDir), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Symlink(filepath.Join(root, templatesDir), filepath.Join(dir, templatesDir)); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Chdir(dir)
+
+	return dir
+}
+
+// snapshot returns the content of the files generated or edited in dir.
+func snapshot(t *testing.T, dir string) map[string]string {
+	t.Helper()
+
+	files := map[string]string{}
+
+	err := filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			if rel == cmdDir {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		files[rel] = string(data)
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return files
+}
+
+var allOptions = Options{Tests: true, Monitoring: true, Webhook: true, E2E: true}
+
+func newTestLogger() *logrus.Logger {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	return logger
+}
+
+func assertSnapshot(t *testing.T, expected map[string]string, actual map[string]string) {
+	t.Helper()
+
+	for f, content := range expected {
+		got, ok := actual[f]
+		switch {
+		case !ok:
+			t.Errorf("expected %s to exist", f)
+		case got != content:
+			t.Errorf("unexpected content of %s:\n%s", f, got)
+		}
+	}
+
+	for f := range actual {
+		if _, ok := expected[f]; !ok {
+			t.Errorf("unexpected file %s", f)
+		}
+	}
+}
+
+func TestGenerateComponent(t *testing.T) {
+	dir := setupRepo(t)
+
+	logger := newTestLogger()
+	opts := allOptions
+
+	if err := GenerateComponent(logger, "FooBar", opts); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	generated := snapshot(t, dir)
+
+	expected := []string{
+		"api/components/v1alpha1/foobar_types.go",
+		"internal/controller/components/foobar/foobar.go",
+		"internal/controller/components/foobar/foobar_test.go",
+		"internal/controller/components/foobar/monitoring/foobar-prometheusrules.tmpl.yaml",
+		"internal/controller/components/foobar/monitoring/foobar-alerting.unit-tests.yaml",
+		"internal/webhook/foobar/register.go",
+		"internal/webhook/foobar/validating.go",
+		"internal/webhook/foobar/validating_test.go",
+		"tests/e2e/foobar_test.go",
+	}
+
+	for _, f := range expected {
+		if _, ok := generated[f]; !ok {
+			t.Errorf("expected %s to be generated", f)
+		}
+	}
+
+	edits := map[string]string{
+		DscTypesPath:       "FooBar componentApi.DSCFooBar",
+		DscConversionPath:  "FooBar: componentApi.DSCFooBarStatus{",
+		mainFilePath:       `"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/foobar"`,
+		projectFilePath:    "kind: FooBar\n",
+		componentsFilePath: "//go:embed foobar/monitoring\n",
+		webhookFilePath:    "foobarwebhook.RegisterWebhooks,",
+		e2eSuitePath:       "componentApi.FooBarComponentName:",
+	}
+
+	for f, s := range edits {
+		if !strings.Contains(generated[f], s) {
+			t.Errorf("expected %s to contain %q", f, s)
+		}
+	}
+
+	fs := token.NewFileSet()
+
+	for f, content := range generated {
+		if filepath.Ext(f) != ".go" {
+			continue
+		}
+
+		if _, err := parser.ParseFile(fs, f, content, parser.AllErrors); err != nil {
+			t.Errorf("invalid Go source %s: %v", f, err)
+		}
+	}
+
+	// Running the generator again must not change anything.
+	if err := GenerateComponent(logger, "FooBar", opts); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertSnapshot(t, generated, snapshot(t, dir))
+}
+
+func TestRemoveComponent(t *testing.T) {
+	dir := setupRepo(t)
+	logger := newTestLogger()
+
+	original := snapshot(t, dir)
+
+	if err := GenerateComponent(logger, "FooBar", allOptions); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := RemoveComponent(logger, "FooBar", RemoveOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertSnapshot(t, original, snapshot(t, dir))
+
+	// Removing the component again must not change anything.
+	if err := RemoveComponent(logger, "FooBar", RemoveOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertSnapshot(t, original, snapshot(t, dir))
+}
+
+func TestRemoveComponentCleanup(t *testing.T) {
+	dir := setupRepo(t)
+	logger := newTestLogger()
+
+	if err := GenerateComponent(logger, "FooBar", Options{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for range 2 {
+		if err := RemoveComponent(logger, "FooBar", RemoveOptions{Cleanup: true}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	files := snapshot(t, dir)
+
+	if _, ok := files["pkg/upgrade/foobar_cleanup.go"]; !ok {
+		t.Error("expected pkg/upgrade/foobar_cleanup.go to be generated")
+	}
+
+	if n := strings.Count(files[upgradeFilePath], "cleanupRemovedFooBar(ctx, cli)"); n != 1 {
+		t.Errorf("expected cleanupRemovedFooBar to be called once, got %d", n)
+	}
+}
+
+func TestRenameComponent(t *testing.T) {
+	logger := newTestLogger()
+
+	dir := setupRepo(t)
+
+	if err := GenerateComponent(logger, "BarBaz", allOptions); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := snapshot(t, dir)
+
+	dir = setupRepo(t)
+
+	if err := GenerateComponent(logger, "FooBar", allOptions); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := RenameComponent(logger, "FooBar", "BarBaz"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertSnapshot(t, expected, snapshot(t, dir))
+
+	if err := RenameComponent(logger, "FooBar", "BarBaz"); err == nil {
+		t.Error("expected an error renaming a component which does not exist")
+	}
+}
+
+func TestRenamerReplace(t *testing.T) {
+	r := newRenamer("FooBar", "BarBaz")
+
+	tests := map[string]string{
+		"FooBar":                         "BarBaz",
+		"DSCFooBarStatus":                "DSCBarBazStatus",
+		"fooBarTestSuite":                "barBazTestSuite",
+		"foobarwebhook.RegisterWebhooks": "barbazwebhook.RegisterWebhooks",
+		"default-foobar":                 "default-barbaz",
+		"resources=foobars/status":       "resources=barbazs/status",
+		"myfoobar":                       "myfoobar",
+	}
+
+	for in, expected := range tests {
+		if got := r.replace(in); got != expected {
+			t.Errorf("replace(%q): expected %q, got %q", in, expected, got)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+// RemoveOptions configures the removal of a component.
+type RemoveOptions struct {
+	// Cleanup scaffolds a function deleting the CRD of the component when the operator is
+	// upgraded.
+	Cleanup bool
+}
+
+// componentFiles returns the files and folders generated for a component.
+func componentFiles(componentName string) []string {
+	lc := strings.ToLower(componentName)
+
+	return []string{
+		filepath.Join(ApisDir, lc+"_types.go"),
+		filepath.Join(Controllers, lc),
+		filepath.Join(Webhooks, lc),
+		filepath.Join(E2ETests, lc+"_test.go"),
+	}
+}
+
+// RemoveComponent removes a component: it deletes the files generated for the component and
+// reverts the edits of the existing sources done by GenerateComponent. Edits which are not
+// found are skipped, so the command can be run again after a partial failure.
+func RemoveComponent(logger *logrus.Logger, componentName string, opts RemoveOptions) error {
+	typeNames, err := componentTypes(componentName)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range componentFiles(componentName) {
+		if !fileExists(f) {
+			continue
+		}
+
+		if err := os.RemoveAll(f); err != nil {
+			return fmt.Errorf("error removing %s: %w", f, err)
+		}
+
+		logger.Infof("Removed %s", f)
+	}
+
+	all := append(slices.Clone(sources), source{path: deepCopyFilePath, spans: deepCopyLocator(typeNames)})
+
+	for _, s := range all {
+		if err := removeFromSource(logger, s, componentName); err != nil {
+			return err
+		}
+	}
+
+	warnReferences(logger, componentName)
+
+	if !opts.Cleanup {
+		return nil
+	}
+
+	err = generateFilesFromTemplate(logger, componentName, PathConfig{
+		Suffix:       "_cleanup.go",
+		OutputPath:   upgradeDir,
+		TemplatePath: filepath.Join(templatesDir, "upgrade_cleanup.go.tmpl"),
+	})
+	if err != nil {
+		return err
+	}
+
+	return addUpgradeCleanup(logger, componentName)
+}
+
+func removeFromSource(logger *logrus.Logger, s source, componentName string) error {
+	src, err := os.ReadFile(s.path)
+	if err != nil {
+		return fmt.Errorf("error reading file: %w", err)
+	}
+
+	spans, err := s.spans(src, componentName)
+	if err != nil {
+		return fmt.Errorf("error locating %s in %s: %w", componentName, s.path, err)
+	}
+
+	if len(spans) == 0 {
+		logger.Infof("%s not found in %s", componentName, s.path)
+		return nil
+	}
+
+	edits := make([]edit, 0, len(spans))
+	for _, sp := range spans {
+		l := lineSpan(src, sp)
+		edits = append(edits, edit{start: l.start, end: l.end})
+	}
+
+	if err := writeSource(s.path, src, edits); err != nil {
+		return err
+	}
+
+	logger.Infof("Successfully removed %s from %s", componentName, s.path)
+	return nil
+}
+
+// componentTypes returns the names of the types declared in the API types file of the
+// component, whose generated deep copy functions have to be updated.
+func componentTypes(componentName string) ([]string, error) {
+	fp := filepath.Join(ApisDir, strings.ToLower(componentName)+"_types.go")
+	if !fileExists(fp) {
+		return nil, nil
+	}
+
+	_, file, _, err := parseSource(fp)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+
+	for _, decl := range file.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.TYPE {
+			continue
+		}
+
+		for _, s := range gd.Specs {
+			if ts, ok := s.(*ast.TypeSpec); ok {
+				names = append(names, ts.Name.Name)
+			}
+		}
+	}
+
+	return names, nil
+}
+
+// warnReferences warns about the Go sources still referencing the component, which are not
+// scaffolded by GenerateComponent and have to be updated manually, e.g. the GVKs or the
+// conversion of the v1 DataScienceCluster when the component is part of the v1 API.
+func warnReferences(logger *logrus.Logger, componentName string) {
+	name := regexp.QuoteMeta(componentName)
+	re := regexp.MustCompile(`componentApi\.(?:DSC)?` + name + `(?:[A-Z_]\w*)?\b|\.Components\.` + name + `\b`)
+
+	_ = filepath.WalkDir(".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil //nolint:nilerr
+		}
+
+		if d.IsDir() {
+			if path == cmdDir || path == "vendor" || (path != "." && strings.HasPrefix(d.Name(), ".")) {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if filepath.Ext(path) != ".go" {
+			return nil
+		}
+
+		if data, err := os.ReadFile(path); err == nil && re.Match(data) {
+			logger.Warnf("%s still references %s and has to be updated manually", path, componentName)
+		}
+
+		return nil
+	})
+}
+
+// addUpgradeCleanup calls the cleanup function of the removed component from
+// CleanupExistingResource.
+func addUpgradeCleanup(logger *logrus.Logger, componentName string) error {
+	fs, file, src, err := parseSource(upgradeFilePath)
+	if err != nil {
+		return err
+	}
+
+	cleanup := "cleanupRemoved" + componentName
+
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Name.Name != "CleanupExistingResource" || fn.Body == nil {
+			continue
+		}
+
+		called := false
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			if c, ok := n.(*ast.CallExpr); ok && types.ExprString(c.Fun) == cleanup {
+				called = true
+			}
+			return !called
+		})
+
+		if called {
+			logger.Infof("%s already called in %s", cleanup, upgradeFilePath)
+			return nil
+		}
+
+		var ret *ast.ReturnStmt
+		if n := len(fn.Body.List); n > 0 {
+			ret, _ = fn.Body.List[n-1].(*ast.ReturnStmt)
+		}
+
+		if ret == nil {
+			return fmt.Errorf("CleanupExistingResource does not end with a return statement in %s", upgradeFilePath)
+		}
+
+		offset := fs.Position(ret.Pos()).Offset
+		offset = strings.LastIndexByte(string(src[:offset]), '\n') + 1
+
+		err := writeEdits(upgradeFilePath, src, []edit{
+			insertAt(offset, fmt.Sprintf("// cleanup the CRD of the removed %s component\nmultiErr = multierror.Append(multiErr, %s(ctx, cli))\n\n", componentName, cleanup)),
+		})
+		if err != nil {
+			return err
+		}
+
+		logger.Infof("Successfully added %s to %s", cleanup, upgradeFilePath)
+		return nil
+	}
+
+	return fmt.Errorf("CleanupExistingResource not found in %s", upgradeFilePath)
+}
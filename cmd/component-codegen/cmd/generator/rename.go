@@ -0,0 +1,197 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+// renamer replaces the name of a component in identifiers, paths and strings, in its upper
+// camel case (e.g. FooBar), lower camel case (e.g. fooBar) and lower case (e.g. foobar) forms.
+// The lower case forms are only replaced at the beginning of a word, e.g. in foobarwebhook or
+// default-foobar but not in myfoobar.
+type renamer struct {
+	pairs [][2]string
+}
+
+func newRenamer(oldName, newName string) renamer {
+	r := renamer{}
+
+	for _, f := range []func(string) string{
+		func(s string) string { return s },
+		lowerFirst,
+		strings.ToLower,
+	} {
+		p := [2]string{f(oldName), f(newName)}
+		if !slices.Contains(r.pairs, p) {
+			r.pairs = append(r.pairs, p)
+		}
+	}
+
+	return r
+}
+
+func (r renamer) replace(s string) string {
+	var b strings.Builder
+
+	for i := 0; i < len(s); {
+		matched := false
+
+		for _, p := range r.pairs {
+			if !strings.HasPrefix(s[i:], p[0]) || (isLower(p[0][0]) && i > 0 && isLower(s[i-1])) {
+				continue
+			}
+
+			b.WriteString(p[1])
+			i += len(p[0])
+			matched = true
+
+			break
+		}
+
+		if !matched {
+			b.WriteByte(s[i])
+			i++
+		}
+	}
+
+	return b.String()
+}
+
+func isLower(c byte) bool {
+	return c >= 'a' && c <= 'z'
+}
+
+// RenameComponent renames a component: it moves the files generated for the component,
+// renaming the component in their content, and renames the component in the existing sources
+// edited by GenerateComponent. The generated code is renamed as a whole, so the result should
+// be reviewed when the component name is part of other names, e.g. of upstream resources.
+func RenameComponent(logger *logrus.Logger, oldName string, newName string) error {
+	if oldName == newName {
+		return errors.New("the new name of the component must differ from the current one")
+	}
+
+	oldFiles := componentFiles(oldName)
+	newFiles := componentFiles(newName)
+
+	if !fileExists(oldFiles[0]) {
+		return fmt.Errorf("component %s not found: %s does not exist", oldName, oldFiles[0])
+	}
+
+	for _, f := range newFiles {
+		if fileExists(f) {
+			return fmt.Errorf("component %s already exists: %s exists", newName, f)
+		}
+	}
+
+	typeNames, err := componentTypes(oldName)
+	if err != nil {
+		return err
+	}
+
+	r := newRenamer(oldName, newName)
+
+	for i, f := range oldFiles {
+		if !fileExists(f) {
+			continue
+		}
+
+		if err := moveFiles(r, f, newFiles[i]); err != nil {
+			return err
+		}
+
+		logger.Infof("Moved %s to %s", f, newFiles[i])
+	}
+
+	all := append(slices.Clone(sources), source{path: deepCopyFilePath, spans: deepCopyLocator(typeNames)})
+
+	for _, s := range all {
+		if err := renameInSource(logger, r, s, oldName, newName); err != nil {
+			return err
+		}
+	}
+
+	warnReferences(logger, oldName)
+
+	return nil
+}
+
+// moveFiles moves the file or the folder src to dst, renaming the component in the names and
+// in the content of the files.
+func moveFiles(r renamer, src string, dst string) error {
+	err := filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("error reading file: %w", err)
+		}
+
+		op := filepath.Join(dst, r.replace(rel))
+		if rel == "." {
+			op = dst
+		}
+
+		if err := os.MkdirAll(filepath.Dir(op), os.ModePerm); err != nil {
+			return fmt.Errorf("error creating directory: %w", err)
+		}
+
+		if err := os.WriteFile(op, []byte(r.replace(string(data))), FilePerm); err != nil {
+			return fmt.Errorf("error writing file: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return os.RemoveAll(src)
+}
+
+func renameInSource(logger *logrus.Logger, r renamer, s source, oldName string, newName string) error {
+	src, err := os.ReadFile(s.path)
+	if err != nil {
+		return fmt.Errorf("error reading file: %w", err)
+	}
+
+	locate := s.spans
+	if s.renameSpans != nil {
+		locate = s.renameSpans
+	}
+
+	spans, err := locate(src, oldName)
+	if err != nil {
+		return fmt.Errorf("error locating %s in %s: %w", oldName, s.path, err)
+	}
+
+	if len(spans) == 0 {
+		logger.Infof("%s not found in %s", oldName, s.path)
+		return nil
+	}
+
+	edits := make([]edit, 0, len(spans))
+	for _, sp := range spans {
+		edits = append(edits, edit{start: sp.start, end: sp.end, text: r.replace(string(src[sp.start:sp.end]))})
+	}
+
+	if err := writeSource(s.path, src, edits); err != nil {
+		return err
+	}
+
+	logger.Infof("Successfully renamed %s to %s in %s", oldName, newName, s.path)
+	return nil
+}
@@ -0,0 +1,447 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// span is a range [start, end) of a source file holding code related to a component.
+type span struct {
+	start int
+	end   int
+}
+
+// locator returns the spans of src holding the code added for a component by GenerateComponent.
+type locator func(src []byte, componentName string) ([]span, error)
+
+// source is an existing source file referencing the components, most of them edited by
+// GenerateComponent.
+type source struct {
+	path  string
+	spans locator
+	// renameSpans locates the code to rename, when it differs from the code to remove.
+	renameSpans locator
+}
+
+var sources = []source{
+	{path: DscTypesPath, spans: goLocator(structFieldSpans)},
+	{path: DscDeepCopyPath, spans: goLocator(structDeepCopySpans)},
+	{path: mainFilePath, spans: goLocator(controllerImportSpans)},
+	{path: DscConversionPath, spans: goLocator(conversionSpans)},
+	{path: componentsFilePath, spans: goLocator(monitoringEmbedSpans)},
+	{path: webhookFilePath, spans: goLocator(webhookRegistrationSpans)},
+	{path: e2eSuitePath, spans: goLocator(e2eTestSuiteSpans)},
+	{path: rbacFilePath, spans: rbacBlockSpans, renameSpans: rbacMarkerSpans},
+	{path: projectFilePath, spans: projectSpans},
+}
+
+func goLocator(f func(fs *token.FileSet, file *ast.File, componentName string) []span) locator {
+	return func(src []byte, componentName string) ([]span, error) {
+		fs := token.NewFileSet()
+
+		file, err := parser.ParseFile(fs, "", src, parser.ParseComments)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing file: %w", err)
+		}
+
+		return f(fs, file, componentName), nil
+	}
+}
+
+func nodeSpan(fs *token.FileSet, start, end token.Pos) span {
+	return span{start: fs.Position(start).Offset, end: fs.Position(end).Offset}
+}
+
+// structFieldSpans locates the fields of the component, with their doc comment, in the
+// Components and ComponentsStatus structs.
+func structFieldSpans(fs *token.FileSet, file *ast.File, componentName string) []span {
+	var spans []span
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		ts, ok := n.(*ast.TypeSpec)
+		if !ok {
+			return true
+		}
+
+		st, ok := ts.Type.(*ast.StructType)
+		if !ok || (ts.Name.Name != "Components" && ts.Name.Name != "ComponentsStatus") {
+			return false
+		}
+
+		for _, f := range st.Fields.List {
+			if len(f.Names) != 1 || f.Names[0].Name != componentName {
+				continue
+			}
+
+			start := f.Pos()
+			if f.Doc != nil {
+				start = f.Doc.Pos()
+			}
+
+			spans = append(spans, nodeSpan(fs, start, f.End()))
+		}
+
+		return false
+	})
+
+	return spans
+}
+
+// structDeepCopySpans locates the statements copying the fields of the component in the
+// generated deep copy functions of the Components and ComponentsStatus structs.
+func structDeepCopySpans(fs *token.FileSet, file *ast.File, componentName string) []span {
+	var spans []span
+
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv == nil || len(fn.Recv.List) != 1 || fn.Body == nil {
+			continue
+		}
+
+		switch types.ExprString(fn.Recv.List[0].Type) {
+		case "*Components", "*ComponentsStatus":
+		default:
+			continue
+		}
+
+		for _, stmt := range fn.Body.List {
+			if references(stmt, componentName) {
+				spans = append(spans, nodeSpan(fs, stmt.Pos(), stmt.End()))
+			}
+		}
+	}
+
+	return spans
+}
+
+// references reports whether node selects the field name of in or out.
+func references(node ast.Node, name string) bool {
+	found := false
+
+	ast.Inspect(node, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if ok && sel.Sel.Name == name {
+			if x, ok := sel.X.(*ast.Ident); ok && (x.Name == "in" || x.Name == "out") {
+				found = true
+			}
+		}
+
+		return !found
+	})
+
+	return found
+}
+
+// controllerImportSpans locates the import registering the component controller.
+func controllerImportSpans(fs *token.FileSet, file *ast.File, componentName string) []span {
+	return importSpans(fs, file, fmt.Sprintf("%s/%s/%s", modulePath, Controllers, strings.ToLower(componentName)))
+}
+
+func importSpans(fs *token.FileSet, file *ast.File, path string) []span {
+	var spans []span
+
+	for _, s := range file.Imports {
+		if p, err := strconv.Unquote(s.Path.Value); err == nil && p == path {
+			spans = append(spans, nodeSpan(fs, s.Pos(), s.End()))
+		}
+	}
+
+	return spans
+}
+
+// conversionSpans locates the component in the conversion of the v1 DataScienceCluster. Only
+// the entries converting the component as Removed, as added by GenerateComponent, are
+// located: components which are part of the v1 API have to be handled manually.
+func conversionSpans(fs *token.FileSet, file *ast.File, componentName string) []span {
+	var spans []span
+
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Name.Name != "ConvertTo" {
+			continue
+		}
+
+		ast.Inspect(fn, func(n ast.Node) bool {
+			lit, ok := n.(*ast.CompositeLit)
+			if !ok || lit.Type == nil {
+				return true
+			}
+
+			switch types.ExprString(lit.Type) {
+			case "dscv2.Components", "dscv2.ComponentsStatus":
+				for _, e := range lit.Elts {
+					kv, ok := e.(*ast.KeyValueExpr)
+					if !ok || types.ExprString(kv.Key) != componentName {
+						continue
+					}
+
+					if _, ok := kv.Value.(*ast.CompositeLit); ok {
+						spans = append(spans, nodeSpan(fs, kv.Pos(), kv.End()))
+					}
+				}
+			}
+
+			return true
+		})
+	}
+
+	return spans
+}
+
+// monitoringEmbedSpans locates the directive embedding the monitoring rules of the component.
+func monitoringEmbedSpans(fs *token.FileSet, file *ast.File, componentName string) []span {
+	directive := fmt.Sprintf("//go:embed %s/monitoring", strings.ToLower(componentName))
+
+	var spans []span
+
+	for _, cg := range file.Comments {
+		for _, c := range cg.List {
+			if c.Text == directive {
+				spans = append(spans, nodeSpan(fs, c.Pos(), c.End()))
+			}
+		}
+	}
+
+	return spans
+}
+
+// webhookRegistrationSpans locates the import and the registration of the component webhooks.
+func webhookRegistrationSpans(fs *token.FileSet, file *ast.File, componentName string) []span {
+	lc := strings.ToLower(componentName)
+	register := lc + "webhook.RegisterWebhooks"
+
+	spans := importSpans(fs, file, fmt.Sprintf("%s/%s/%s", modulePath, Webhooks, lc))
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		lit, ok := n.(*ast.CompositeLit)
+		if !ok || lit.Type == nil || types.ExprString(lit.Type) != "[]func(ctrl.Manager) error" {
+			return true
+		}
+
+		for _, e := range lit.Elts {
+			if types.ExprString(e) == register {
+				spans = append(spans, nodeSpan(fs, e.Pos(), e.End()))
+			}
+		}
+
+		return false
+	})
+
+	return spans
+}
+
+// e2eTestSuiteSpans locates the e2e test suite of the component in the components test group.
+func e2eTestSuiteSpans(fs *token.FileSet, file *ast.File, componentName string) []span {
+	key := fmt.Sprintf("componentApi.%sComponentName", componentName)
+
+	var spans []span
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		vs, ok := n.(*ast.ValueSpec)
+		if !ok {
+			return true
+		}
+
+		for i, name := range vs.Names {
+			if name.Name != "Components" || i >= len(vs.Values) {
+				continue
+			}
+
+			group, ok := vs.Values[i].(*ast.CompositeLit)
+			if !ok {
+				continue
+			}
+
+			scenarios := field(group, "scenarios")
+			if scenarios == nil {
+				continue
+			}
+
+			for _, s := range scenarios.Elts {
+				lit, ok := s.(*ast.CompositeLit)
+				if !ok {
+					continue
+				}
+
+				for _, e := range lit.Elts {
+					if kv, ok := e.(*ast.KeyValueExpr); ok && types.ExprString(kv.Key) == key {
+						spans = append(spans, nodeSpan(fs, kv.Pos(), kv.End()))
+					}
+				}
+			}
+		}
+
+		return false
+	})
+
+	return spans
+}
+
+// deepCopyLocator returns a locator of the generated deep copy functions of the given types.
+func deepCopyLocator(typeNames []string) locator {
+	return goLocator(func(fs *token.FileSet, file *ast.File, _ string) []span {
+		var spans []span
+
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || len(fn.Recv.List) != 1 {
+				continue
+			}
+
+			recv := fn.Recv.List[0].Type
+			if star, ok := recv.(*ast.StarExpr); ok {
+				recv = star.X
+			}
+
+			if !slices.Contains(typeNames, types.ExprString(recv)) {
+				continue
+			}
+
+			start := fn.Pos()
+			if fn.Doc != nil {
+				start = fn.Doc.Pos()
+			}
+
+			spans = append(spans, nodeSpan(fs, start, fn.End()))
+		}
+
+		return spans
+	})
+}
+
+// rbacMarkerSpans locates the header and the RBAC markers of the component resources.
+func rbacMarkerSpans(src []byte, componentName string) ([]span, error) {
+	lines := rbacMarkers(componentName)
+	lines = append(lines, "// "+componentName)
+
+	var spans []span
+
+	offset := 0
+	for _, l := range bytes.SplitAfter(src, []byte("\n")) {
+		for _, m := range lines {
+			if strings.TrimSpace(string(l)) == m {
+				spans = append(spans, span{start: offset, end: offset + len(bytes.TrimRight(l, "\n"))})
+			}
+		}
+
+		offset += len(l)
+	}
+
+	return spans, nil
+}
+
+// rbacBlockSpans locates the RBAC markers of the component, including the markers of the
+// resources managed by the component which are in the same block, i.e. the lines following
+// the header with the component name up to the next blank line.
+func rbacBlockSpans(src []byte, componentName string) ([]span, error) {
+	spans, err := rbacMarkerSpans(src, componentName)
+	if err != nil {
+		return nil, err
+	}
+
+	header := "// " + componentName
+
+	offset := 0
+	start := -1
+
+	for _, l := range bytes.SplitAfter(src, []byte("\n")) {
+		line := strings.TrimSpace(string(l))
+
+		switch {
+		case line == header:
+			start = offset
+		case start >= 0 && !strings.HasPrefix(line, "//"):
+			return []span{{start: start, end: offset - 1}}, nil
+		}
+
+		offset += len(l)
+	}
+
+	if start >= 0 {
+		return []span{{start: start, end: len(bytes.TrimRight(src, "\n"))}}, nil
+	}
+
+	return spans, nil
+}
+
+func rbacMarkers(componentName string) []string {
+	lc := strings.ToLower(componentName)
+
+	return []string{
+		fmt.Sprintf("// +kubebuilder:rbac:groups=components.platform.opendatahub.io,resources=%ss,verbs=get;list;watch;create;update;patch;delete", lc),
+		fmt.Sprintf("// +kubebuilder:rbac:groups=components.platform.opendatahub.io,resources=%ss/status,verbs=get;update;patch", lc),
+		fmt.Sprintf("// +kubebuilder:rbac:groups=components.platform.opendatahub.io,resources=%ss/finalizers,verbs=update", lc),
+	}
+}
+
+// projectResourceRe matches the resources of the components group in the PROJECT file.
+var projectResourceRe = regexp.MustCompile(`(?m)^- api:\n(?:\s+.*\n)*?\s+group: components(?:\n\s+.*)*`)
+
+// projectSpans locates the resource of the component in the PROJECT file.
+func projectSpans(src []byte, componentName string) ([]span, error) {
+	kindRe := regexp.MustCompile(`(?m)^\s+kind: ` + regexp.QuoteMeta(componentName) + `$`)
+
+	var spans []span
+
+	for _, m := range projectResourceRe.FindAllIndex(src, -1) {
+		if kindRe.Match(src[m[0]:m[1]]) {
+			spans = append(spans, span{start: m[0], end: m[1]})
+		}
+	}
+
+	return spans, nil
+}
+
+// lineSpan extends s to the lines holding it when nothing else is on them, besides the
+// separating comma, and removes the blank line separating it from the previous line.
+// Otherwise s is only extended to the separating comma.
+func lineSpan(src []byte, s span) span {
+	start := bytes.LastIndexByte(src[:s.start], '\n') + 1
+
+	end := len(src)
+	if i := bytes.IndexByte(src[s.end:], '\n'); i >= 0 {
+		end = s.end + i + 1
+	}
+
+	before := bytes.TrimSpace(src[start:s.start])
+	after := bytes.TrimSpace(bytes.TrimPrefix(bytes.TrimSpace(src[s.end:end]), []byte(",")))
+
+	if len(before) != 0 || len(after) != 0 {
+		end = s.end
+		for end < len(src) && (src[end] == ' ' || src[end] == '\t') {
+			end++
+		}
+
+		if end < len(src) && src[end] == ',' {
+			return span{start: s.start, end: end + 1}
+		}
+
+		return s
+	}
+
+	// Remove the blank line before the span, or after it if it is the first of a block.
+	if start >= 2 && src[start-2] == '\n' {
+		return span{start: start - 1, end: end}
+	}
+
+	if end < len(src) && src[end] == '\n' && (start == 0 || isBlockStart(src[:start-1])) {
+		return span{start: start, end: end + 1}
+	}
+
+	return span{start: start, end: end}
+}
+
+func isBlockStart(src []byte) bool {
+	line := src[bytes.LastIndexByte(src, '\n')+1:]
+	line = bytes.TrimSpace(line)
+
+	return len(line) > 0 && bytes.ContainsAny(line[len(line)-1:], "{(")
+}
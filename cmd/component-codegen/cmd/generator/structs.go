@@ -1,22 +1,19 @@
 package generator
 
 import (
-	"bytes"
 	"fmt"
 	"go/ast"
-	"go/format"
-	"go/parser"
-	"go/printer"
 	"go/token"
-	"os"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/sirupsen/logrus"
 )
 
-func addImportField(file *ast.File, componentName string) error {
-	if file.Name.Name+".go" != "main.go" {
-		return nil
+func addImportField(fs *token.FileSet, file *ast.File, componentName string) ([]edit, error) {
+	if file.Name.Name != "main" {
+		return nil, nil
 	}
 	var importDecl *ast.GenDecl
 	for _, decl := range file.Decls {
@@ -25,27 +22,31 @@ func addImportField(file *ast.File, componentName string) error {
 		}
 	}
 
-	if importDecl == nil {
-		return fmt.Errorf("import declaration not found")
+	if importDecl == nil || !importDecl.Rparen.IsValid() {
+		return nil, fmt.Errorf("import declaration not found")
 	}
 
-	newImport := &ast.ImportSpec{
-		Name: ast.NewIdent("_"),
-		Path: &ast.BasicLit{
-			Kind:  token.STRING,
-			Value: fmt.Sprintf("\"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/%s\"", strings.ToLower(componentName)),
-		},
+	importPath := fmt.Sprintf("%s/%s/%s", modulePath, Controllers, strings.ToLower(componentName))
+	if hasImport(file, importPath) {
+		return nil, nil
 	}
 
 	// Ensure new import is added at the end
-	importDecl.Specs = append(importDecl.Specs, newImport)
-	return nil
+	return []edit{
+		insertAt(fs.Position(importDecl.Rparen).Offset, fmt.Sprintf("_ %s\n", strconv.Quote(importPath))),
+	}, nil
 }
 
-func addStructField(ts *ast.TypeSpec, componentName string) error {
+func addStructField(fs *token.FileSet, ts *ast.TypeSpec, componentName string) ([]edit, error) {
 	st, ok := ts.Type.(*ast.StructType)
 	if !ok {
-		return fmt.Errorf("not a struct type")
+		return nil, fmt.Errorf("not a struct type")
+	}
+
+	for _, f := range st.Fields.List {
+		if slices.ContainsFunc(f.Names, func(n *ast.Ident) bool { return n.Name == componentName }) {
+			return nil, nil
+		}
 	}
 
 	var fieldType string
@@ -57,65 +58,47 @@ func addStructField(ts *ast.TypeSpec, componentName string) error {
 		fieldType = fmt.Sprintf("componentApi.DSC%s", componentName)
 	}
 
-	st.Fields.List = append(st.Fields.List,
-		&ast.Field{
-			Doc: &ast.CommentGroup{
-				List: []*ast.Comment{
-					{
-						Text: "// " + comment,
-					},
-				},
-			},
-			Names: []*ast.Ident{ast.NewIdent(componentName)},
-			Type:  &ast.Ident{Name: fieldType},
-			Tag:   &ast.BasicLit{Value: fmt.Sprintf("`json:\"%s,omitempty\"`", strings.ToLower(componentName))},
-		},
-	)
-	return nil
+	return []edit{
+		insertAt(fs.Position(st.Fields.Closing).Offset, fmt.Sprintf("\n// %s\n%s %s `json:\"%s,omitempty\"`\n", comment, componentName, fieldType, strings.ToLower(componentName))),
+	}, nil
 }
 
 func addFieldsToStruct(log *logrus.Logger, componentName string, fp string) error {
-	file, err := os.Open(fp)
+	fs, node, src, err := parseSource(fp)
 	if err != nil {
-		return fmt.Errorf("error opening file: %w", err)
+		return err
 	}
-	defer file.Close()
 
-	fs := token.NewFileSet()
-	node, err := parser.ParseFile(fs, fp, file, parser.ParseComments)
+	edits, err := addImportField(fs, node, componentName)
 	if err != nil {
-		return fmt.Errorf("error parsing file: %w", err)
+		return err
 	}
-	ast.Inspect(node, func(n ast.Node) bool {
-		switch n := n.(type) {
-		case *ast.File:
-			if err = addImportField(n, componentName); err != nil {
-				return false
-			}
-		case *ast.TypeSpec:
-			switch n.Name.Name {
-			case "Components", "ComponentsStatus":
-				if err = addStructField(n, componentName); err != nil {
-					return false
-				}
-			}
+
+	for _, decl := range node.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.TYPE {
+			continue
 		}
-		return true
-	})
 
-	if err != nil {
-		return err
-	}
+		for _, spec := range gd.Specs {
+			ts, ok := spec.(*ast.TypeSpec)
+			if !ok || (ts.Name.Name != "Components" && ts.Name.Name != "ComponentsStatus") {
+				continue
+			}
 
-	var buf bytes.Buffer
-	if err := printer.Fprint(&buf, fs, node); err != nil {
-		return fmt.Errorf("error storing buffer: %w", err)
+			fields, err := addStructField(fs, ts, componentName)
+			if err != nil {
+				return err
+			}
+
+			edits = append(edits, fields...)
+		}
 	}
 
-	formatted, err := format.Source(buf.Bytes())
-	if err != nil {
-		return fmt.Errorf("error formatting code: %w", err)
+	if len(edits) == 0 {
+		log.Infof("%s already added to %s", componentName, fp)
+		return nil
 	}
 
-	return os.WriteFile(fp, formatted, FilePerm)
+	return writeEdits(fp, src, edits)
 }
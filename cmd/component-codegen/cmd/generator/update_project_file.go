@@ -3,7 +3,6 @@ package generator
 import (
 	"fmt"
 	"os"
-	"regexp"
 	"strings"
 
 	"github.com/sirupsen/logrus"
@@ -26,8 +25,20 @@ func updateProjectFile(logger *logrus.Logger, componentName string) error {
 	}
 	content := string(data)
 
-	re := regexp.MustCompile(`(?m)^- api:\n(?:\s+.*\n)*?\s+group: components(?:\n\s+.*)*`)
-	matches := re.FindAllStringIndex(content, -1)
+	matches := projectResourceRe.FindAllStringIndex(content, -1)
+	if len(matches) == 0 {
+		return fmt.Errorf("no components resource found in %s", projectFilePath)
+	}
+
+	spans, err := projectSpans(data, componentName)
+	if err != nil {
+		return err
+	}
+
+	if len(spans) != 0 {
+		logger.Infof("%s already present in %s", componentName, projectFilePath)
+		return nil
+	}
 
 	insertPos := matches[len(matches)-1][1]
 
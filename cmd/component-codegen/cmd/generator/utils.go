@@ -2,6 +2,7 @@ package generator
 
 import (
 	"os"
+	"strings"
 )
 
 const FilePerm = 0644
@@ -10,3 +11,11 @@ func fileExists(filePath string) bool {
 	_, err := os.Stat(filePath)
 	return !os.IsNotExist(err)
 }
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+
+	return strings.ToLower(s[:1]) + s[1:]
+}
@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/component-codegen/cmd/generator"
+)
+
+var removeOpts generator.RemoveOptions
+
+var removeCmd = &cobra.Command{
+	Use:   "remove [component-name]",
+	Short: "Removes the folders/files of a component and reverts its registration",
+	Long: `Removes the folders/files generated for a component and reverts the edits of the
+existing sources done by the generate command. Edits which are not found are skipped,
+so the command can be run again after a partial failure.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logger := newLogger()
+		componentName := args[0]
+
+		if err := generator.RemoveComponent(logger, componentName, removeOpts); err != nil {
+			logger.Errorf("Failed to remove component: %v", err)
+			return err
+		}
+
+		return nil
+	},
+}
+
+func init() { //nolint:gochecknoinits
+	removeCmd.Flags().BoolVar(&removeOpts.Cleanup, "cleanup", false, "Generate a function deleting the CRD of the component on upgrade in pkg/upgrade")
+
+	rootCmd.AddCommand(removeCmd)
+}
@@ -0,0 +1,30 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/component-codegen/cmd/generator"
+)
+
+var renameCmd = &cobra.Command{
+	Use:   "rename [component-name] [new-component-name]",
+	Short: "Renames a component",
+	Long: `Moves the folders/files generated for a component, renaming the component in their
+content, and renames the component in the existing sources edited by the generate
+command.`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logger := newLogger()
+
+		if err := generator.RenameComponent(logger, args[0], args[1]); err != nil {
+			logger.Errorf("Failed to rename component: %v", err)
+			return err
+		}
+
+		return nil
+	},
+}
+
+func init() { //nolint:gochecknoinits
+	rootCmd.AddCommand(renameCmd)
+}
@@ -75,6 +75,7 @@ import (
 	cr "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/registry"
 	dscctrl "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/datasciencecluster"
 	dscictrl "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/dscinitialization"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/operatorconfig"
 	sr "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/registry"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
@@ -175,6 +176,31 @@ type OperatorConfig struct {
 	ZapTimeEncoding string `mapstructure:"zap-time-encoding"`
 }
 
+// Spec returns the configuration as an OperatorConfig spec.
+func (c *OperatorConfig) Spec() serviceApi.OperatorConfigSpec {
+	return serviceApi.OperatorConfigSpec{
+		Logging: serviceApi.OperatorLoggingSpec{
+			Level: c.ZapLogLevel,
+			Mode:  c.LogMode,
+		},
+		LeaderElection: &serviceApi.OperatorLeaderElectionSpec{
+			Enabled: c.LeaderElection,
+		},
+		Reconcile: serviceApi.OperatorReconcileSpec{
+			MaxConcurrentReconciles: 1,
+		},
+	}
+}
+
+// Apply sets the configuration from an OperatorConfig spec.
+func (c *OperatorConfig) Apply(spec serviceApi.OperatorConfigSpec) {
+	c.ZapLogLevel = spec.Logging.Level
+	c.LogMode = spec.Logging.Mode
+	if spec.LeaderElection != nil {
+		c.LeaderElection = spec.LeaderElection.Enabled
+	}
+}
+
 func LoadConfig() (*OperatorConfig, error) {
 	var operatorConfig OperatorConfig
 	if err := viper.Unmarshal(&operatorConfig); err != nil {
@@ -191,23 +217,49 @@ func main() { //nolint:funlen,maintidx,gocyclo
 
 	// define flags and env vars
 	if err := flags.AddOperatorFlagsAndEnvvars(viper.GetEnvPrefix()); err != nil {
-		fmt.Printf("Error in adding flags or binding env vars: %s", err.Error())
+		fmt.Fprintln(os.Stderr, "Error in adding flags or binding env vars:", err)
 		os.Exit(1)
 	}
 
 	// parse and bind flags
 	pflag.Parse()
 	if err := viper.BindPFlags(pflag.CommandLine); err != nil {
-		fmt.Printf("Error in binding flags: %s", err.Error())
+		fmt.Fprintln(os.Stderr, "Error in binding flags:", err)
 		os.Exit(1)
 	}
 
 	oconfig, err := LoadConfig()
 	if err != nil {
-		fmt.Printf("Error loading configuration: %s", err.Error())
+		fmt.Fprintln(os.Stderr, "Error loading configuration:", err)
+		os.Exit(1)
+	}
+
+	// root context
+	ctx := ctrl.SetupSignalHandler()
+	// Create new uncached client to run initial setup
+	setupCfg, err := config.GetConfig()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error getting config for setup:", err)
+		os.Exit(1)
+	}
+
+	setupClient, err := client.New(setupCfg, client.Options{Scheme: scheme})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error getting client for setup:", err)
+		os.Exit(1)
+	}
+
+	// The OperatorConfig overrides the flags and environment variables, it is loaded before the
+	// logger is created since it configures the logs.
+	operatorConfig, err := operatorconfig.Load(ctx, setupClient)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error loading OperatorConfig:", err)
 		os.Exit(1)
 	}
 
+	operatorConfigSpec := operatorconfig.Startup(oconfig.Spec(), operatorConfig)
+	oconfig.Apply(operatorConfigSpec)
+
 	// After getting the zap related configs an ad hoc flag set is created so the zap BindFlags mechanism can be reused
 	zapFlagSet := flags.NewZapFlagSet()
 
@@ -216,28 +268,25 @@ func main() { //nolint:funlen,maintidx,gocyclo
 
 	err = flags.ParseZapFlags(zapFlagSet, oconfig.ZapDevel, oconfig.ZapEncoder, oconfig.ZapLogLevel, oconfig.ZapStacktrace, oconfig.ZapTimeEncoding)
 	if err != nil {
-		fmt.Printf("Error in parsing zap flags: %s", err.Error())
+		fmt.Fprintln(os.Stderr, "Error in parsing zap flags:", err)
 		os.Exit(1)
 	}
 
 	ctrl.SetLogger(logger.NewLogger(oconfig.LogMode, &opts))
-
-	// root context
-	ctx := ctrl.SetupSignalHandler()
 	ctx = logf.IntoContext(ctx, setupLog)
-	// Create new uncached client to run initial setup
-	setupCfg, err := config.GetConfig()
-	if err != nil {
-		setupLog.Error(err, "error getting config for setup")
-		os.Exit(1)
-	}
 
-	setupClient, err := client.New(setupCfg, client.Options{Scheme: scheme})
-	if err != nil {
-		setupLog.Error(err, "error getting client for setup")
+	if err := operatorconfig.ApplyRuntimeConfig(operatorConfigSpec); err != nil {
+		setupLog.Error(err, "unable to apply OperatorConfig")
 		os.Exit(1)
 	}
 
+	cr.DefaultRegistry().SetAllowed(func(name string) bool {
+		return operatorconfig.ComponentAllowed(operatorConfigSpec, name)
+	})
+	sr.DefaultRegistry().SetAllowed(func(name string) bool {
+		return operatorconfig.ServiceAllowed(operatorConfigSpec, name)
+	})
+
 	err = cluster.Init(ctx, setupClient)
 	if err != nil {
 		setupLog.Error(err, "unable to initialize cluster config")
@@ -265,13 +314,13 @@ func main() { //nolint:funlen,maintidx,gocyclo
 		os.Exit(1)
 	}
 
-	secretCache, err := createSecretCacheConfig(platform)
+	secretCache, err := createSecretCacheConfig(platform, operatorConfigSpec.Cache.Namespaces)
 	if err != nil {
 		setupLog.Error(err, "unable to get application namespace into cache")
 		os.Exit(1)
 	}
 
-	oDHCache, err := createODHGeneralCacheConfig(platform)
+	oDHCache, err := createODHGeneralCacheConfig(platform, operatorConfigSpec.Cache.Namespaces)
 	if err != nil {
 		setupLog.Error(err, "unable to get application namespace into cache")
 		os.Exit(1)
@@ -361,6 +410,8 @@ func main() { //nolint:funlen,maintidx,gocyclo
 					&ofapiv1alpha1.Subscription{},
 					&authorizationv1.SelfSubjectRulesReview{},
 					&corev1.Pod{},
+					// only the API server Endpoints are read, to generate the egress NetworkPolicies
+					&corev1.Endpoints{},
 					&userv1.Group{},
 					&ofapiv1alpha1.CatalogSource{},
 				},
@@ -376,9 +427,13 @@ func main() { //nolint:funlen,maintidx,gocyclo
 	}
 
 	// Register all webhooks using the helper
-	if err := webhook.RegisterAllWebhooks(mgr); err != nil {
-		setupLog.Error(err, "unable to register webhooks")
-		os.Exit(1)
+	if operatorConfigSpec.WebhooksEnabled() {
+		if err := webhook.RegisterAllWebhooks(mgr); err != nil {
+			setupLog.Error(err, "unable to register webhooks")
+			os.Exit(1)
+		}
+	} else {
+		setupLog.Info("webhooks are disabled by the OperatorConfig")
 	}
 
 	if err = (&dscictrl.DSCInitializationReconciler{
@@ -429,7 +484,7 @@ func main() { //nolint:funlen,maintidx,gocyclo
 	// Create default DSC CR for managed RHOAI
 	if platform == cluster.ManagedRhoai {
 		var createDefaultDSCFunc manager.RunnableFunc = func(ctx context.Context) error {
-			err := initialinstall.CreateDefaultDSC(ctx, setupClient)
+			err := initialinstall.CreateDefaultDSC(ctx, setupClient, operatorConfigSpec.DefaultManagementStates)
 			if err != nil {
 				setupLog.Error(err, "unable to create default DSC CR by the operator")
 			}
@@ -471,7 +526,9 @@ func main() { //nolint:funlen,maintidx,gocyclo
 	}
 }
 
-func getCommonCache(platform common.Platform) (map[string]cache.Config, error) {
+// getCommonCache returns the namespaces watched for the namespaced resources, extraNamespaces are
+// the namespaces set in the OperatorConfig.
+func getCommonCache(platform common.Platform, extraNamespaces []string) (map[string]cache.Config, error) {
 	namespaceConfigs := map[string]cache.Config{}
 
 	// networkpolicy need operator namespace
@@ -492,11 +549,15 @@ func getCommonCache(platform common.Platform) (map[string]cache.Config, error) {
 		namespaceConfigs[cluster.NamespaceConsoleLink] = cache.Config{}
 	}
 
+	for _, ns := range extraNamespaces {
+		namespaceConfigs[ns] = cache.Config{}
+	}
+
 	return namespaceConfigs, nil
 }
 
-func createSecretCacheConfig(platform common.Platform) (map[string]cache.Config, error) {
-	namespaceConfigs, err := getCommonCache(platform)
+func createSecretCacheConfig(platform common.Platform, extraNamespaces []string) (map[string]cache.Config, error) {
+	namespaceConfigs, err := getCommonCache(platform, extraNamespaces)
 	if err != nil {
 		return nil, err
 	}
@@ -506,8 +567,8 @@ func createSecretCacheConfig(platform common.Platform) (map[string]cache.Config,
 	return namespaceConfigs, nil
 }
 
-func createODHGeneralCacheConfig(platform common.Platform) (map[string]cache.Config, error) {
-	namespaceConfigs, err := getCommonCache(platform)
+func createODHGeneralCacheConfig(platform common.Platform, extraNamespaces []string) (map[string]cache.Config, error) {
+	namespaceConfigs, err := getCommonCache(platform, extraNamespaces)
 	if err != nil {
 		return nil, err
 	}
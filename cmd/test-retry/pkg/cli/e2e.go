@@ -1,10 +1,14 @@
 package cli
 
 import (
+	"fmt"
+
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/test-retry/pkg/config"
+	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/test-retry/pkg/formatter"
+	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/test-retry/pkg/history"
 	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/test-retry/pkg/runner"
 	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/test-retry/pkg/types"
 )
@@ -19,7 +23,17 @@ func NewE2ECommand(cfg *config.Config) *cobra.Command {
 	var neverSkip []string
 	var skipAtPrefix []string
 	var junitOutput string
+	var historyPath string
+	var historyMaxRuns int
+	var quarantine []string
+	var quarantineFile string
+	var reportOutput string
+	var reportTop int
+	var shards int
+	var shardIndex int
+	var resultOutput string
 	var prOpts types.PROptions
+	var notifierOpts types.NotifierOptions
 
 	cmd := &cobra.Command{
 		Use:   "e2e [-- go-test-args...]",
@@ -45,6 +59,18 @@ Example: test-retry e2e -- -run TestFoo -v`,
 				finalTestFlags = finalTestFlags[:len(finalTestFlags)-1] // Remove trailing space
 			}
 
+			if shards < 1 || shardIndex < 0 || shardIndex >= shards {
+				return fmt.Errorf("invalid shard index %d of %d shards", shardIndex, shards)
+			}
+
+			if quarantineFile != "" {
+				tests, err := history.LoadQuarantineFile(quarantineFile)
+				if err != nil {
+					return fmt.Errorf("failed to load quarantined tests: %w", err)
+				}
+				quarantine = append(quarantine, tests...)
+			}
+
 			opts := types.E2ETestOptions{
 				Config:            cfg,
 				MaxRetries:        maxRetries,
@@ -55,7 +81,16 @@ Example: test-retry e2e -- -run TestFoo -v`,
 				NeverSkipPrefixes: neverSkip,
 				SkipAtPrefixes:    skipAtPrefix,
 				PROptions:         prOpts,
+				NotifierOptions:   notifierOpts,
 				JUnitOutputPath:   junitOutput,
+				HistoryPath:       historyPath,
+				HistoryMaxRuns:    historyMaxRuns,
+				QuarantinedTests:  quarantine,
+				ReportOutputPath:  reportOutput,
+				ReportTop:         reportTop,
+				Shards:            shards,
+				ShardIndex:        shardIndex,
+				ResultOutputPath:  resultOutput,
 			}
 
 			testRunner := runner.NewE2ETestRunner(opts)
@@ -71,6 +106,19 @@ Example: test-retry e2e -- -run TestFoo -v`,
 	cmd.Flags().StringSliceVar(&skipAtPrefix, "skip-at-prefix", []string{"TestOdhOperator/services/*/", "TestOdhOperator/components/*/", "TestOdhOperator/"}, "Test prefixes where tests should be extracted at prefix + 1 level (repeatable)")
 	cmd.Flags().StringVar(&junitOutput, "junit-output", "", "Path to JUnit XML output file (optional)")
 
+	// Flaky tests tracking flags
+	cmd.Flags().StringVar(&historyPath, "history", "", "Path to the JSON file persisting the test outcomes across runs (optional)")
+	cmd.Flags().IntVar(&historyMaxRuns, "history-max-runs", history.DefaultMaxRuns, "Maximum number of runs kept in the history file (negative keeps all of them)")
+	cmd.Flags().StringSliceVar(&quarantine, "quarantine", nil, "Tests whose failures do not fail the run, along with their subtests (repeatable)")
+	cmd.Flags().StringVar(&quarantineFile, "quarantine-file", "", "File listing the quarantined tests, one per line (optional)")
+	cmd.Flags().StringVar(&reportOutput, "report-output", "", "Path to the flaky tests report, JSON for a .json extension and Markdown otherwise (optional)")
+	cmd.Flags().IntVar(&reportTop, "report-top", formatter.DefaultReportTop, "Number of tests listed in each section of the Markdown report")
+
+	// Sharding flags
+	cmd.Flags().IntVar(&shards, "shards", 1, "Number of shards the tests are partitioned into, balanced by the durations recorded in the history file")
+	cmd.Flags().IntVar(&shardIndex, "shard-index", 0, "Index of the shard to run, from 0 to shards - 1")
+	cmd.Flags().StringVar(&resultOutput, "result-output", "", "Path to the JSON output file of the results, to be merged with the ones of the other shards (optional)")
+
 	// GitHub PR notification flags
 	cmd.Flags().StringVar(&prOpts.Token, "github-token", "", "GitHub token for authentication (can also use GITHUB_TOKEN env var)")
 	cmd.Flags().StringVar(&prOpts.Owner, "github-owner", "", "GitHub repository owner")
@@ -79,6 +127,11 @@ Example: test-retry e2e -- -run TestFoo -v`,
 	cmd.Flags().StringVar(&prOpts.Label, "failure-label", "", "Label to add to PR when tests fail (optional)")
 	cmd.Flags().StringVar(&prOpts.Comment, "failure-comment", "", "Comment to add to PR when tests fail (optional)")
 
+	// Notifier flags
+	cmd.Flags().StringSliceVar(&notifierOpts.WebhookURLs, "notify-webhook", nil, "Webhook URL receiving a JSON payload when tests fail or are flaky (repeatable)")
+	cmd.Flags().StringSliceVar(&notifierOpts.SlackWebhookURLs, "notify-slack-webhook", nil, "Slack compatible incoming webhook URL notified when tests fail or are flaky (repeatable)")
+	cmd.Flags().StringVar(&notifierOpts.MarkdownSummaryPath, "notify-markdown", "", "Markdown file the summary is appended to when tests fail or are flaky, e.g. $GITHUB_STEP_SUMMARY (optional)")
+
 	// Bind the github-token flag to viper and set up env var binding
 	viper.BindPFlag("github-token", cmd.Flags().Lookup("github-token"))
 	viper.BindEnv("github-token", "GITHUB_TOKEN")
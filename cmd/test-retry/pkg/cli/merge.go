@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/test-retry/pkg/config"
+	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/test-retry/pkg/formatter"
+	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/test-retry/pkg/history"
+	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/test-retry/pkg/types"
+)
+
+// NewMergeCommand creates the command merging the results of sharded runs
+func NewMergeCommand(cfg *config.Config) *cobra.Command {
+	var junitOutput string
+	var resultOutput string
+	var historyPath string
+	var historyMaxRuns int
+	var reportOutput string
+	var reportTop int
+
+	cmd := &cobra.Command{
+		Use:   "merge results-file...",
+		Short: "Merge the results of sharded e2e test runs",
+		Long: `Merge the results of e2e test runs, e.g. of the shards of a run, exported by the e2e
+command with the --result-output or --junit-output flags. Files with a .xml extension are read
+as JUnit XML, the other ones as JSON.
+
+The merged results can be exported to JUnit XML and JSON, and recorded in the history file.`,
+		Args:          cobra.MinimumNArgs(1),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			results := make([]*types.TestResult, 0, len(args))
+			for _, path := range args {
+				result, err := formatter.ImportResult(path)
+				if err != nil {
+					return err
+				}
+				results = append(results, result)
+			}
+
+			merged := formatter.MergeResults(results...)
+
+			if junitOutput != "" {
+				err := formatter.ExportToJUnit(merged, formatter.JUnitExportOptions{
+					OutputPath: junitOutput,
+					SuiteName:  "e2e-test",
+				})
+				if err != nil {
+					return err
+				}
+			}
+
+			if resultOutput != "" {
+				if err := formatter.ExportResult(merged, resultOutput); err != nil {
+					return err
+				}
+			}
+
+			run := history.NewRun(time.Now(), merged, nil)
+
+			if historyPath != "" {
+				h, err := history.Load(historyPath)
+				if err != nil {
+					return err
+				}
+
+				h.Add(run, historyMaxRuns)
+
+				if err := h.Save(historyPath); err != nil {
+					return err
+				}
+
+				if reportOutput != "" {
+					err := formatter.ExportReport(history.Analyze(h), formatter.ReportExportOptions{
+						OutputPath: reportOutput,
+						Top:        reportTop,
+					})
+					if err != nil {
+						return err
+					}
+				}
+			}
+
+			counts := make(map[history.Outcome]int)
+			for _, t := range run.Tests {
+				counts[t.Outcome]++
+			}
+
+			fmt.Printf("Merged %d results: %d passed, %d flaky, %d failed\n",
+				len(args), counts[history.OutcomePassed], counts[history.OutcomeFlaky], counts[history.OutcomeFailed])
+
+			if cfg.Verbose && junitOutput != "" {
+				fmt.Printf("JUnit XML exported to %s\n", junitOutput)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&junitOutput, "junit-output", "", "Path to JUnit XML output file of the merged results (optional)")
+	cmd.Flags().StringVar(&resultOutput, "result-output", "", "Path to the JSON output file of the merged results (optional)")
+	cmd.Flags().StringVar(&historyPath, "history", "", "Path to the JSON file persisting the test outcomes across runs, to record the merged results in (optional)")
+	cmd.Flags().IntVar(&historyMaxRuns, "history-max-runs", history.DefaultMaxRuns, "Maximum number of runs kept in the history file (negative keeps all of them)")
+	cmd.Flags().StringVar(&reportOutput, "report-output", "", "Path to the flaky tests report, requires --history (optional)")
+	cmd.Flags().IntVar(&reportTop, "report-top", formatter.DefaultReportTop, "Number of tests listed in each section of the Markdown report")
+
+	return cmd
+}
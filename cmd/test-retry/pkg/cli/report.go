@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/test-retry/pkg/config"
+	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/test-retry/pkg/formatter"
+	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/test-retry/pkg/history"
+)
+
+// NewReportCommand creates the flaky tests report command
+func NewReportCommand(cfg *config.Config) *cobra.Command {
+	var historyPath string
+	var output string
+	var top int
+
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "Report the flakiest tests from the test history",
+		Long: `Report the flakiest tests and the duration trends of the slowest ones from the
+history file recorded by the e2e command with the --history flag.
+
+The report is exported in JSON for a .json output file and in Markdown otherwise.`,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			h, err := history.Load(historyPath)
+			if err != nil {
+				return err
+			}
+
+			if len(h.Runs) == 0 {
+				return fmt.Errorf("no runs recorded in %s", historyPath)
+			}
+
+			err = formatter.ExportReport(history.Analyze(h), formatter.ReportExportOptions{
+				OutputPath: output,
+				Top:        top,
+			})
+			if err != nil {
+				return err
+			}
+
+			if cfg.Verbose {
+				fmt.Printf("Flaky tests report of %d runs exported to %s\n", len(h.Runs), output)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&historyPath, "history", "", "Path to the JSON file persisting the test outcomes across runs")
+	cmd.Flags().StringVar(&output, "output", "", "Path to the report, JSON for a .json extension and Markdown otherwise")
+	cmd.Flags().IntVar(&top, "top", formatter.DefaultReportTop, "Number of tests listed in each section of the Markdown report")
+
+	_ = cmd.MarkFlagRequired("history")
+	_ = cmd.MarkFlagRequired("output")
+
+	return cmd
+}
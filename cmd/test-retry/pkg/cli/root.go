@@ -24,6 +24,8 @@ execution more efficient and reliable.`,
 
 	// Add subcommands
 	rootCmd.AddCommand(NewE2ECommand(cfg))
+	rootCmd.AddCommand(NewReportCommand(cfg))
+	rootCmd.AddCommand(NewMergeCommand(cfg))
 
 	return rootCmd
 }
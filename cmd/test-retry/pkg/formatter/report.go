@@ -0,0 +1,118 @@
+package formatter
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/test-retry/pkg/history"
+)
+
+// DefaultReportTop is the default number of tests listed in each section of the report
+const DefaultReportTop = 20
+
+// ReportExportOptions holds configuration for the flaky tests report export
+type ReportExportOptions struct {
+	// OutputPath of the report, exported in JSON for a .json extension and Markdown otherwise
+	OutputPath string
+	// Top is the number of tests listed in each section of the Markdown report
+	Top int
+}
+
+// ExportReport exports the flaky tests report, ranking the flakiest tests and showing the
+// duration trends of the slowest ones
+func ExportReport(report history.Report, opts ReportExportOptions) error {
+	if opts.OutputPath == "" {
+		return fmt.Errorf("output path is required")
+	}
+
+	if opts.Top <= 0 {
+		opts.Top = DefaultReportTop
+	}
+
+	var content []byte
+	if strings.EqualFold(filepath.Ext(opts.OutputPath), ".json") {
+		var err error
+		if content, err = json.MarshalIndent(report, "", "  "); err != nil {
+			return fmt.Errorf("failed to marshal report: %w", err)
+		}
+	} else {
+		content = []byte(renderMarkdownReport(report, opts.Top))
+	}
+
+	if err := os.WriteFile(opts.OutputPath, content, 0644); err != nil {
+		return fmt.Errorf("failed to write report file: %w", err)
+	}
+
+	return nil
+}
+
+func renderMarkdownReport(report history.Report, top int) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# Flaky tests report\n\n%d runs analyzed, %d tests.\n\n", report.Runs, len(report.Tests))
+
+	b.WriteString("## Flakiest tests\n\n")
+
+	flaky := report.Flakiest(top)
+	if len(flaky) == 0 {
+		b.WriteString("No flaky tests.\n")
+	} else {
+		b.WriteString("| # | Test | Score | Flaky | Flips | Failed | Runs | Quarantined |\n")
+		b.WriteString("|---|------|-------|-------|-------|--------|------|-------------|\n")
+
+		for i, s := range flaky {
+			fmt.Fprintf(&b, "| %d | `%s` | %.2f | %d | %d | %d | %d | %s |\n",
+				i+1, s.Name, s.FlakinessScore, s.Flaky, s.Flips, s.Failed, s.Runs, yesNo(s.Quarantined))
+		}
+	}
+
+	b.WriteString("\n## Duration trends\n\n")
+
+	slowest := report.Slowest(top)
+	if len(slowest) == 0 {
+		b.WriteString("No tests.\n")
+	} else {
+		b.WriteString("| Test | Last | Mean | Trend | History |\n")
+		b.WriteString("|------|------|------|-------|---------|\n")
+
+		for _, s := range slowest {
+			fmt.Fprintf(&b, "| `%s` | %s | %s | %+.0f%% | %s |\n",
+				s.Name, s.LastDuration().Round(time.Millisecond), s.MeanDuration.Round(time.Millisecond),
+				s.DurationTrend*100, sparkline(s.Durations))
+		}
+	}
+
+	return b.String()
+}
+
+// sparkline renders the durations as a line of block characters scaled to the longest one
+func sparkline(durations []time.Duration) string {
+	blocks := []rune("▁▂▃▄▅▆▇█")
+
+	var longest time.Duration
+	for _, d := range durations {
+		longest = max(longest, d)
+	}
+
+	line := make([]rune, 0, len(durations))
+	for _, d := range durations {
+		i := 0
+		if longest > 0 {
+			i = int(int64(d) * int64(len(blocks)-1) / int64(longest))
+		}
+		line = append(line, blocks[i])
+	}
+
+	return string(line)
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
@@ -0,0 +1,112 @@
+package formatter
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/test-retry/pkg/history"
+)
+
+func TestExportReport(t *testing.T) {
+	report := history.Report{
+		Runs: 3,
+		Tests: []history.TestStats{
+			{
+				Name:           "TestFlaky",
+				Runs:           3,
+				Passed:         1,
+				Flaky:          2,
+				FlakinessScore: 2.0 / 3,
+				Durations:      []time.Duration{time.Second, 2 * time.Second, 4 * time.Second},
+				MeanDuration:   2333 * time.Millisecond,
+				DurationTrend:  1.0,
+				Quarantined:    true,
+			},
+			{
+				Name:         "TestStable",
+				Runs:         3,
+				Passed:       3,
+				Durations:    []time.Duration{time.Second, time.Second, time.Second},
+				MeanDuration: time.Second,
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		outputPath  string
+		report      history.Report
+		wantErr     bool
+		errContains string
+		verify      func(t *testing.T, content []byte)
+	}{
+		{
+			name:       "markdown report",
+			outputPath: "report.md",
+			report:     report,
+			verify: func(t *testing.T, content []byte) {
+				t.Helper()
+
+				require.Contains(t, string(content), "3 runs analyzed, 2 tests.")
+				require.Contains(t, string(content), "| 1 | `TestFlaky` | 0.67 | 2 | 0 | 0 | 3 | yes |")
+				require.NotContains(t, string(content), "| 2 | `TestStable`")
+				require.Contains(t, string(content), "| `TestFlaky` | 4s | 2.333s | +100% | ▂▄█ |")
+				require.Contains(t, string(content), "| `TestStable` | 1s | 1s | +0% | ███ |")
+			},
+		},
+		{
+			name:       "markdown report without flaky tests",
+			outputPath: "report.md",
+			report:     history.Report{},
+			verify: func(t *testing.T, content []byte) {
+				t.Helper()
+
+				require.Contains(t, string(content), "No flaky tests.")
+			},
+		},
+		{
+			name:       "json report",
+			outputPath: "report.json",
+			report:     report,
+			verify: func(t *testing.T, content []byte) {
+				t.Helper()
+
+				var exported history.Report
+				require.NoError(t, json.Unmarshal(content, &exported))
+				require.Equal(t, report, exported)
+			},
+		},
+		{
+			name:        "missing output path",
+			report:      report,
+			wantErr:     true,
+			errContains: "output path is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			outputPath := tt.outputPath
+			if outputPath != "" {
+				outputPath = filepath.Join(t.TempDir(), outputPath)
+			}
+
+			err := ExportReport(tt.report, ReportExportOptions{OutputPath: outputPath})
+			if tt.wantErr {
+				require.ErrorContains(t, err, tt.errContains)
+				return
+			}
+			require.NoError(t, err)
+
+			content, err := os.ReadFile(outputPath)
+			require.NoError(t, err)
+
+			tt.verify(t, content)
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package formatter
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/test-retry/pkg/types"
+)
+
+// ExportResult exports the results of all the test attempts to a JSON file
+func ExportResult(result *types.TestResult, outputPath string) error {
+	if outputPath == "" {
+		return fmt.Errorf("output path is required")
+	}
+
+	content, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal test results: %w", err)
+	}
+
+	if err := os.WriteFile(outputPath, content, 0644); err != nil {
+		return fmt.Errorf("failed to write test results file: %w", err)
+	}
+
+	return nil
+}
+
+// ImportResult imports the results of the test attempts from a file exported by ExportResult,
+// or from a JUnit XML file exported by ExportToJUnit for a .xml extension. JUnit files do not
+// hold the package of the tests.
+func ImportResult(path string) (*types.TestResult, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read test results file: %w", err)
+	}
+
+	if strings.EqualFold(filepath.Ext(path), ".xml") {
+		return importJUnit(path, content)
+	}
+
+	result := &types.TestResult{}
+	if err := json.Unmarshal(content, result); err != nil {
+		return nil, fmt.Errorf("failed to parse test results file %s: %w", path, err)
+	}
+
+	return result, nil
+}
+
+func importJUnit(path string, content []byte) (*types.TestResult, error) {
+	suite := TestSuite{}
+	if err := xml.Unmarshal(content, &suite); err != nil {
+		return nil, fmt.Errorf("failed to parse JUnit XML file %s: %w", path, err)
+	}
+
+	result := &types.TestResult{
+		PassedTest: make([]types.TestCase, 0),
+		FailedTest: make([]types.TestCase, 0),
+	}
+
+	for _, tc := range suite.TestCases {
+		seconds, err := strconv.ParseFloat(tc.Duration, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid duration %q of test %s in %s: %w", tc.Duration, tc.Name, path, err)
+		}
+
+		test := types.TestCase{
+			Name:     tc.Name,
+			Duration: time.Duration(seconds * float64(time.Second)),
+		}
+
+		if tc.Failure != nil {
+			test.FailureOutput = tc.Failure.Content
+			result.FailedTest = append(result.FailedTest, test)
+		} else {
+			result.PassedTest = append(result.PassedTest, test)
+		}
+	}
+
+	return result, nil
+}
+
+// MergeResults combines the results of the test attempts of several runs, e.g. of the shards
+// of a run, into one
+func MergeResults(results ...*types.TestResult) *types.TestResult {
+	merged := &types.TestResult{
+		PassedTest: make([]types.TestCase, 0),
+		FailedTest: make([]types.TestCase, 0),
+	}
+
+	for _, r := range results {
+		merged.PassedTest = append(merged.PassedTest, r.PassedTest...)
+		merged.FailedTest = append(merged.FailedTest, r.FailedTest...)
+	}
+
+	return merged
+}
@@ -0,0 +1,78 @@
+package formatter
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/test-retry/pkg/types"
+)
+
+func TestExportImportResult(t *testing.T) {
+	result := &types.TestResult{
+		PassedTest: []types.TestCase{
+			{ID: 2, Name: "TestFlaky", Package: "example.com/pkg", Duration: 2 * time.Second, Time: time.Date(2026, 1, 1, 0, 0, 2, 0, time.UTC)},
+		},
+		FailedTest: []types.TestCase{
+			{ID: 1, Name: "TestFlaky", Package: "example.com/pkg", Duration: 1500 * time.Millisecond, FailureOutput: "boom", Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+
+	dir := t.TempDir()
+
+	t.Run("json", func(t *testing.T) {
+		path := filepath.Join(dir, "result.json")
+		require.NoError(t, ExportResult(result, path))
+
+		imported, err := ImportResult(path)
+		require.NoError(t, err)
+		require.Equal(t, result, imported)
+	})
+
+	t.Run("junit", func(t *testing.T) {
+		path := filepath.Join(dir, "junit.xml")
+		require.NoError(t, ExportToJUnit(result, JUnitExportOptions{OutputPath: path, SuiteName: "e2e-test"}))
+
+		imported, err := ImportResult(path)
+		require.NoError(t, err)
+		require.Equal(t, &types.TestResult{
+			PassedTest: []types.TestCase{{Name: "TestFlaky", Duration: 2 * time.Second}},
+			FailedTest: []types.TestCase{{Name: "TestFlaky", Duration: 1500 * time.Millisecond, FailureOutput: "boom"}},
+		}, imported)
+	})
+
+	t.Run("invalid file", func(t *testing.T) {
+		path := filepath.Join(dir, "invalid.json")
+		require.NoError(t, os.WriteFile(path, []byte("{"), 0600))
+
+		_, err := ImportResult(path)
+		require.ErrorContains(t, err, "failed to parse test results file")
+	})
+
+	t.Run("missing output path", func(t *testing.T) {
+		require.ErrorContains(t, ExportResult(result, ""), "output path is required")
+	})
+}
+
+func TestMergeResults(t *testing.T) {
+	shard1 := &types.TestResult{
+		PassedTest: []types.TestCase{{Name: "TestA"}},
+		FailedTest: []types.TestCase{{Name: "TestB"}},
+	}
+	shard2 := &types.TestResult{
+		PassedTest: []types.TestCase{{Name: "TestB"}, {Name: "TestC"}},
+	}
+
+	require.Equal(t, &types.TestResult{
+		PassedTest: []types.TestCase{{Name: "TestA"}, {Name: "TestB"}, {Name: "TestC"}},
+		FailedTest: []types.TestCase{{Name: "TestB"}},
+	}, MergeResults(shard1, shard2))
+
+	require.Equal(t, &types.TestResult{
+		PassedTest: []types.TestCase{},
+		FailedTest: []types.TestCase{},
+	}, MergeResults())
+}
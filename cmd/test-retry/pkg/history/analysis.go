@@ -0,0 +1,170 @@
+package history
+
+import (
+	"sort"
+	"time"
+)
+
+// TestStats summarizes the history of a test
+type TestStats struct {
+	Name    string `json:"name"`
+	Package string `json:"package,omitempty"`
+	// Runs is the number of runs in which the test ran
+	Runs   int `json:"runs"`
+	Passed int `json:"passed"`
+	Failed int `json:"failed"`
+	Flaky  int `json:"flaky"`
+	// Flips is the number of consecutive runs in which the test passed in one and failed in the
+	// other
+	Flips int `json:"flips"`
+	// FlakinessScore ranges from 0, for a test always passing or always failing, to 1, for a test
+	// flaky in every run. It is the ratio of the flaky runs and flips over the runs, so that a
+	// test consistently broken is not reported as flaky.
+	FlakinessScore float64 `json:"flakinessScore"`
+	// Durations of the test in the runs, from the oldest to the newest
+	Durations    []time.Duration `json:"durations"`
+	MeanDuration time.Duration   `json:"meanDuration"`
+	// DurationTrend is the relative change of the mean duration of the newest half of the runs
+	// over the oldest half, e.g. 0.2 when the test got 20% slower
+	DurationTrend float64 `json:"durationTrend"`
+	Quarantined   bool    `json:"quarantined,omitempty"`
+}
+
+// LastDuration returns the duration of the test in its most recent run
+func (s TestStats) LastDuration() time.Duration {
+	if len(s.Durations) == 0 {
+		return 0
+	}
+
+	return s.Durations[len(s.Durations)-1]
+}
+
+// Report summarizes the history of all the tests
+type Report struct {
+	Runs int `json:"runs"`
+	// Tests ranked from the flakiest to the least flaky
+	Tests []TestStats `json:"tests"`
+}
+
+// Flakiest returns at most n tests with a flakiness score greater than zero, from the
+// flakiest. A n lower or equal to zero returns all of them.
+func (r Report) Flakiest(n int) []TestStats {
+	var flaky []TestStats
+
+	for _, s := range r.Tests {
+		if s.FlakinessScore > 0 {
+			flaky = append(flaky, s)
+		}
+	}
+
+	if n > 0 && len(flaky) > n {
+		flaky = flaky[:n]
+	}
+
+	return flaky
+}
+
+// Slowest returns at most n tests, from the one with the highest mean duration. A n lower or
+// equal to zero returns all of them.
+func (r Report) Slowest(n int) []TestStats {
+	slow := append([]TestStats(nil), r.Tests...)
+
+	sort.SliceStable(slow, func(i, j int) bool {
+		return slow[i].MeanDuration > slow[j].MeanDuration
+	})
+
+	if n > 0 && len(slow) > n {
+		slow = slow[:n]
+	}
+
+	return slow
+}
+
+// Analyze computes the statistics of every test in the history
+func Analyze(h *History) Report {
+	type key struct{ pkg, name string }
+
+	stats := make(map[key]*TestStats)
+	last := make(map[key]Outcome)
+
+	for _, run := range h.Runs {
+		for _, t := range run.Tests {
+			k := key{t.Package, t.Name}
+
+			s, ok := stats[k]
+			if !ok {
+				s = &TestStats{Name: t.Name, Package: t.Package}
+				stats[k] = s
+			}
+
+			s.Runs++
+			s.Durations = append(s.Durations, t.Duration)
+			s.Quarantined = t.Quarantined
+
+			switch t.Outcome {
+			case OutcomePassed:
+				s.Passed++
+			case OutcomeFailed:
+				s.Failed++
+			case OutcomeFlaky:
+				s.Flaky++
+			}
+
+			// a flaky run already counts as such, only the changes between passing and failing
+			// runs are flips
+			if t.Outcome != OutcomeFlaky {
+				if prev, ok := last[k]; ok && prev != t.Outcome {
+					s.Flips++
+				}
+				last[k] = t.Outcome
+			}
+		}
+	}
+
+	report := Report{
+		Runs:  len(h.Runs),
+		Tests: make([]TestStats, 0, len(stats)),
+	}
+
+	for _, s := range stats {
+		s.FlakinessScore = min(1, float64(s.Flaky+s.Flips)/float64(s.Runs))
+		s.MeanDuration = mean(s.Durations)
+
+		if n := len(s.Durations); n >= 2 {
+			if older := mean(s.Durations[:n/2]); older > 0 {
+				s.DurationTrend = float64(mean(s.Durations[n/2:])-older) / float64(older)
+			}
+		}
+
+		report.Tests = append(report.Tests, *s)
+	}
+
+	sort.Slice(report.Tests, func(i, j int) bool {
+		a, b := report.Tests[i], report.Tests[j]
+		if a.FlakinessScore != b.FlakinessScore {
+			return a.FlakinessScore > b.FlakinessScore
+		}
+		if a.Failed != b.Failed {
+			return a.Failed > b.Failed
+		}
+		if a.Package != b.Package {
+			return a.Package < b.Package
+		}
+		return a.Name < b.Name
+	})
+
+	return report
+}
+
+func mean(durations []time.Duration) time.Duration {
+	if len(durations) == 0 {
+		return 0
+	}
+
+	var total time.Duration
+	for _, d := range durations {
+		total += d
+	}
+
+	return total / time.Duration(len(durations))
+}
@@ -0,0 +1,194 @@
+// Package history persists the outcome of the tests across runs of the test-retry CLI, to
+// detect flaky tests and follow the evolution of their duration.
+package history
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/test-retry/pkg/types"
+)
+
+// Version is the version of the history file format
+const Version = 1
+
+// DefaultMaxRuns is the default number of runs kept in the history
+const DefaultMaxRuns = 100
+
+// Outcome is the outcome of a test in a run
+type Outcome string
+
+const (
+	// OutcomePassed is the outcome of a test which passed at the first attempt
+	OutcomePassed Outcome = "pass"
+	// OutcomeFailed is the outcome of a test which failed at every attempt
+	OutcomeFailed Outcome = "fail"
+	// OutcomeFlaky is the outcome of a test which failed and then passed on retry
+	OutcomeFlaky Outcome = "flaky"
+)
+
+// TestRecord is the outcome of a test in a run
+type TestRecord struct {
+	Name     string  `json:"name"`
+	Package  string  `json:"package,omitempty"`
+	Outcome  Outcome `json:"outcome"`
+	Attempts int     `json:"attempts"`
+	// Duration of the last attempt
+	Duration    time.Duration `json:"duration"`
+	Quarantined bool          `json:"quarantined,omitempty"`
+}
+
+// Run holds the outcome of the tests in a run of the test-retry CLI
+type Run struct {
+	Time  time.Time    `json:"time"`
+	Tests []TestRecord `json:"tests"`
+}
+
+// History holds the outcome of the tests in the previous runs, from the oldest to the newest
+type History struct {
+	Version int   `json:"version"`
+	Runs    []Run `json:"runs"`
+}
+
+// Load reads the history from the given file, returning an empty history if it does not exist
+func Load(path string) (*History, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &History{Version: Version}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read history file: %w", err)
+	}
+
+	h := &History{}
+	if err := json.Unmarshal(data, h); err != nil {
+		return nil, fmt.Errorf("failed to parse history file %s: %w", path, err)
+	}
+
+	if h.Version != Version {
+		return nil, fmt.Errorf("unsupported history file version %d, expected %d", h.Version, Version)
+	}
+
+	return h, nil
+}
+
+// Save writes the history to the given file. The file is replaced atomically, so that an
+// interrupted run does not corrupt the history.
+func (h *History) Save(path string) error {
+	data, err := json.MarshalIndent(h, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal history: %w", err)
+	}
+
+	if dir := filepath.Dir(path); dir != "" {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("failed to create history directory: %w", err)
+		}
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return fmt.Errorf("failed to write history file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write history file: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write history file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to write history file: %w", err)
+	}
+
+	return nil
+}
+
+// Add appends a run to the history, dropping the oldest runs to keep at most maxRuns runs.
+// A maxRuns lower or equal to zero keeps all the runs.
+func (h *History) Add(run Run, maxRuns int) {
+	h.Runs = append(h.Runs, run)
+
+	if maxRuns > 0 && len(h.Runs) > maxRuns {
+		h.Runs = h.Runs[len(h.Runs)-maxRuns:]
+	}
+}
+
+// NewRun builds the run from the results of all the attempts of a test-retry invocation
+func NewRun(start time.Time, result *types.TestResult, quarantine *Quarantine) Run {
+	type attempts struct {
+		record TestRecord
+		passed bool
+		failed bool
+	}
+
+	tests := make(map[string]*attempts)
+
+	get := func(tc types.TestCase) *attempts {
+		key := tc.Package + "\x00" + tc.Name
+		a, ok := tests[key]
+		if !ok {
+			a = &attempts{record: TestRecord{
+				Name:        tc.Name,
+				Package:     tc.Package,
+				Quarantined: quarantine.Contains(tc.Name),
+			}}
+			tests[key] = a
+		}
+
+		a.record.Attempts++
+		return a
+	}
+
+	// The duration of the passing attempt wins over the ones of the failed attempts, as the
+	// test is not run anymore once passed.
+	for _, tc := range result.FailedTest {
+		a := get(tc)
+		a.failed = true
+		if !a.passed {
+			a.record.Duration = tc.Duration
+		}
+	}
+
+	for _, tc := range result.PassedTest {
+		a := get(tc)
+		a.passed = true
+		a.record.Duration = tc.Duration
+	}
+
+	run := Run{
+		Time:  start,
+		Tests: make([]TestRecord, 0, len(tests)),
+	}
+
+	for _, a := range tests {
+		switch {
+		case a.passed && a.failed:
+			a.record.Outcome = OutcomeFlaky
+		case a.passed:
+			a.record.Outcome = OutcomePassed
+		default:
+			a.record.Outcome = OutcomeFailed
+		}
+
+		run.Tests = append(run.Tests, a.record)
+	}
+
+	sort.Slice(run.Tests, func(i, j int) bool {
+		if run.Tests[i].Package != run.Tests[j].Package {
+			return run.Tests[i].Package < run.Tests[j].Package
+		}
+		return run.Tests[i].Name < run.Tests[j].Name
+	})
+
+	return run
+}
@@ -0,0 +1,154 @@
+package history
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/test-retry/pkg/types"
+)
+
+func TestNewRun(t *testing.T) {
+	start := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	result := &types.TestResult{
+		FailedTest: []types.TestCase{
+			{Name: "TestFlaky", Package: "example.com/pkg", Duration: 3 * time.Second},
+			{Name: "TestFail", Package: "example.com/pkg", Duration: 1 * time.Second},
+			{Name: "TestFail", Package: "example.com/pkg", Duration: 2 * time.Second},
+		},
+		PassedTest: []types.TestCase{
+			{Name: "TestPass", Package: "example.com/pkg", Duration: 500 * time.Millisecond},
+			{Name: "TestFlaky", Package: "example.com/pkg", Duration: 4 * time.Second},
+			{Name: "TestPass", Package: "example.com/other", Duration: time.Second},
+		},
+	}
+
+	run := NewRun(start, result, NewQuarantine([]string{"TestFail"}))
+
+	require.Equal(t, Run{
+		Time: start,
+		Tests: []TestRecord{
+			{Name: "TestPass", Package: "example.com/other", Outcome: OutcomePassed, Attempts: 1, Duration: time.Second},
+			{Name: "TestFail", Package: "example.com/pkg", Outcome: OutcomeFailed, Attempts: 2, Duration: 2 * time.Second, Quarantined: true},
+			{Name: "TestFlaky", Package: "example.com/pkg", Outcome: OutcomeFlaky, Attempts: 2, Duration: 4 * time.Second},
+			{Name: "TestPass", Package: "example.com/pkg", Outcome: OutcomePassed, Attempts: 1, Duration: 500 * time.Millisecond},
+		},
+	}, run)
+}
+
+func TestHistorySaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history", "history.json")
+
+	h, err := Load(path)
+	require.NoError(t, err)
+	require.Equal(t, &History{Version: Version}, h)
+
+	for i := range 3 {
+		h.Add(Run{
+			Time:  time.Date(2026, 1, i+1, 0, 0, 0, 0, time.UTC),
+			Tests: []TestRecord{{Name: "TestA", Outcome: OutcomePassed, Attempts: 1, Duration: time.Duration(i) * time.Second}},
+		}, 2)
+	}
+
+	require.Len(t, h.Runs, 2)
+	require.Equal(t, 2, h.Runs[0].Time.Day())
+
+	require.NoError(t, h.Save(path))
+
+	loaded, err := Load(path)
+	require.NoError(t, err)
+	require.Equal(t, h, loaded)
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	require.Len(t, entries, 1, "temporary files must be cleaned up")
+}
+
+func TestHistoryLoadErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		errContains string
+	}{
+		{
+			name:        "invalid json",
+			content:     "{",
+			errContains: "failed to parse history file",
+		},
+		{
+			name:        "unsupported version",
+			content:     `{"version": 42}`,
+			errContains: "unsupported history file version 42",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "history.json")
+			require.NoError(t, os.WriteFile(path, []byte(tt.content), 0600))
+
+			_, err := Load(path)
+			require.ErrorContains(t, err, tt.errContains)
+		})
+	}
+}
+
+func TestAnalyze(t *testing.T) {
+	outcomes := map[string][]Outcome{
+		"TestStable":    {OutcomePassed, OutcomePassed, OutcomePassed, OutcomePassed},
+		"TestBroken":    {OutcomeFailed, OutcomeFailed, OutcomeFailed, OutcomeFailed},
+		"TestFlaky":     {OutcomeFlaky, OutcomePassed, OutcomeFlaky, OutcomePassed},
+		"TestFlipFlops": {OutcomePassed, OutcomeFailed, OutcomePassed, OutcomePassed},
+	}
+
+	h := &History{Version: Version}
+	for i := range 4 {
+		run := Run{}
+		for name, o := range outcomes {
+			run.Tests = append(run.Tests, TestRecord{
+				Name:     name,
+				Outcome:  o[i],
+				Attempts: 1,
+				Duration: time.Duration(i+1) * time.Second,
+			})
+		}
+		h.Add(run, 0)
+	}
+
+	report := Analyze(h)
+	require.Equal(t, 4, report.Runs)
+
+	names := make([]string, 0, len(report.Tests))
+	for _, s := range report.Tests {
+		names = append(names, s.Name)
+	}
+	// equal scores are ranked by failed runs
+	require.Equal(t, []string{"TestFlipFlops", "TestFlaky", "TestBroken", "TestStable"}, names)
+
+	flaky := report.Tests[1]
+	require.Equal(t, 2, flaky.Flaky)
+	require.Equal(t, 0, flaky.Flips)
+	require.InDelta(t, 0.5, flaky.FlakinessScore, 1e-9)
+
+	flipFlops := report.Tests[0]
+	require.Equal(t, 2, flipFlops.Flips)
+	require.InDelta(t, 0.5, flipFlops.FlakinessScore, 1e-9)
+
+	broken := report.Tests[2]
+	require.Equal(t, 4, broken.Failed)
+	require.Zero(t, broken.FlakinessScore)
+
+	// durations of 1s, 2s, 3s and 4s: the newest half is 2s slower than the oldest one
+	require.Equal(t, []time.Duration{time.Second, 2 * time.Second, 3 * time.Second, 4 * time.Second}, broken.Durations)
+	require.Equal(t, 4*time.Second, broken.LastDuration())
+	require.Equal(t, 2500*time.Millisecond, broken.MeanDuration)
+	require.InDelta(t, 2/1.5, broken.DurationTrend, 1e-9)
+
+	require.Len(t, report.Flakiest(0), 2)
+	require.Len(t, report.Flakiest(1), 1)
+	require.Len(t, report.Slowest(3), 3)
+}
@@ -0,0 +1,134 @@
+package history
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/test-retry/pkg/types"
+)
+
+// Quarantine is a list of known flaky tests, which are still run but whose failures do not
+// fail the job. A quarantined test is matched by its full name, e.g.
+// TestOdhOperator/components/kserve, and its subtests are quarantined along with it.
+type Quarantine struct {
+	tests []string
+}
+
+// NewQuarantine creates a quarantine from a list of test names, ignoring the empty ones
+func NewQuarantine(tests []string) *Quarantine {
+	q := &Quarantine{}
+
+	for _, t := range tests {
+		if t = strings.TrimSpace(t); t != "" {
+			q.tests = append(q.tests, t)
+		}
+	}
+
+	return q
+}
+
+// LoadQuarantineFile reads the names of the quarantined tests from a file, one per line.
+// Empty lines and lines starting with # are ignored.
+func LoadQuarantineFile(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open quarantine file: %w", err)
+	}
+	defer f.Close()
+
+	var tests []string
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		tests = append(tests, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read quarantine file: %w", err)
+	}
+
+	return tests, nil
+}
+
+// Len returns the number of quarantined tests
+func (q *Quarantine) Len() int {
+	if q == nil {
+		return 0
+	}
+
+	return len(q.tests)
+}
+
+// Contains returns whether the given test, or one of its parents, is quarantined
+func (q *Quarantine) Contains(testName string) bool {
+	if q == nil {
+		return false
+	}
+
+	for _, t := range q.tests {
+		if testName == t || strings.HasPrefix(testName, strings.TrimSuffix(t, "/")+"/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Split splits the failed tests of a run into the ones failing the job and the quarantined
+// ones. A parent test fails when one of its subtests fails, so it is considered quarantined
+// when all its failed subtests are.
+func (q *Quarantine) Split(failed []types.TestCase) ([]types.TestCase, []types.TestCase) {
+	var blocking, quarantined []types.TestCase
+
+	for _, tc := range failed {
+		if q.excused(tc.Name, failed) {
+			quarantined = append(quarantined, tc)
+		} else {
+			blocking = append(blocking, tc)
+		}
+	}
+
+	return blocking, quarantined
+}
+
+func (q *Quarantine) excused(testName string, failed []types.TestCase) bool {
+	if q.Contains(testName) {
+		return true
+	}
+
+	prefix := testName + "/"
+	hasSubtests := false
+
+	for _, tc := range failed {
+		if !strings.HasPrefix(tc.Name, prefix) || hasFailedSubtests(tc.Name, failed) {
+			continue
+		}
+
+		hasSubtests = true
+
+		if !q.Contains(tc.Name) {
+			return false
+		}
+	}
+
+	return hasSubtests
+}
+
+func hasFailedSubtests(testName string, failed []types.TestCase) bool {
+	prefix := testName + "/"
+
+	for _, tc := range failed {
+		if strings.HasPrefix(tc.Name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,98 @@
+package history
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/test-retry/pkg/types"
+)
+
+func TestQuarantineContains(t *testing.T) {
+	q := NewQuarantine([]string{"TestOdhOperator/components/kserve", " ", "TestOther/"})
+
+	require.Equal(t, 2, q.Len())
+
+	require.True(t, q.Contains("TestOdhOperator/components/kserve"))
+	require.True(t, q.Contains("TestOdhOperator/components/kserve/Validate_deployment"))
+	require.True(t, q.Contains("TestOther/sub"))
+	require.False(t, q.Contains("TestOdhOperator/components/kserveraw"))
+	require.False(t, q.Contains("TestOdhOperator/components"))
+
+	var nilQuarantine *Quarantine
+	require.False(t, nilQuarantine.Contains("TestOther"))
+	require.Zero(t, nilQuarantine.Len())
+}
+
+func TestQuarantineSplit(t *testing.T) {
+	tests := []struct {
+		name                string
+		quarantine          []string
+		failed              []string
+		expectedBlocking    []string
+		expectedQuarantined []string
+	}{
+		{
+			name:             "no quarantine",
+			failed:           []string{"TestA", "TestB"},
+			expectedBlocking: []string{"TestA", "TestB"},
+		},
+		{
+			name:                "top level tests",
+			quarantine:          []string{"TestA"},
+			failed:              []string{"TestA", "TestB"},
+			expectedBlocking:    []string{"TestB"},
+			expectedQuarantined: []string{"TestA"},
+		},
+		{
+			name:                "parents of quarantined subtests",
+			quarantine:          []string{"TestA/sub/leaf"},
+			failed:              []string{"TestA", "TestA/sub", "TestA/sub/leaf"},
+			expectedQuarantined: []string{"TestA", "TestA/sub", "TestA/sub/leaf"},
+		},
+		{
+			name:                "parents of quarantined and failing subtests",
+			quarantine:          []string{"TestA/one"},
+			failed:              []string{"TestA", "TestA/one", "TestA/two"},
+			expectedBlocking:    []string{"TestA", "TestA/two"},
+			expectedQuarantined: []string{"TestA/one"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			failed := make([]types.TestCase, 0, len(tt.failed))
+			for _, name := range tt.failed {
+				failed = append(failed, types.TestCase{Name: name})
+			}
+
+			blocking, quarantined := NewQuarantine(tt.quarantine).Split(failed)
+
+			require.Equal(t, tt.expectedBlocking, testNames(blocking))
+			require.Equal(t, tt.expectedQuarantined, testNames(quarantined))
+		})
+	}
+}
+
+func TestLoadQuarantineFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "quarantine.txt")
+	content := "# known flaky tests\nTestA\n\n  TestB/sub  \n"
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+
+	tests, err := LoadQuarantineFile(path)
+	require.NoError(t, err)
+	require.Equal(t, []string{"TestA", "TestB/sub"}, tests)
+
+	_, err = LoadQuarantineFile(filepath.Join(t.TempDir(), "missing.txt"))
+	require.ErrorContains(t, err, "failed to open quarantine file")
+}
+
+func testNames(tcs []types.TestCase) []string {
+	var names []string
+	for _, tc := range tcs {
+		names = append(names, tc.Name)
+	}
+	return names
+}
@@ -0,0 +1,59 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// MarkdownNotifier appends a summary of the notification to a Markdown file, e.g. the
+// $GITHUB_STEP_SUMMARY file of GitHub Actions
+type MarkdownNotifier struct {
+	path string
+}
+
+// NewMarkdownNotifier creates a notifier appending to the given file
+func NewMarkdownNotifier(path string) *MarkdownNotifier {
+	return &MarkdownNotifier{path: path}
+}
+
+// Name identifies the notifier in the logs
+func (m *MarkdownNotifier) Name() string {
+	return "markdown summary " + m.path
+}
+
+// Notify appends the summary to the file, creating it if needed
+func (m *MarkdownNotifier) Notify(_ context.Context, n Notification) error {
+	f, err := os.OpenFile(m.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open summary file: %w", err)
+	}
+
+	if _, err := f.WriteString(markdownSummary(n)); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write summary file: %w", err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write summary file: %w", err)
+	}
+
+	return nil
+}
+
+func markdownSummary(n Notification) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "## %s %s\n\n", statusEmoji(n.Status), headline(n))
+	b.WriteString("| Test | Result | Attempts |\n")
+	b.WriteString("|------|--------|----------|\n")
+
+	for _, t := range n.Tests {
+		fmt.Fprintf(&b, "| `%s` | %s | %d |\n", t.Name, t.Classification, t.Attempts)
+	}
+
+	b.WriteString("\n")
+
+	return b.String()
+}
@@ -0,0 +1,118 @@
+// Package notifier notifies the outcome of the test runs in which tests failed, e.g. to chat
+// channels or CI step summaries.
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/test-retry/pkg/types"
+)
+
+// Status is the overall status of a run in which tests failed
+type Status string
+
+const (
+	// StatusFlaky is the status of a run in which all the failed tests passed on retry, or are
+	// quarantined
+	StatusFlaky Status = "flaky"
+	// StatusFailed is the status of a run in which tests still failed after the retries
+	StatusFailed Status = "failed"
+)
+
+// Classification is the outcome of a failed test
+type Classification string
+
+const (
+	// ClassificationFlaky is the classification of a test which failed and then passed on retry
+	ClassificationFlaky Classification = "flaky"
+	// ClassificationFailed is the classification of a test which failed at every attempt
+	ClassificationFailed Classification = "failed"
+	// ClassificationQuarantined is the classification of a quarantined test which failed at
+	// every attempt, without failing the run
+	ClassificationQuarantined Classification = "quarantined"
+)
+
+// Test is a test which failed at least once in the run
+type Test struct {
+	Name           string         `json:"name"`
+	Package        string         `json:"package,omitempty"`
+	Classification Classification `json:"classification"`
+	Attempts       int            `json:"attempts"`
+}
+
+// Notification describes a run in which tests failed
+type Notification struct {
+	Status Status `json:"status"`
+	// Retries is the number of retry attempts run
+	Retries    int    `json:"retries"`
+	MaxRetries int    `json:"maxRetries"`
+	Tests      []Test `json:"tests"`
+}
+
+// Count returns the number of tests with the given classification
+func (n Notification) Count(c Classification) int {
+	count := 0
+	for _, t := range n.Tests {
+		if t.Classification == c {
+			count++
+		}
+	}
+	return count
+}
+
+// Notifier notifies the runs in which tests failed
+type Notifier interface {
+	// Name identifies the notifier in the logs
+	Name() string
+	Notify(ctx context.Context, n Notification) error
+}
+
+// New creates the notifiers configured by the options
+func New(opts types.NotifierOptions) []Notifier {
+	var notifiers []Notifier
+
+	for _, url := range opts.WebhookURLs {
+		notifiers = append(notifiers, NewWebhookNotifier(url))
+	}
+
+	for _, url := range opts.SlackWebhookURLs {
+		notifiers = append(notifiers, NewSlackNotifier(url))
+	}
+
+	if opts.MarkdownSummaryPath != "" {
+		notifiers = append(notifiers, NewMarkdownNotifier(opts.MarkdownSummaryPath))
+	}
+
+	return notifiers
+}
+
+// headline summarizes the notification in a sentence
+func headline(n Notification) string {
+	var parts []string
+
+	if c := n.Count(ClassificationFailed); c > 0 {
+		parts = append(parts, fmt.Sprintf("%d failed", c))
+	}
+	if c := n.Count(ClassificationQuarantined); c > 0 {
+		parts = append(parts, fmt.Sprintf("%d quarantined", c))
+	}
+	if c := n.Count(ClassificationFlaky); c > 0 {
+		parts = append(parts, fmt.Sprintf("%d flaky", c))
+	}
+
+	title := "E2E tests passed with flaky tests"
+	if n.Status == StatusFailed {
+		title = "E2E tests failed"
+	}
+
+	return fmt.Sprintf("%s: %s after %d of %d retries", title, strings.Join(parts, ", "), n.Retries, n.MaxRetries)
+}
+
+func statusEmoji(s Status) string {
+	if s == StatusFailed {
+		return "❌"
+	}
+	return "⚠️"
+}
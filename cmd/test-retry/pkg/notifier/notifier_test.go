@@ -0,0 +1,136 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/test-retry/pkg/types"
+)
+
+var testNotification = Notification{
+	Status:     StatusFailed,
+	Retries:    2,
+	MaxRetries: 3,
+	Tests: []Test{
+		{Name: "TestA", Package: "example.com/pkg", Classification: ClassificationFailed, Attempts: 3},
+		{Name: "TestB", Package: "example.com/pkg", Classification: ClassificationFlaky, Attempts: 2},
+		{Name: "TestC", Package: "example.com/pkg", Classification: ClassificationQuarantined, Attempts: 3},
+	},
+}
+
+func newServer(t *testing.T, status int) (*httptest.Server, *[]byte) {
+	t.Helper()
+
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		body = b
+
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte("server says no"))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &body
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		server, body := newServer(t, http.StatusAccepted)
+
+		err := NewWebhookNotifier(server.URL+"/hooks/secret").Notify(context.Background(), testNotification)
+		require.NoError(t, err)
+
+		var received Notification
+		require.NoError(t, json.Unmarshal(*body, &received))
+		require.Equal(t, testNotification, received)
+	})
+
+	t.Run("error status", func(t *testing.T) {
+		server, _ := newServer(t, http.StatusBadRequest)
+
+		err := NewWebhookNotifier(server.URL+"/hooks/secret").Notify(context.Background(), testNotification)
+		require.ErrorContains(t, err, "400 Bad Request: server says no")
+		require.NotContains(t, err.Error(), "secret", "the webhook URL must be redacted")
+	})
+}
+
+func TestSlackNotifier(t *testing.T) {
+	server, body := newServer(t, http.StatusOK)
+
+	notifier := NewSlackNotifier(server.URL + "/services/secret")
+	require.Equal(t, "slack "+server.URL, notifier.Name())
+
+	require.NoError(t, notifier.Notify(context.Background(), testNotification))
+
+	var received map[string]string
+	require.NoError(t, json.Unmarshal(*body, &received))
+	require.Equal(t, "❌ *E2E tests failed: 1 failed, 1 quarantined, 1 flaky after 2 of 3 retries*\n"+
+		"• `TestA`: failed, 3 attempts\n"+
+		"• `TestB`: flaky, 2 attempts\n"+
+		"• `TestC`: quarantined, 3 attempts\n", received["text"])
+}
+
+func TestSlackMessageTruncated(t *testing.T) {
+	n := Notification{Status: StatusFlaky, Retries: 1, MaxRetries: 1}
+	for range slackMaxTests + 5 {
+		n.Tests = append(n.Tests, Test{Name: "TestFlaky", Classification: ClassificationFlaky, Attempts: 2})
+	}
+
+	msg := slackMessage(n)
+	require.True(t, strings.HasPrefix(msg, "⚠️ *E2E tests passed with flaky tests: 25 flaky after 1 of 1 retries*\n"))
+	require.Equal(t, slackMaxTests, strings.Count(msg, "`TestFlaky`"))
+	require.Contains(t, msg, "• and 5 more\n")
+}
+
+func TestMarkdownNotifier(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "summary.md")
+	require.NoError(t, os.WriteFile(path, []byte("# Existing summary\n\n"), 0600))
+
+	require.NoError(t, NewMarkdownNotifier(path).Notify(context.Background(), testNotification))
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "# Existing summary\n\n"+
+		"## ❌ E2E tests failed: 1 failed, 1 quarantined, 1 flaky after 2 of 3 retries\n\n"+
+		"| Test | Result | Attempts |\n"+
+		"|------|--------|----------|\n"+
+		"| `TestA` | failed | 3 |\n"+
+		"| `TestB` | flaky | 2 |\n"+
+		"| `TestC` | quarantined | 3 |\n\n", string(content))
+}
+
+func TestNew(t *testing.T) {
+	notifiers := New(types.NotifierOptions{
+		WebhookURLs:         []string{"https://example.com/a", "https://example.com/b"},
+		SlackWebhookURLs:    []string{"https://hooks.slack.com/services/x"},
+		MarkdownSummaryPath: "summary.md",
+	})
+
+	names := make([]string, 0, len(notifiers))
+	for _, n := range notifiers {
+		names = append(names, n.Name())
+	}
+
+	require.Equal(t, []string{
+		"webhook https://example.com",
+		"webhook https://example.com",
+		"slack https://hooks.slack.com",
+		"markdown summary summary.md",
+	}, names)
+
+	require.Empty(t, New(types.NotifierOptions{}))
+}
@@ -0,0 +1,52 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// slackMaxTests is the maximum number of tests listed in a Slack message
+const slackMaxTests = 20
+
+// SlackNotifier posts the notification as a message to a Slack compatible incoming webhook
+type SlackNotifier struct {
+	url    string
+	client *http.Client
+}
+
+// NewSlackNotifier creates a notifier posting to the given incoming webhook URL
+func NewSlackNotifier(url string) *SlackNotifier {
+	return &SlackNotifier{
+		url:    url,
+		client: &http.Client{Timeout: defaultTimeout},
+	}
+}
+
+// Name identifies the notifier in the logs
+func (s *SlackNotifier) Name() string {
+	return "slack " + redactURL(s.url)
+}
+
+// Notify posts the notification message to the incoming webhook
+func (s *SlackNotifier) Notify(ctx context.Context, n Notification) error {
+	return postJSON(ctx, s.client, s.url, map[string]string{"text": slackMessage(n)})
+}
+
+func slackMessage(n Notification) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%s *%s*\n", statusEmoji(n.Status), headline(n))
+
+	for i, t := range n.Tests {
+		if i == slackMaxTests {
+			fmt.Fprintf(&b, "• and %d more\n", len(n.Tests)-slackMaxTests)
+			break
+		}
+
+		fmt.Fprintf(&b, "• `%s`: %s, %d attempts\n", t.Name, t.Classification, t.Attempts)
+	}
+
+	return b.String()
+}
@@ -9,9 +9,12 @@ import (
 	"slices"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/test-retry/pkg/formatter"
 	github "github.com/opendatahub-io/opendatahub-operator/v2/cmd/test-retry/pkg/github"
+	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/test-retry/pkg/history"
+	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/test-retry/pkg/notifier"
 	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/test-retry/pkg/parser"
 	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/test-retry/pkg/types"
 )
@@ -20,6 +23,10 @@ import (
 type E2ETestRunner struct {
 	opts         types.E2ETestOptions
 	githubClient github.GitHubClient
+	quarantine   *history.Quarantine
+	notifiers    []notifier.Notifier
+	// skip filter of the tests assigned to the other shards
+	shardSkipFilter string
 }
 
 // NewE2ETestRunner creates a new e2e test runner
@@ -27,6 +34,8 @@ func NewE2ETestRunner(opts types.E2ETestOptions) *E2ETestRunner {
 	return &E2ETestRunner{
 		opts:         opts,
 		githubClient: github.NewClient(opts.PROptions.Token),
+		quarantine:   history.NewQuarantine(opts.QuarantinedTests),
+		notifiers:    notifier.New(opts.NotifierOptions),
 	}
 }
 
@@ -36,6 +45,14 @@ func (r *E2ETestRunner) Run() error {
 		fmt.Println("Starting e2e test execution with retry functionality...")
 	}
 
+	start := time.Now()
+
+	shardSkipFilter, err := r.buildShardSkipFilter()
+	if err != nil {
+		return fmt.Errorf("failed to shard e2e tests: %w", err)
+	}
+	r.shardSkipFilter = shardSkipFilter
+
 	// Aggregate results to collect all test attempts for JUnit export
 	aggregateResult := &types.TestResult{
 		PassedTest: make([]types.TestCase, 0),
@@ -59,9 +76,12 @@ func (r *E2ETestRunner) Run() error {
 
 	hasFirstRunFailedTests := len(testResult.FailedTest) > 0
 	lastTestResult := testResult
+	retries := 0
 
 	// Retry tests, skipping the ones that already passed
 	for attempt := 1; attempt <= r.opts.MaxRetries && hasFirstRunFailedTests; attempt++ {
+		retries = attempt
+
 		if r.opts.Config.Verbose {
 			fmt.Printf("Retry attempt %d\n", attempt)
 		}
@@ -101,21 +121,51 @@ func (r *E2ETestRunner) Run() error {
 		}
 	}
 
-	// Final summary
-	if len(lastTestResult.FailedTest) > 0 {
+	// Export the results to be merged with the ones of the other shards if path is specified
+	if r.opts.ResultOutputPath != "" {
+		if err := formatter.ExportResult(aggregateResult, r.opts.ResultOutputPath); err != nil {
+			fmt.Printf("Warning: failed to export test results: %v\n", err)
+		} else if r.opts.Config.Verbose {
+			fmt.Printf("Test results exported to %s\n", r.opts.ResultOutputPath)
+		}
+	}
+
+	// Record the outcome of the tests and report the flakiest ones. A shard only ran a part of
+	// the tests, the merged results of all the shards are recorded instead.
+	run := history.NewRun(start, aggregateResult, r.quarantine)
+	if r.opts.Shards <= 1 {
+		r.recordHistory(run)
+	}
+
+	// Final summary, quarantined tests do not fail the run
+	failedTests, quarantinedTests := r.quarantine.Split(lastTestResult.FailedTest)
+
+	if hasFirstRunFailedTests {
+		r.notify(run, retries, len(failedTests) > 0, quarantinedTests)
+	}
+
+	if len(failedTests) > 0 {
 		fmt.Printf("❌ Final result: %d tests still failing after %d retries\n",
-			len(lastTestResult.FailedTest), r.opts.MaxRetries)
+			len(failedTests), r.opts.MaxRetries)
 		// Show which tests are still failing
-		for _, failedTest := range lastTestResult.FailedTest {
+		for _, failedTest := range failedTests {
 			fmt.Printf("  - %s\n", failedTest.Name)
 		}
 
-		return fmt.Errorf("%d tests failed after retries", len(lastTestResult.FailedTest))
+		return fmt.Errorf("%d tests failed after retries", len(failedTests))
 	}
 
-	if hasFirstRunFailedTests {
+	if len(quarantinedTests) > 0 {
+		fmt.Printf("⚠️  All tests passed, but %d quarantined tests are still failing after %d retries\n",
+			len(quarantinedTests), r.opts.MaxRetries)
+		for _, quarantinedTest := range quarantinedTests {
+			fmt.Printf("  - %s\n", quarantinedTest.Name)
+		}
+	} else if hasFirstRunFailedTests {
 		fmt.Println("⚠️  All tests passed, but some tests were flaky (failed initially but passed on retry)")
+	}
 
+	if hasFirstRunFailedTests {
 		// Notify PR if GitHub info is provided
 		r.notifyPROnFailure()
 
@@ -139,6 +189,13 @@ func (r *E2ETestRunner) runE2ETests(skipTestFilter string) (*types.TestResult, e
 		args = append(args, "-run", r.opts.TestFilter)
 	}
 
+	if r.shardSkipFilter != "" {
+		if skipTestFilter != "" {
+			skipTestFilter += "|"
+		}
+		skipTestFilter += r.shardSkipFilter
+	}
+
 	if skipTestFilter != "" {
 		args = append(args, "-skip", skipTestFilter)
 	}
@@ -343,6 +400,108 @@ func (r *E2ETestRunner) exportJUnit(result *types.TestResult) error {
 	})
 }
 
+// recordHistory appends the run to the history file and exports the flaky tests report, if
+// configured. Failures are reported as warnings, as they must not fail the run.
+func (r *E2ETestRunner) recordHistory(run history.Run) {
+	if r.opts.HistoryPath == "" && r.opts.ReportOutputPath == "" {
+		return
+	}
+
+	h := &history.History{Version: history.Version}
+
+	if r.opts.HistoryPath != "" {
+		loaded, err := history.Load(r.opts.HistoryPath)
+		if err != nil {
+			fmt.Printf("Warning: failed to load test history, starting a new one: %v\n", err)
+		} else {
+			h = loaded
+		}
+	}
+
+	maxRuns := r.opts.HistoryMaxRuns
+	if maxRuns == 0 {
+		maxRuns = history.DefaultMaxRuns
+	}
+
+	h.Add(run, maxRuns)
+
+	if r.opts.HistoryPath != "" {
+		if err := h.Save(r.opts.HistoryPath); err != nil {
+			fmt.Printf("Warning: failed to save test history: %v\n", err)
+		} else if r.opts.Config.Verbose {
+			fmt.Printf("Test history saved to %s\n", r.opts.HistoryPath)
+		}
+	}
+
+	if r.opts.ReportOutputPath != "" {
+		err := formatter.ExportReport(history.Analyze(h), formatter.ReportExportOptions{
+			OutputPath: r.opts.ReportOutputPath,
+			Top:        r.opts.ReportTop,
+		})
+		if err != nil {
+			fmt.Printf("Warning: failed to export flaky tests report: %v\n", err)
+		} else if r.opts.Config.Verbose {
+			fmt.Printf("Flaky tests report exported to %s\n", r.opts.ReportOutputPath)
+		}
+	}
+}
+
+// notify sends the tests which failed at least once, classified as flaky, failed or
+// quarantined, to the configured notifiers. Failures are reported as warnings, as they must not
+// fail the run.
+func (r *E2ETestRunner) notify(run history.Run, retries int, failed bool, quarantinedTests []types.TestCase) {
+	if len(r.notifiers) == 0 {
+		return
+	}
+
+	quarantined := make(map[string]bool, len(quarantinedTests))
+	for _, tc := range quarantinedTests {
+		quarantined[tc.Name] = true
+	}
+
+	n := notifier.Notification{
+		Status:     notifier.StatusFlaky,
+		Retries:    retries,
+		MaxRetries: r.opts.MaxRetries,
+	}
+
+	if failed {
+		n.Status = notifier.StatusFailed
+	}
+
+	for _, t := range run.Tests {
+		var classification notifier.Classification
+
+		switch {
+		case t.Outcome == history.OutcomeFlaky:
+			classification = notifier.ClassificationFlaky
+		case t.Outcome == history.OutcomeFailed && quarantined[t.Name]:
+			classification = notifier.ClassificationQuarantined
+		case t.Outcome == history.OutcomeFailed:
+			classification = notifier.ClassificationFailed
+		default:
+			continue
+		}
+
+		n.Tests = append(n.Tests, notifier.Test{
+			Name:           t.Name,
+			Package:        t.Package,
+			Classification: classification,
+			Attempts:       t.Attempts,
+		})
+	}
+
+	ctx := context.Background()
+
+	for _, nt := range r.notifiers {
+		if err := nt.Notify(ctx, n); err != nil {
+			fmt.Printf("Warning: failed to notify %s: %v\n", nt.Name(), err)
+		} else if r.opts.Config.Verbose {
+			fmt.Printf("✓ Successfully notified %s\n", nt.Name())
+		}
+	}
+}
+
 // notifyPROnFailure adds a label and/or comment to the GitHub PR if configured
 func (r *E2ETestRunner) notifyPROnFailure() {
 	// Only proceed if basic GitHub options are configured
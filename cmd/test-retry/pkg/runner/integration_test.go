@@ -1,15 +1,22 @@
 package runner
 
 import (
+	"encoding/json"
 	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/test-retry/pkg/config"
 	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/test-retry/pkg/formatter"
+	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/test-retry/pkg/history"
+	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/test-retry/pkg/notifier"
 	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/test-retry/pkg/types"
 )
 
@@ -325,3 +332,188 @@ func readJUnitFile(t *testing.T, path string) formatter.TestSuite {
 
 	return suite
 }
+
+func TestQuarantinedTests(t *testing.T) {
+	testCases := []struct {
+		name          string
+		quarantine    []string
+		expectedError string
+	}{
+		{
+			name:       "all failing tests quarantined",
+			quarantine: []string{"TestAlwaysFail1", "TestAlwaysFail2"},
+		},
+		{
+			name:          "some failing tests quarantined",
+			quarantine:    []string{"TestAlwaysFail1"},
+			expectedError: "1 tests failed after retries",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			opts := types.E2ETestOptions{
+				MaxRetries:        1,
+				TestPath:          "./testdata/failing",
+				Config:            &config.Config{Verbose: false},
+				NeverSkipPrefixes: []string{},
+				SkipAtPrefixes:    []string{},
+				QuarantinedTests:  testCase.quarantine,
+			}
+
+			err := NewE2ETestRunner(opts).Run()
+
+			if testCase.expectedError != "" {
+				require.EqualError(t, err, testCase.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestHistoryRecordedAcrossRuns(t *testing.T) {
+	dir := t.TempDir()
+
+	opts := types.E2ETestOptions{
+		MaxRetries:        2,
+		TestPath:          "./testdata/flaky",
+		Config:            &config.Config{Verbose: false},
+		NeverSkipPrefixes: []string{},
+		SkipAtPrefixes:    []string{"TestNonFlaky", "TestFlaky1", "TestFlaky2", "TestFlaky3"},
+		HistoryPath:       filepath.Join(dir, "history.json"),
+		HistoryMaxRuns:    1,
+		ReportOutputPath:  filepath.Join(dir, "report.json"),
+	}
+
+	for range 2 {
+		require.NoError(t, NewE2ETestRunner(opts).Run())
+	}
+
+	h, err := history.Load(opts.HistoryPath)
+	require.NoError(t, err)
+	require.Len(t, h.Runs, 1)
+
+	outcomes := make(map[string]history.Outcome)
+	for _, record := range h.Runs[0].Tests {
+		outcomes[record.Name] = record.Outcome
+	}
+	require.Equal(t, map[string]history.Outcome{
+		"TestFlaky1":   history.OutcomeFlaky,
+		"TestFlaky2":   history.OutcomeFlaky,
+		"TestFlaky3":   history.OutcomeFlaky,
+		"TestNonFlaky": history.OutcomePassed,
+	}, outcomes)
+
+	content, err := os.ReadFile(opts.ReportOutputPath)
+	require.NoError(t, err)
+
+	var report history.Report
+	require.NoError(t, json.Unmarshal(content, &report))
+	require.Equal(t, 1, report.Runs)
+	require.Len(t, report.Flakiest(0), 3)
+}
+
+func TestShardedRuns(t *testing.T) {
+	dir := t.TempDir()
+	shards := 2
+
+	results := make([]*types.TestResult, 0, shards)
+
+	for i := range shards {
+		opts := types.E2ETestOptions{
+			MaxRetries:        1,
+			TestPath:          "./testdata/passing",
+			Config:            &config.Config{Verbose: false},
+			NeverSkipPrefixes: []string{},
+			SkipAtPrefixes:    []string{},
+			Shards:            shards,
+			ShardIndex:        i,
+			ResultOutputPath:  filepath.Join(dir, fmt.Sprintf("result-%d.json", i)),
+		}
+
+		require.NoError(t, NewE2ETestRunner(opts).Run())
+
+		result, err := formatter.ImportResult(opts.ResultOutputPath)
+		require.NoError(t, err)
+		results = append(results, result)
+	}
+
+	// the tests weigh the same without history, they are assigned in turn to the shards
+	require.Equal(t, []string{"TestAlwaysPass1", "TestAlwaysPass3"}, resultNames(results[0]))
+	require.Equal(t, []string{"TestAlwaysPass2"}, resultNames(results[1]))
+
+	merged := formatter.MergeResults(results...)
+	require.Equal(t, []string{"TestAlwaysPass1", "TestAlwaysPass2", "TestAlwaysPass3"}, resultNames(merged))
+	require.Empty(t, merged.FailedTest)
+}
+
+func resultNames(result *types.TestResult) []string {
+	names := make([]string, 0, len(result.PassedTest))
+	for _, tc := range result.PassedTest {
+		names = append(names, tc.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestNotifiers(t *testing.T) {
+	var received notifier.Notification
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	summaryPath := filepath.Join(t.TempDir(), "summary.md")
+
+	opts := types.E2ETestOptions{
+		MaxRetries:        1,
+		TestPath:          "./testdata/failing",
+		Config:            &config.Config{Verbose: false},
+		NeverSkipPrefixes: []string{},
+		SkipAtPrefixes:    []string{},
+		QuarantinedTests:  []string{"TestAlwaysFail2"},
+		NotifierOptions: types.NotifierOptions{
+			WebhookURLs:         []string{server.URL},
+			MarkdownSummaryPath: summaryPath,
+		},
+	}
+
+	err := NewE2ETestRunner(opts).Run()
+	require.EqualError(t, err, "1 tests failed after retries")
+
+	require.Equal(t, notifier.StatusFailed, received.Status)
+	require.Equal(t, 1, received.Retries)
+	require.Equal(t, 1, received.MaxRetries)
+
+	classifications := make(map[string]notifier.Classification)
+	for _, test := range received.Tests {
+		require.Equal(t, 2, test.Attempts)
+		classifications[test.Name] = test.Classification
+	}
+	require.Equal(t, map[string]notifier.Classification{
+		"TestAlwaysFail1": notifier.ClassificationFailed,
+		"TestAlwaysFail2": notifier.ClassificationQuarantined,
+	}, classifications)
+
+	summary, err := os.ReadFile(summaryPath)
+	require.NoError(t, err)
+	require.Contains(t, string(summary), "E2E tests failed: 1 failed, 1 quarantined after 1 of 1 retries")
+}
+
+func TestNotifiersNotCalledOnSuccess(t *testing.T) {
+	summaryPath := filepath.Join(t.TempDir(), "summary.md")
+
+	opts := types.E2ETestOptions{
+		MaxRetries:        1,
+		TestPath:          "./testdata/passing",
+		Config:            &config.Config{Verbose: false},
+		NeverSkipPrefixes: []string{},
+		SkipAtPrefixes:    []string{},
+		NotifierOptions:   types.NotifierOptions{MarkdownSummaryPath: summaryPath},
+	}
+
+	require.NoError(t, NewE2ETestRunner(opts).Run())
+	require.NoFileExists(t, summaryPath)
+}
@@ -0,0 +1,217 @@
+package runner
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/test-retry/pkg/history"
+)
+
+// defaultShardUnitWeight is the weight of the test groups when no duration is recorded in the
+// history
+const defaultShardUnitWeight = time.Second
+
+// shardUnit is a group of tests assigned as a whole to a shard
+type shardUnit struct {
+	name   string
+	weight time.Duration
+}
+
+// buildShardSkipFilter creates a regex pattern skipping the test groups assigned to the other
+// shards. The test groups are the top level tests and, for the tests matching the skip-at
+// prefixes, the subtests recorded in the history. Tests which are not part of
+// any group, e.g. the never-skip ones or the subtests not recorded in the history yet, run in
+// every shard.
+func (r *E2ETestRunner) buildShardSkipFilter() (string, error) {
+	if r.opts.Shards <= 1 {
+		return "", nil
+	}
+
+	names, err := r.listTopLevelTests()
+	if err != nil {
+		return "", fmt.Errorf("failed to list tests: %w", err)
+	}
+
+	durations := make(map[string]time.Duration)
+
+	if r.opts.HistoryPath != "" {
+		h, err := history.Load(r.opts.HistoryPath)
+		if err != nil {
+			fmt.Printf("Warning: failed to load test history, shards are not balanced by duration: %v\n", err)
+		} else {
+			// the most recent duration of each test wins
+			for _, run := range h.Runs {
+				for _, t := range run.Tests {
+					names = append(names, t.Name)
+					durations[t.Name] = t.Duration
+				}
+			}
+		}
+	}
+
+	shards := partitionShardUnits(r.shardUnits(names, durations), r.opts.Shards)
+
+	var filters []string
+	for i, units := range shards {
+		if i == r.opts.ShardIndex {
+			continue
+		}
+
+		for _, u := range units {
+			filters = append(filters, buildGoTestSkipFilter(regexp.QuoteMeta(u.name))...)
+		}
+	}
+
+	if r.opts.Config.Verbose {
+		names := make([]string, 0, len(shards[r.opts.ShardIndex]))
+		for _, u := range shards[r.opts.ShardIndex] {
+			names = append(names, u.name)
+		}
+		fmt.Printf("Shard %d/%d runs %s\n", r.opts.ShardIndex+1, r.opts.Shards, strings.Join(names, ", "))
+	}
+
+	sort.Strings(filters)
+
+	return strings.Join(filters, "|"), nil
+}
+
+// listTopLevelTests lists the top level tests of the test path
+func (r *E2ETestRunner) listTopLevelTests() ([]string, error) {
+	args := []string{"test", r.opts.TestPath, "-list", "."}
+	if r.opts.TestFlags != "" {
+		args = append(args, strings.Fields(r.opts.TestFlags)...)
+	}
+
+	cmd := exec.Command("go", args...)
+	if r.opts.WorkingDir != "" {
+		cmd.Dir = r.opts.WorkingDir
+	}
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	var names []string
+
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); strings.HasPrefix(line, "Test") {
+			names = append(names, line)
+		}
+	}
+
+	return names, scanner.Err()
+}
+
+// shardUnits returns the test groups which can be assigned to a shard, weighted by their
+// duration. A group containing other groups or never-skip tests is split into those instead.
+// The subtests of a skip-at prefix ending with a wildcard, e.g. the steps of the tests of a
+// component, are not split across shards, as they may depend on each other.
+func (r *E2ETestRunner) shardUnits(names []string, durations map[string]time.Duration) []shardUnit {
+	levels := &E2ETestRunner{opts: r.opts}
+	levels.opts.SkipAtPrefixes = make([]string, 0, len(r.opts.SkipAtPrefixes))
+
+	for _, p := range r.opts.SkipAtPrefixes {
+		if trimmed := strings.TrimSuffix(strings.TrimSuffix(p, "/"), "*"); trimmed != "" {
+			levels.opts.SkipAtPrefixes = append(levels.opts.SkipAtPrefixes, normalizePrefix(trimmed))
+		}
+	}
+
+	candidates := make(map[string]bool)
+
+	for _, name := range names {
+		if level, ok := levels.extractTestLevel(name); ok {
+			candidates[level] = true
+		} else if !strings.Contains(name, "/") {
+			candidates[name] = true
+		}
+	}
+
+	nested := make([]string, 0, len(candidates)+len(r.opts.NeverSkipPrefixes))
+	for c := range candidates {
+		nested = append(nested, c)
+	}
+	for _, p := range r.opts.NeverSkipPrefixes {
+		nested = append(nested, strings.TrimSuffix(p, "/"))
+	}
+
+	var units []shardUnit
+	var total time.Duration
+	var known int
+
+	for c := range candidates {
+		split := false
+		for _, n := range nested {
+			if strings.HasPrefix(n, c+"/") {
+				split = true
+				break
+			}
+		}
+
+		if split {
+			continue
+		}
+
+		d, ok := durations[c]
+		if ok && d > 0 {
+			total += d
+			known++
+		}
+
+		units = append(units, shardUnit{name: c, weight: d})
+	}
+
+	// groups not recorded in the history yet weigh as the average group
+	weight := defaultShardUnitWeight
+	if known > 0 {
+		weight = total / time.Duration(known)
+	}
+
+	for i := range units {
+		if units[i].weight <= 0 {
+			units[i].weight = weight
+		}
+	}
+
+	return units
+}
+
+// partitionShardUnits assigns the test groups to the shards, balancing their total duration.
+// The assignment only depends on the groups, so that every shard computes the same one.
+func partitionShardUnits(units []shardUnit, shards int) [][]shardUnit {
+	sorted := append([]shardUnit(nil), units...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].weight != sorted[j].weight {
+			return sorted[i].weight > sorted[j].weight
+		}
+		return sorted[i].name < sorted[j].name
+	})
+
+	result := make([][]shardUnit, shards)
+	loads := make([]time.Duration, shards)
+
+	// the longest groups first, each one to the least loaded shard
+	for _, u := range sorted {
+		lightest := 0
+		for i := 1; i < shards; i++ {
+			if loads[i] < loads[lightest] {
+				lightest = i
+			}
+		}
+
+		result[lightest] = append(result[lightest], u)
+		loads[lightest] += u.weight
+	}
+
+	return result
+}
@@ -0,0 +1,73 @@
+package runner
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/cmd/test-retry/pkg/types"
+)
+
+func TestShardUnits(t *testing.T) {
+	runner := NewE2ETestRunner(types.E2ETestOptions{
+		NeverSkipPrefixes: []string{"TestOdhOperator/DSCInitialization_and_DataScienceCluster_management_E2E_Tests"},
+		SkipAtPrefixes:    []string{"TestOdhOperator/components/*/", "TestOdhOperator/"},
+	})
+
+	names := []string{
+		"TestOdhOperator",
+		"TestOdhOperator/DSCInitialization_and_DataScienceCluster_management_E2E_Tests",
+		"TestOdhOperator/DSCInitialization_and_DataScienceCluster_management_E2E_Tests/create",
+		"TestOdhOperator/components",
+		"TestOdhOperator/components/kserve",
+		"TestOdhOperator/components/kserve/validate",
+		"TestOdhOperator/components/ray",
+		"TestOdhOperator/services/monitoring",
+		"TestOther",
+	}
+
+	durations := map[string]time.Duration{
+		"TestOdhOperator/components/kserve": 4 * time.Minute,
+		"TestOdhOperator/services":          2 * time.Minute,
+	}
+
+	units := runner.shardUnits(names, durations)
+	sort.Slice(units, func(i, j int) bool { return units[i].name < units[j].name })
+
+	// TestOdhOperator and TestOdhOperator/components are split into their groups, the groups
+	// not recorded in the history weigh as the average group
+	require.Equal(t, []shardUnit{
+		{name: "TestOdhOperator/components/kserve", weight: 4 * time.Minute},
+		{name: "TestOdhOperator/components/ray", weight: 3 * time.Minute},
+		{name: "TestOdhOperator/services", weight: 2 * time.Minute},
+		{name: "TestOther", weight: 3 * time.Minute},
+	}, units)
+}
+
+func TestPartitionShardUnits(t *testing.T) {
+	units := []shardUnit{
+		{name: "a", weight: time.Minute},
+		{name: "b", weight: 5 * time.Minute},
+		{name: "c", weight: 3 * time.Minute},
+		{name: "d", weight: 3 * time.Minute},
+		{name: "e", weight: 2 * time.Minute},
+	}
+
+	shards := partitionShardUnits(units, 2)
+
+	require.Equal(t, [][]shardUnit{
+		{{name: "b", weight: 5 * time.Minute}, {name: "e", weight: 2 * time.Minute}},
+		{{name: "c", weight: 3 * time.Minute}, {name: "d", weight: 3 * time.Minute}, {name: "a", weight: time.Minute}},
+	}, shards)
+
+	// the assignment does not depend on the order of the groups
+	reversed := make([]shardUnit, 0, len(units))
+	for i := len(units) - 1; i >= 0; i-- {
+		reversed = append(reversed, units[i])
+	}
+	require.Equal(t, shards, partitionShardUnits(reversed, 2))
+
+	require.Len(t, partitionShardUnits(units, 8), 8)
+}
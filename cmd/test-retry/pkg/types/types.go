@@ -25,6 +25,16 @@ type PROptions struct {
 	Comment string
 }
 
+// NotifierOptions holds the configuration of the notifiers of the runs in which tests failed
+type NotifierOptions struct {
+	// URLs of generic webhooks receiving the notification as a JSON payload
+	WebhookURLs []string
+	// URLs of Slack compatible incoming webhooks
+	SlackWebhookURLs []string
+	// Path to a Markdown file the summary is appended to, e.g. $GITHUB_STEP_SUMMARY
+	MarkdownSummaryPath string
+}
+
 // E2ETestOptions holds options for e2e test execution
 type E2ETestOptions struct {
 	MaxRetries int
@@ -38,7 +48,25 @@ type E2ETestOptions struct {
 	// prefixes where tests should be extracted at prefix + 1 level
 	SkipAtPrefixes  []string
 	PROptions       PROptions
+	NotifierOptions NotifierOptions
 	JUnitOutputPath string // Path to JUnit XML output file (optional)
+	// Path to the JSON file persisting the test outcomes across runs (optional)
+	HistoryPath string
+	// Maximum number of runs kept in the history file, history.DefaultMaxRuns when zero and
+	// unbounded when negative
+	HistoryMaxRuns int
+	// Tests whose failures do not fail the run, along with their subtests
+	QuarantinedTests []string
+	// Path to the flaky tests report, in JSON or Markdown depending on the extension (optional)
+	ReportOutputPath string
+	// Number of tests listed in each section of the Markdown report
+	ReportTop int
+	// Number of shards the tests are partitioned into, 0 or 1 to run all the tests
+	Shards int
+	// Index of the shard to run, from 0 to Shards - 1
+	ShardIndex int
+	// Path to the JSON output file of the results, to be merged with the ones of the other shards (optional)
+	ResultOutputPath string
 }
 
 // TestCase represents a single test case (passed or failed)
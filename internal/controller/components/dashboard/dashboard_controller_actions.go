@@ -21,9 +21,12 @@ import (
 
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
 	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/gateway"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	odherrors "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/errors"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
 	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 	odhdeploy "github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
@@ -54,13 +57,52 @@ type DashboardHardwareProfileList struct {
 }
 
 func initialize(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
-	rr.Manifests = []odhtypes.ManifestInfo{defaultManifestInfo(rr.Release.Name)}
+	rr.Manifests = odhtypes.PlatformOverlays(defaultManifestInfo, cluster.Platforms...)
+
+	return nil
+}
+
+// validateGateway checks that the gateway selected in the Dashboard spec is declared in the
+// GatewayConfig, the dashboard routes are not deployed otherwise.
+func validateGateway(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	d, ok := rr.Instance.(*componentApi.Dashboard)
+	if !ok {
+		return errors.New("instance is not of type *odhTypes.Dashboard")
+	}
+
+	rr.Conditions.MarkTrue(status.ConditionGatewayAvailable)
+
+	err := gateway.ValidateGatewayName(ctx, rr.Client, d.Spec.Gateway)
+	switch {
+	case errors.Is(err, gateway.ErrUnknownGateway):
+		rr.Conditions.MarkFalse(
+			status.ConditionGatewayAvailable,
+			conditions.WithObservedGeneration(rr.Instance.GetGeneration()),
+			conditions.WithReason(status.UnknownGatewayReason),
+			conditions.WithMessage("Gateway %q is not declared in the GatewayConfig additionalGateways", d.Spec.Gateway),
+		)
+
+		return odherrors.NewStopErrorW(err)
+	case err != nil:
+		rr.Conditions.MarkFalse(
+			status.ConditionGatewayAvailable,
+			conditions.WithObservedGeneration(rr.Instance.GetGeneration()),
+			conditions.WithError(err),
+		)
+
+		return err
+	}
 
 	return nil
 }
 
 func setKustomizedParams(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
-	extraParamsMap, err := computeKustomizeVariable(ctx, rr.Client, rr.Release.Name)
+	d, ok := rr.Instance.(*componentApi.Dashboard)
+	if !ok {
+		return errors.New("instance is not of type *odhTypes.Dashboard")
+	}
+
+	extraParamsMap, err := computeKustomizeVariable(ctx, rr.Client, rr.Release.Name, d.Spec.Gateway)
 	if err != nil {
 		return fmt.Errorf("failed to set variable for url, section-title etc: %w", err)
 	}
@@ -101,6 +143,16 @@ func configureDependencies(ctx context.Context, rr *odhtypes.ReconciliationReque
 	return nil
 }
 
+// attachToGateway exposes the dashboard through the gateway selected in the Dashboard spec.
+func attachToGateway(_ context.Context, rr *odhtypes.ReconciliationRequest) error {
+	d, ok := rr.Instance.(*componentApi.Dashboard)
+	if !ok {
+		return errors.New("instance is not of type *odhTypes.Dashboard")
+	}
+
+	return gateway.AttachHTTPRoutes(rr.Resources, d.Spec.Gateway)
+}
+
 func updateStatus(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
 	d, ok := rr.Instance.(*componentApi.Dashboard)
 	if !ok {
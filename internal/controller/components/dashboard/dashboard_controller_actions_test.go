@@ -2,6 +2,7 @@
 package dashboard
 
 import (
+	"encoding/json"
 	"testing"
 
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -11,10 +12,17 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
 	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/gateway"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	odherrors "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/errors"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/scheme"
 
 	. "github.com/onsi/gomega"
@@ -147,3 +155,40 @@ func TestCreateInfraHardwareProfile(t *testing.T) {
 	g.Expect(receivedHardwareProfile.GetAnnotations()["opendatahub.io/description"]).Should(Equal("Test Description"))
 	g.Expect(receivedHardwareProfile.GetAnnotations()["opendatahub.io/disabled"]).Should(Equal("false"))
 }
+
+func TestValidateGateway(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	gatewayConfig := &serviceApi.GatewayConfig{
+		ObjectMeta: v1.ObjectMeta{Name: serviceApi.GatewayConfigName},
+		Spec: serviceApi.GatewayConfigSpec{
+			AdditionalGateways: []serviceApi.AdditionalGatewaySpec{{Name: "internal"}},
+		},
+	}
+
+	cli, err := fakeclient.New(fakeclient.WithObjects(gatewayConfig))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	for _, name := range []string{"", "internal"} {
+		dashboard := &componentApi.Dashboard{ObjectMeta: v1.ObjectMeta{Name: componentApi.DashboardInstanceName}}
+		dashboard.Spec.Gateway = name
+
+		rr := &types.ReconciliationRequest{Client: cli, Instance: dashboard, Conditions: conditions.NewManager(dashboard, status.ConditionGatewayAvailable)}
+		g.Expect(validateGateway(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Conditions.GetCondition(status.ConditionGatewayAvailable).Status).Should(Equal(v1.ConditionTrue))
+	}
+
+	dashboard := &componentApi.Dashboard{ObjectMeta: v1.ObjectMeta{Name: componentApi.DashboardInstanceName}}
+	dashboard.Spec.Gateway = "unknown"
+
+	rr := &types.ReconciliationRequest{Client: cli, Instance: dashboard, Conditions: conditions.NewManager(dashboard, status.ConditionGatewayAvailable)}
+	err = validateGateway(ctx, rr)
+	g.Expect(err).Should(MatchError(ContainSubstring(gateway.ErrUnknownGateway.Error())))
+	g.Expect(err).Should(BeAssignableToTypeOf(odherrors.StopError{}))
+
+	g.Expect(dashboard).Should(WithTransform(json.Marshal, And(
+		jq.Match(`.status.conditions[] | select(.type == "%s") | .status == "%s"`, status.ConditionGatewayAvailable, v1.ConditionFalse),
+		jq.Match(`.status.conditions[] | select(.type == "%s") | .reason == "%s"`, status.ConditionGatewayAvailable, status.UnknownGatewayReason),
+	)))
+}
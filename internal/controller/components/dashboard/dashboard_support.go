@@ -51,6 +51,7 @@ var (
 
 	conditionTypes = []string{
 		status.ConditionDeploymentsAvailable,
+		status.ConditionGatewayAvailable,
 	}
 )
 
@@ -70,9 +71,9 @@ func bffManifestsPath() odhtypes.ManifestInfo {
 	}
 }
 
-func computeKustomizeVariable(ctx context.Context, cli client.Client, platform common.Platform) (map[string]string, error) {
+func computeKustomizeVariable(ctx context.Context, cli client.Client, platform common.Platform, gatewayName string) (map[string]string, error) {
 	// Get the gateway domain directly from Gateway CR
-	consoleLinkDomain, err := gateway.GetGatewayDomain(ctx, cli)
+	consoleLinkDomain, err := gateway.GetNamedGatewayDomain(ctx, cli, gatewayName)
 	if err != nil {
 		return nil, fmt.Errorf("error getting gateway domain: %w", err)
 	}
@@ -288,7 +288,7 @@ func TestComputeKustomizeVariable(t *testing.T) {
 			cli, err := fakeclient.New(fakeclient.WithObjects(objects...))
 			g.Expect(err).ShouldNot(HaveOccurred())
 
-			result, err := computeKustomizeVariable(ctx, cli, tt.platform)
+			result, err := computeKustomizeVariable(ctx, cli, tt.platform, "")
 
 			if tt.expectError {
 				g.Expect(err).Should(HaveOccurred())
@@ -312,7 +312,7 @@ func TestComputeKustomizeVariableError(t *testing.T) {
 	g.Expect(err).ShouldNot(HaveOccurred())
 
 	// Test error handling with better error message validation
-	_, err = computeKustomizeVariable(ctx, cli, cluster.OpenDataHub)
+	_, err = computeKustomizeVariable(ctx, cli, cluster.OpenDataHub, "")
 	g.Expect(err).Should(HaveOccurred(), "Should fail when cluster domain cannot be determined")
 	g.Expect(err.Error()).Should(ContainSubstring("error getting gateway domain"), "Error should contain expected message")
 }
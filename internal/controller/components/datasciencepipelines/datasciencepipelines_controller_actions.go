@@ -101,7 +101,7 @@ func checkPreConditions(ctx context.Context, rr *odhtypes.ReconciliationRequest)
 }
 
 func initialize(_ context.Context, rr *odhtypes.ReconciliationRequest) error {
-	rr.Manifests = append(rr.Manifests, manifestPath(rr.Release.Name))
+	rr.Manifests = append(rr.Manifests, odhtypes.PlatformOverlays(manifestPath, cluster.Platforms...)...)
 
 	return nil
 }
@@ -3,10 +3,14 @@ package feastoperator
 import (
 	"context"
 
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 )
 
 func initialize(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
-	rr.Manifests = append(rr.Manifests, manifestPath(rr.Release.Name))
+	for _, mi := range odhtypes.PlatformOverlays(manifestPath, cluster.Platforms...) {
+		rr.Sources = append(rr.Sources, odhtypes.KustomizeSource{ManifestInfo: mi})
+	}
+
 	return nil
 }
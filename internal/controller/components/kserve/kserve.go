@@ -44,6 +44,7 @@ var (
 	conditionTypes = []string{
 		status.ConditionDeploymentsAvailable,
 		status.ConditionDependenciesAvailable,
+		status.ConditionGatewayAvailable,
 	}
 )
 
@@ -34,11 +34,13 @@ import (
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/dependency"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploy"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/gc"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/overlays"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/render/kustomize"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/status/deployments"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/status/releases"
@@ -120,10 +122,18 @@ func (s *componentHandler) NewComponentReconciler(ctx context.Context, mgr ctrl.
 				dependent.New(dependent.WithWatchStatus(true)),
 			),
 			reconciler.Dynamic(reconciler.CrdExists(gvk.LeaderWorkerSetOperatorV1))).
+		// The gateway selected in the spec must be declared in the GatewayConfig
+		Watches(
+			&serviceApi.GatewayConfig{},
+			reconciler.WithEventHandler(handlers.ToNamed(componentApi.KserveInstanceName)),
+			reconciler.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
 
 		// actions
 		WithAction(checkPreConditions).
 		WithAction(initialize).
+		WithAction(overlays.NewAction()).
+		WithAction(validateGateway).
 		WithAction(dependency.NewAction(
 			dependency.MonitorOperator(dependency.OperatorConfig{
 				OperatorGVK: gvk.LeaderWorkerSetOperatorV1,
@@ -154,6 +164,7 @@ func (s *componentHandler) NewComponentReconciler(ctx context.Context, mgr ctrl.
 			deploy.WithCache(),
 		)).
 		WithAction(deployments.NewAction()).
+		WithAction(releases.NewImagesAction()).
 		// must be the final action
 		WithAction(gc.NewAction(gc.WithUnremovables(gvk.LLMInferenceServiceConfigV1Alpha1))).
 		// declares the list of additional, controller specific conditions that are
@@ -2,6 +2,7 @@ package kserve
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -16,6 +17,8 @@ import (
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/gateway"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	odherrors "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/errors"
@@ -43,6 +46,41 @@ func initialize(_ context.Context, rr *odhtypes.ReconciliationRequest) error {
 	return nil
 }
 
+// validateGateway checks that the gateway selected in the Kserve spec is declared in the
+// GatewayConfig, the InferenceServices would otherwise be exposed through a gateway that does
+// not exist.
+func validateGateway(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	k, ok := rr.Instance.(*componentApi.Kserve)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a componentApi.Kserve", rr.Instance)
+	}
+
+	rr.Conditions.MarkTrue(status.ConditionGatewayAvailable)
+
+	err := gateway.ValidateGatewayName(ctx, rr.Client, k.Spec.Gateway)
+	switch {
+	case errors.Is(err, gateway.ErrUnknownGateway):
+		rr.Conditions.MarkFalse(
+			status.ConditionGatewayAvailable,
+			conditions.WithObservedGeneration(rr.Instance.GetGeneration()),
+			conditions.WithReason(status.UnknownGatewayReason),
+			conditions.WithMessage("Gateway %q is not declared in the GatewayConfig additionalGateways", k.Spec.Gateway),
+		)
+
+		return odherrors.NewStopErrorW(err)
+	case err != nil:
+		rr.Conditions.MarkFalse(
+			status.ConditionGatewayAvailable,
+			conditions.WithObservedGeneration(rr.Instance.GetGeneration()),
+			conditions.WithError(err),
+		)
+
+		return err
+	}
+
+	return nil
+}
+
 func removeOwnershipFromUnmanagedResources(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
 	for _, res := range rr.Resources {
 		if shouldRemoveOwnerRefAndLabel(res) {
@@ -104,7 +142,12 @@ func customizeKserveConfigMap(ctx context.Context, rr *odhtypes.ReconciliationRe
 		serviceClusterIPNone = false
 	}
 
-	if err := updateInferenceCM(&kserveConfigMap, serviceClusterIPNone); err != nil {
+	ingressGateway := ""
+	if k.Spec.Gateway != "" {
+		ingressGateway = gateway.GatewayNamespace + "/" + gateway.GatewayResourceName(k.Spec.Gateway)
+	}
+
+	if err := updateInferenceCM(&kserveConfigMap, serviceClusterIPNone, ingressGateway); err != nil {
 		return err
 	}
 
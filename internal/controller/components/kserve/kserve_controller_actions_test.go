@@ -14,14 +14,56 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/gateway"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	odherrors "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/errors"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
 	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
 
 	. "github.com/onsi/gomega"
 )
 
+func TestValidateGateway(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	gatewayConfig := &serviceApi.GatewayConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: serviceApi.GatewayConfigName},
+		Spec: serviceApi.GatewayConfigSpec{
+			AdditionalGateways: []serviceApi.AdditionalGatewaySpec{{Name: "internal"}},
+		},
+	}
+
+	cli, err := fakeclient.New(fakeclient.WithObjects(gatewayConfig))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	for _, name := range []string{"", "internal"} {
+		kserve := &componentApi.Kserve{ObjectMeta: metav1.ObjectMeta{Name: componentApi.KserveInstanceName}}
+		kserve.Spec.Gateway = name
+
+		rr := &odhtypes.ReconciliationRequest{Client: cli, Instance: kserve, Conditions: conditions.NewManager(kserve, status.ConditionGatewayAvailable)}
+		g.Expect(validateGateway(ctx, rr)).Should(Succeed())
+		g.Expect(rr.Conditions.GetCondition(status.ConditionGatewayAvailable).Status).Should(Equal(metav1.ConditionTrue))
+	}
+
+	kserve := &componentApi.Kserve{ObjectMeta: metav1.ObjectMeta{Name: componentApi.KserveInstanceName}}
+	kserve.Spec.Gateway = "unknown"
+
+	rr := &odhtypes.ReconciliationRequest{Client: cli, Instance: kserve, Conditions: conditions.NewManager(kserve, status.ConditionGatewayAvailable)}
+	err = validateGateway(ctx, rr)
+	g.Expect(err).Should(MatchError(ContainSubstring(gateway.ErrUnknownGateway.Error())))
+	g.Expect(err).Should(BeAssignableToTypeOf(odherrors.StopError{}))
+
+	g.Expect(kserve).Should(WithTransform(json.Marshal, And(
+		jq.Match(`.status.conditions[] | select(.type == "%s") | .status == "%s"`, status.ConditionGatewayAvailable, metav1.ConditionFalse),
+		jq.Match(`.status.conditions[] | select(.type == "%s") | .reason == "%s"`, status.ConditionGatewayAvailable, status.UnknownGatewayReason),
+	)))
+}
+
 func TestCustomizeKserveConfigMap(t *testing.T) {
 	g := NewWithT(t)
 	ctx := t.Context()
@@ -112,6 +154,41 @@ func TestCustomizeKserveConfigMap(t *testing.T) {
 		g.Expect(serviceData["serviceClusterIPNone"]).Should(BeFalse())
 	})
 
+	t.Run("Test KServe config: ingress gateway selected in spec", func(t *testing.T) {
+		kserve := &componentApi.Kserve{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: componentApi.KserveInstanceName,
+			},
+			Spec: componentApi.KserveSpec{
+				KserveCommonSpec: componentApi.KserveCommonSpec{
+					Gateway: "internal",
+				},
+			},
+		}
+
+		resources := []unstructured.Unstructured{
+			*convertToUnstructured(t, createTestConfigMap()),
+			*convertToUnstructured(t, createTestDeployment()),
+		}
+
+		rr := &odhtypes.ReconciliationRequest{
+			Instance:  kserve,
+			Resources: resources,
+		}
+
+		err := customizeKserveConfigMap(ctx, rr)
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		updatedConfigMap := &corev1.ConfigMap{}
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(rr.Resources[0].Object, updatedConfigMap)
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		var ingressData map[string]interface{}
+		err = json.Unmarshal([]byte(updatedConfigMap.Data[IngressConfigKeyName]), &ingressData)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(ingressData["kserveIngressGateway"]).Should(Equal("openshift-ingress/data-science-gateway-internal"))
+	})
+
 	t.Run("Test adding ConfigMap hash annotation to deployment", func(t *testing.T) {
 		kserve := &componentApi.Kserve{
 			ObjectMeta: metav1.ObjectMeta{
@@ -52,7 +52,7 @@ func kserveManifestInfo(sourcePath string) odhtypes.ManifestInfo {
 	}
 }
 
-func updateInferenceCM(inferenceServiceConfigMap *corev1.ConfigMap, isHeadless bool) error {
+func updateInferenceCM(inferenceServiceConfigMap *corev1.ConfigMap, isHeadless bool, ingressGateway string) error {
 	// ingress
 	// RawDeployment mode is the only supported mode, so always disable ingress creation
 	var ingressData map[string]interface{}
@@ -60,6 +60,10 @@ func updateInferenceCM(inferenceServiceConfigMap *corev1.ConfigMap, isHeadless b
 		return fmt.Errorf("error retrieving value for key '%s' from configmap %s. %w", IngressConfigKeyName, kserveConfigMapName, err)
 	}
 	ingressData["disableIngressCreation"] = true
+	// the gateway shipped with the manifests is kept unless one is selected in the spec
+	if ingressGateway != "" {
+		ingressData["kserveIngressGateway"] = ingressGateway
+	}
 	ingressDataBytes, err := json.MarshalIndent(ingressData, "", " ")
 	if err != nil {
 		return fmt.Errorf("could not set values in configmap %s. %w", kserveConfigMapName, err)
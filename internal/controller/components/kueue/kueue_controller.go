@@ -156,6 +156,7 @@ func (s *componentHandler) NewComponentReconciler(ctx context.Context, mgr ctrl.
 			deploy.WithCache(),
 		)).
 		WithAction(deployments.NewAction()).
+		WithAction(releases.NewImagesAction()).
 		WithAction(func(ctx context.Context, rr *types.ReconciliationRequest) error {
 			kueueCRInstance, ok := rr.Instance.(*componentApi.Kueue)
 			if !ok {
@@ -3,10 +3,11 @@ package llamastackoperator
 import (
 	"context"
 
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 )
 
 func initialize(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
-	rr.Manifests = append(rr.Manifests, manifestPath(rr.Release.Name))
+	rr.Manifests = append(rr.Manifests, odhtypes.PlatformOverlays(manifestPath, cluster.Platforms...)...)
 	return nil
 }
@@ -31,6 +31,7 @@ type ComponentHandler interface {
 // Registry is a struct that maintains a list of registered ComponentHandlers.
 type Registry struct {
 	handlers []ComponentHandler
+	allowed  func(name string) bool
 }
 
 var r = &Registry{}
@@ -41,12 +42,36 @@ func (r *Registry) Add(ch ComponentHandler) {
 	r.handlers = append(r.handlers, ch)
 }
 
-// ForEach iterates over all registered ComponentHandlers and applies the given function.
+// SetAllowed restricts the handlers ForEach iterates over to the ones whose name is accepted
+// by allowed, nil allows all the handlers.
+// not thread safe, supposed to be called during init.
+func (r *Registry) SetAllowed(allowed func(name string) bool) {
+	r.allowed = allowed
+}
+
+// Names returns the names of all the registered ComponentHandlers, including the ones not allowed.
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.handlers))
+	for _, ch := range r.handlers {
+		names = append(names, ch.GetName())
+	}
+
+	return names
+}
+
+func (r *Registry) isAllowed(ch ComponentHandler) bool {
+	return r.allowed == nil || r.allowed(ch.GetName())
+}
+
+// ForEach iterates over all allowed registered ComponentHandlers and applies the given function.
 // If any handler returns an error, that error is collected and returned at the end.
 // With go1.23 probably https://go.dev/blog/range-functions can be used.
 func (r *Registry) ForEach(f func(ch ComponentHandler) error) error {
 	var errs *multierror.Error
 	for _, ch := range r.handlers {
+		if !r.isAllowed(ch) {
+			continue
+		}
 		errs = multierror.Append(errs, f(ch))
 	}
 
@@ -54,10 +79,10 @@ func (r *Registry) ForEach(f func(ch ComponentHandler) error) error {
 }
 
 // IsComponentEnabled checks if a component with the given name is enabled in the DataScienceCluster.
-// Returns false if the component is not found.
+// Returns false if the component is not found or not allowed.
 func (r *Registry) IsComponentEnabled(componentName string, dsc *dscv2.DataScienceCluster) bool {
 	for _, ch := range r.handlers {
-		if ch.GetName() == componentName {
+		if ch.GetName() == componentName && r.isAllowed(ch) {
 			return ch.IsEnabled(dsc)
 		}
 	}
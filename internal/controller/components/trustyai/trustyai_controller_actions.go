@@ -46,7 +46,7 @@ func checkPreConditions(ctx context.Context, rr *odhtypes.ReconciliationRequest)
 }
 
 func initialize(_ context.Context, rr *odhtypes.ReconciliationRequest) error {
-	rr.Manifests = append(rr.Manifests, manifestsPath(rr.Release.Name))
+	rr.Manifests = append(rr.Manifests, odhtypes.PlatformOverlays(manifestsPath, cluster.Platforms...)...)
 	return nil
 }
 
@@ -16,12 +16,21 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components"
 	cr "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/registry"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 	odhdeploy "github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 )
 
+// defaultWorkbenchNamespaces is the namespace of the workbenches of each platform, used when the
+// Workbenches spec does not set one.
+var defaultWorkbenchNamespaces = map[common.Platform]string{
+	cluster.OpenDataHub:      cluster.DefaultNotebooksNamespaceODH,
+	cluster.SelfManagedRhoai: cluster.DefaultNotebooksNamespaceRHOAI,
+	cluster.ManagedRhoai:     cluster.DefaultNotebooksNamespaceRHOAI,
+}
+
 type componentHandler struct{}
 
 func init() { //nolint:gochecknoinits
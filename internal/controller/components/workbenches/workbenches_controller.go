@@ -19,6 +19,7 @@ package workbenches
 import (
 	"context"
 	"path"
+	"strings"
 
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	appsv1 "k8s.io/api/apps/v1"
@@ -26,6 +27,7 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploy"
@@ -34,10 +36,12 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/status/deployments"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/status/releases"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/handlers"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/predicates"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/predicates/component"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/predicates/resources"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/reconciler"
 	odhdeploy "github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 )
 
@@ -61,7 +65,27 @@ func (s *componentHandler) NewComponentReconciler(ctx context.Context, mgr ctrl.
 			reconciler.WithPredicates(
 				component.ForLabel(labels.ODH.Component(LegacyComponentName), labels.True)),
 		).
-		Watches(&corev1.Namespace{}).
+		// the namespace of the workbenches, and the idle timeout overrides of the namespaces the
+		// culling configuration depends on
+		Watches(
+			&corev1.Namespace{},
+			reconciler.WithEventHandler(
+				handlers.ToNamed(componentApi.WorkbenchesInstanceName)),
+			reconciler.WithPredicates(predicate.Or(
+				predicate.And(
+					predicates.DefaultPredicate,
+					component.ForLabel(labels.PlatformPartOf, strings.ToLower(componentApi.WorkbenchesKind))),
+				resources.AnnotationChanged(annotations.NotebookCullingIdleTimeout))),
+		).
+		// the culling keys of the culler configuration, not owned by the component, are applied
+		// again when overwritten
+		Watches(
+			&corev1.ConfigMap{},
+			reconciler.WithEventHandler(
+				handlers.ToNamed(componentApi.WorkbenchesInstanceName)),
+			reconciler.WithPredicates(
+				resources.CreatedOrUpdatedOrDeletedNamed(cullerConfigMapName)),
+		).
 		WithAction(initialize).
 		WithAction(releases.NewAction(
 			releases.WithMetadataFilePath(
@@ -71,10 +95,12 @@ func (s *componentHandler) NewComponentReconciler(ctx context.Context, mgr ctrl.
 			kustomize.WithLabel(labels.ODH.Component(LegacyComponentName), labels.True),
 			kustomize.WithLabel(labels.K8SCommon.PartOf, LegacyComponentName),
 		)).
+		WithAction(configureCulling).
 		WithAction(deploy.NewAction(
 			deploy.WithCache(),
 		)).
 		WithAction(deployments.NewAction()).
+		WithAction(releases.NewImagesAction()).
 		WithAction(updateStatus).
 		// must be the final action
 		WithAction(gc.NewAction()).
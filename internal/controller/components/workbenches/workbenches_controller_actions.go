@@ -2,14 +2,26 @@ package workbenches
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
+	"slices"
+	"strconv"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 )
 
 func initialize(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
@@ -33,15 +45,9 @@ func configureDependencies(ctx context.Context, rr *odhtypes.ReconciliationReque
 		labels.ODH.OwnedNamespace: "true",
 	}
 
-	if workbench.Spec.WorkbenchNamespace != "" || len(workbench.Spec.WorkbenchNamespace) > 0 {
-		wbNS.Name = workbench.Spec.WorkbenchNamespace
-	} else {
-		switch rr.Release.Name {
-		case cluster.SelfManagedRhoai, cluster.ManagedRhoai:
-			wbNS.Name = cluster.DefaultNotebooksNamespaceRHOAI
-		case cluster.OpenDataHub:
-			wbNS.Name = cluster.DefaultNotebooksNamespaceODH
-		}
+	wbNS.Name = workbench.Spec.WorkbenchNamespace
+	if wbNS.Name == "" {
+		wbNS.Name = defaultWorkbenchNamespaces[rr.Release.Name]
 	}
 
 	err := rr.AddResources(wbNS)
@@ -51,6 +57,137 @@ func configureDependencies(ctx context.Context, rr *odhtypes.ReconciliationReque
 	return nil
 }
 
+// configureCulling renders the culling policy into the configuration of the culler of the kf
+// notebook controller. Since the culler supports a single idle timeout, it is given the shortest
+// one among the policy and the namespace overrides, the notebook webhook preventing the stop of
+// the workbenches that are exempted or whose namespace has a longer one.
+//
+// The culler ConfigMap belongs to the ODH Dashboard, which writes it from its own settings. It
+// is therefore not deployed as a resource of the component, which would own it and garbage
+// collect it once the policy is removed: the culling keys are server-side applied with the
+// platform field manager while a policy is set, and released once it is removed so that the
+// keys written by the platform are dropped and the culling stops.
+func configureCulling(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	workbench, ok := rr.Instance.(*componentApi.Workbenches)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a componentApi.Workbenches", rr.Instance)
+	}
+
+	appNamespace, err := cluster.ApplicationNamespace(ctx, rr.Client)
+	if err != nil {
+		return err
+	}
+
+	policy := workbench.Spec.Culling
+	if policy == nil {
+		return releaseCullingConfig(ctx, rr, appNamespace)
+	}
+
+	namespaces := corev1.NamespaceList{}
+	if err := rr.Client.List(ctx, &namespaces); err != nil {
+		return fmt.Errorf("failed to list namespaces: %w", err)
+	}
+
+	idleTimeout := policy.IdleTimeout.Duration
+
+	for _, ns := range namespaces.Items {
+		value := resources.GetAnnotation(&ns, annotations.NotebookCullingIdleTimeout)
+		if value == "" {
+			continue
+		}
+
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			logf.FromContext(ctx).Info("ignoring invalid annotation", "namespace", ns.Name, "annotation", annotations.NotebookCullingIdleTimeout, "error", err.Error())
+			continue
+		}
+
+		if d > 0 && d < idleTimeout {
+			idleTimeout = d
+		}
+	}
+
+	cm := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cullerConfigMapName,
+			Namespace: appNamespace,
+		},
+		Data: map[string]string{
+			"ENABLE_CULLING":        "true",
+			"CULL_IDLE_TIME":        strconv.Itoa(minutes(idleTimeout)),
+			"IDLENESS_CHECK_PERIOD": strconv.Itoa(minutes(policy.CheckInterval.Duration)),
+		},
+	}
+
+	u, err := resources.ToUnstructured(&cm)
+	if err != nil {
+		return err
+	}
+
+	hash, err := resources.Hash(u)
+	if err != nil {
+		return err
+	}
+
+	if err := cluster.CreateOrUpdateConfigMap(ctx, rr.Client, &cm); err != nil {
+		return fmt.Errorf("failed to apply culler configuration: %w", err)
+	}
+
+	// the culler configuration is read at startup
+	return rr.ForEachResource(func(res *unstructured.Unstructured) (bool, error) {
+		if res.GroupVersionKind() != gvk.Deployment || res.GetName() != kfNotebookControllerDeploymentName {
+			return false, nil
+		}
+
+		err := unstructured.SetNestedField(res.Object, hex.EncodeToString(hash), "spec", "template", "metadata", "annotations", cullerConfigHashAnnotation)
+		if err != nil {
+			return false, fmt.Errorf("failed to set annotation %s: %w", cullerConfigHashAnnotation, err)
+		}
+
+		return true, nil
+	})
+}
+
+// releaseCullingConfig drops the culling keys applied to the culler ConfigMap by the platform,
+// with an apply of the ConfigMap without data. The ConfigMap is left untouched when the
+// platform did not apply it.
+func releaseCullingConfig(ctx context.Context, rr *odhtypes.ReconciliationRequest, namespace string) error {
+	cm := corev1.ConfigMap{}
+
+	err := rr.Client.Get(ctx, client.ObjectKey{Name: cullerConfigMapName, Namespace: namespace}, &cm)
+	switch {
+	case k8serr.IsNotFound(err):
+		return nil
+	case err != nil:
+		return fmt.Errorf("failed to get culler configuration: %w", err)
+	}
+
+	applied := slices.ContainsFunc(cm.ManagedFields, func(mf metav1.ManagedFieldsEntry) bool {
+		return mf.Manager == resources.PlatformFieldOwner && mf.Operation == metav1.ManagedFieldsOperationApply
+	})
+	if !applied {
+		return nil
+	}
+
+	empty := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cullerConfigMapName,
+			Namespace: namespace,
+		},
+	}
+
+	if err := cluster.CreateOrUpdateConfigMap(ctx, rr.Client, &empty); err != nil {
+		return fmt.Errorf("failed to release culler configuration: %w", err)
+	}
+
+	return nil
+}
+
+// minutes returns a duration in whole minutes, rounded up, as expected by the culler.
+func minutes(d time.Duration) int {
+	return max(1, int((d+time.Minute-1)/time.Minute))
+}
+
 func updateStatus(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
 	workbench, ok := rr.Instance.(*componentApi.Workbenches)
 	if !ok {
@@ -23,6 +23,14 @@ const (
 
 	kfNotebookControllerPath               = "kf-notebook-controller"
 	kfNotebookControllerManifestSourcePath = "overlays/openshift"
+	kfNotebookControllerDeploymentName     = "notebook-controller-deployment"
+
+	// cullerConfigMapName is the ConfigMap the kf notebook controller reads its culling
+	// configuration from, as environment variables.
+	cullerConfigMapName = "notebook-controller-culler-config"
+	// cullerConfigHashAnnotation records on the kf notebook controller pods the hash of the
+	// culling configuration, so that they are restarted when it changes.
+	cullerConfigHashAnnotation = "opendatahub.io/notebook-culler-config-hash"
 
 	// LegacyComponentName is the name of the component that is assigned to deployments
 	// via Kustomize. Since a deployment selector is immutable, we can't upgrade existing
@@ -2,21 +2,33 @@
 package workbenches
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"testing"
+	"time"
 
 	gt "github.com/onsi/gomega/types"
 	operatorv1 "github.com/openshift/api/operator/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
 	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v2"
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
 
@@ -232,3 +244,170 @@ func createWorkbenchesCR(ready bool) *componentApi.Workbenches {
 
 	return &c
 }
+
+func TestConfigureDependencies(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	tests := []struct {
+		platform  common.Platform
+		namespace string
+		expected  string
+	}{
+		{platform: cluster.OpenDataHub, expected: cluster.DefaultNotebooksNamespaceODH},
+		{platform: cluster.SelfManagedRhoai, expected: cluster.DefaultNotebooksNamespaceRHOAI},
+		{platform: cluster.ManagedRhoai, expected: cluster.DefaultNotebooksNamespaceRHOAI},
+		{platform: cluster.OpenDataHub, namespace: "workbenches", expected: "workbenches"},
+	}
+
+	cli, err := fakeclient.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	for _, tt := range tests {
+		wb := createWorkbenchesCR(true)
+		wb.Spec.WorkbenchNamespace = tt.namespace
+
+		rr := types.ReconciliationRequest{Client: cli, Instance: wb, Release: common.Release{Name: tt.platform}}
+
+		g.Expect(configureDependencies(ctx, &rr)).Should(Succeed())
+		g.Expect(rr.Resources).Should(HaveLen(1))
+		g.Expect(rr.Resources[0].GetName()).Should(Equal(tt.expected), "platform %s", tt.platform)
+		g.Expect(rr.Resources[0].GetLabels()).Should(HaveKeyWithValue(labels.ODH.OwnedNamespace, "true"))
+	}
+}
+
+func TestConfigureCulling(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	dsci := &dsciv2.DSCInitialization{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-dsci"},
+		Spec:       dsciv2.DSCInitializationSpec{ApplicationsNamespace: "opendatahub"},
+	}
+
+	overridden := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:        "overridden",
+		Annotations: map[string]string{annotations.NotebookCullingIdleTimeout: "90s"},
+	}}
+	disabled := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:        "disabled",
+		Annotations: map[string]string{annotations.NotebookCullingIdleTimeout: "0"},
+	}}
+	invalid := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:        "invalid",
+		Annotations: map[string]string{annotations.NotebookCullingIdleTimeout: "soon"},
+	}}
+
+	// the culler configuration written by the dashboard
+	dashboardConfig := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: cullerConfigMapName, Namespace: "opendatahub"},
+		Data:       map[string]string{"ENABLE_CULLING": "false", "CULL_IDLE_TIME": "60"},
+	}
+
+	// the keys applied by the platform field manager
+	owned := map[string]struct{}{}
+
+	cli, err := fakeclient.New(
+		fakeclient.WithObjects(dsci, overridden, disabled, invalid, dashboardConfig),
+		// apply patches are not supported by the fake client, the server-side apply of the
+		// culler configuration is simulated: the applied keys are set and the keys previously
+		// applied but no longer part of the configuration are dropped
+		fakeclient.WithInterceptorFuncs(interceptor.Funcs{
+			Patch: func(ctx context.Context, cl client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+				if patch.Type() != client.Apply.Type() {
+					return cl.Patch(ctx, obj, patch, opts...)
+				}
+
+				u, ok := obj.(*unstructured.Unstructured)
+				if !ok {
+					return fmt.Errorf("unexpected applied object %T", obj)
+				}
+
+				data, _, err := unstructured.NestedStringMap(u.Object, "data")
+				if err != nil {
+					return err
+				}
+
+				current := corev1.ConfigMap{}
+				if err := cl.Get(ctx, client.ObjectKeyFromObject(obj), &current); err != nil {
+					return err
+				}
+
+				for k := range owned {
+					if _, ok := data[k]; !ok {
+						delete(current.Data, k)
+						delete(owned, k)
+					}
+				}
+
+				for k, v := range data {
+					current.Data[k] = v
+					owned[k] = struct{}{}
+				}
+
+				current.ManagedFields = nil
+				if len(owned) != 0 {
+					current.ManagedFields = []metav1.ManagedFieldsEntry{{
+						Manager:   resources.PlatformFieldOwner,
+						Operation: metav1.ManagedFieldsOperationApply,
+					}}
+				}
+
+				return cl.Update(ctx, &current)
+			},
+		}),
+	)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	controller := &appsv1.Deployment{}
+	controller.SetGroupVersionKind(gvk.Deployment)
+	controller.SetName(kfNotebookControllerDeploymentName)
+
+	wb := createWorkbenchesCR(true)
+	rr := types.ReconciliationRequest{Client: cli, Instance: wb}
+	g.Expect(rr.AddResources(controller)).Should(Succeed())
+
+	cm := &corev1.ConfigMap{}
+
+	// no policy, the culler configuration of the dashboard is left untouched
+	g.Expect(configureCulling(ctx, &rr)).Should(Succeed())
+	g.Expect(rr.Resources).Should(HaveLen(1))
+	g.Expect(cli.Get(ctx, client.ObjectKeyFromObject(dashboardConfig), cm)).Should(Succeed())
+	g.Expect(cm.Data).Should(Equal(dashboardConfig.Data))
+
+	wb.Spec.Culling = &componentApi.NotebookCullingPolicy{
+		IdleTimeout:   metav1.Duration{Duration: 4 * time.Hour},
+		CheckInterval: metav1.Duration{Duration: 5 * time.Minute},
+	}
+
+	// the culling keys are applied to the culler configuration, which is not a resource of the
+	// component so that it is neither owned nor garbage collected
+	g.Expect(configureCulling(ctx, &rr)).Should(Succeed())
+	g.Expect(rr.Resources).Should(HaveLen(1))
+
+	g.Expect(cli.Get(ctx, client.ObjectKeyFromObject(dashboardConfig), cm)).Should(Succeed())
+	g.Expect(cm.OwnerReferences).Should(BeEmpty())
+	g.Expect(cm.Data).Should(Equal(map[string]string{
+		"ENABLE_CULLING": "true",
+		// the shortest idle timeout, in minutes
+		"CULL_IDLE_TIME":        "2",
+		"IDLENESS_CHECK_PERIOD": "5",
+	}))
+
+	g.Expect(rr.Resources[0].Object).Should(WithTransform(json.Marshal,
+		jq.Match(`.spec.template.metadata.annotations["%s"] | length == 64`, cullerConfigHashAnnotation),
+	))
+
+	// once the policy is removed, the keys applied by the platform are dropped so that the
+	// culling stops
+	wb.Spec.Culling = nil
+	rr.Resources = nil
+	g.Expect(rr.AddResources(controller)).Should(Succeed())
+
+	g.Expect(configureCulling(ctx, &rr)).Should(Succeed())
+	g.Expect(cli.Get(ctx, client.ObjectKeyFromObject(dashboardConfig), cm)).Should(Succeed())
+	g.Expect(cm.Data).Should(BeEmpty())
+	g.Expect(rr.Resources[0].Object).Should(WithTransform(json.Marshal,
+		jq.Match(`.spec.template.metadata.annotations["%s"] == null`, cullerConfigHashAnnotation),
+	))
+}
@@ -4,14 +4,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"sort"
 	"strings"
 
+	operatorv1 "github.com/openshift/api/operator/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v2"
 	cr "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/registry"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
 )
 
@@ -37,15 +43,30 @@ func computeComponentsStatus(
 
 	notReadyComponents := make([]string, 0)
 	managedComponent := 0
+	summaries := make([]common.ComponentStatusSummary, 0)
 
 	err := reg.ForEach(func(component cr.ComponentHandler) error {
 		cs, err := component.UpdateDSCStatus(ctx, rr)
 		if err != nil {
 			notReadyComponents = append(notReadyComponents, component.GetName())
+			summaries = append(summaries, common.ComponentStatusSummary{
+				Name:            component.GetName(),
+				ManagementState: managementStateOf(component, instance),
+				Ready:           metav1.ConditionUnknown,
+				FailingCondition: &common.ConditionSummary{
+					Type:    status.ConditionTypeReady,
+					Reason:  common.ConditionReasonError,
+					Message: err.Error(),
+				},
+			})
 			return err
 		}
 
 		if !component.IsEnabled(instance) {
+			summaries = append(summaries, common.ComponentStatusSummary{
+				Name:            component.GetName(),
+				ManagementState: operatorv1.Removed,
+			})
 			return nil
 		}
 
@@ -55,9 +76,26 @@ func computeComponentsStatus(
 			notReadyComponents = append(notReadyComponents, component.GetName())
 		}
 
+		obj := component.NewCRObject(instance)
+		if err := rr.Client.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+			if !k8serr.IsNotFound(err) {
+				return fmt.Errorf("failed to get %s resource: %w", component.GetName(), err)
+			}
+			obj = nil
+		}
+
+		summaries = append(summaries, newComponentStatusSummary(component.GetName(), operatorv1.Managed, cs, obj))
+
 		return nil
 	})
 
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].Name < summaries[j].Name
+	})
+
+	instance.Status.ComponentsSummary = summaries
+	instance.Status.ComponentsReady = componentsReady(summaries)
+
 	switch {
 	case len(notReadyComponents) > 0:
 		rr.Conditions.SetCondition(common.Condition{
@@ -84,3 +122,123 @@ func computeComponentsStatus(
 
 	return nil
 }
+
+// managementStateOf returns the normalized management state of the component in the DataScienceCluster.
+func managementStateOf(component cr.ComponentHandler, instance *dscv2.DataScienceCluster) operatorv1.ManagementState {
+	if component.IsEnabled(instance) {
+		return operatorv1.Managed
+	}
+	return operatorv1.Removed
+}
+
+// componentsReady returns the number of ready managed components over the number of managed components.
+func componentsReady(summaries []common.ComponentStatusSummary) string {
+	managed, ready := 0, 0
+	for _, s := range summaries {
+		if s.ManagementState != operatorv1.Managed {
+			continue
+		}
+		managed++
+		if s.Ready == metav1.ConditionTrue {
+			ready++
+		}
+	}
+	return fmt.Sprintf("%d/%d", ready, managed)
+}
+
+// newComponentStatusSummary builds the status summary of a managed component from its resource,
+// obj is nil when the resource has not been created yet.
+func newComponentStatusSummary(
+	name string,
+	ms operatorv1.ManagementState,
+	ready metav1.ConditionStatus,
+	obj common.PlatformObject,
+) common.ComponentStatusSummary {
+	summary := common.ComponentStatusSummary{
+		Name:            name,
+		ManagementState: ms,
+		Ready:           ready,
+	}
+
+	if obj == nil {
+		summary.FailingCondition = &common.ConditionSummary{
+			Type:    status.ConditionTypeReady,
+			Reason:  status.NotReadyReason,
+			Message: fmt.Sprintf("%s resource not found", name),
+		}
+		return summary
+	}
+
+	cs := obj.GetStatus()
+	summary.Phase = cs.Phase
+
+	if lag := obj.GetGeneration() - cs.ObservedGeneration; lag > 0 {
+		summary.GenerationLag = lag
+	}
+
+	if rc := conditions.FindStatusCondition(obj, status.ConditionTypeReady); rc != nil {
+		summary.LastTransitionTime = rc.LastTransitionTime.DeepCopy()
+	}
+
+	if fc := topFailingCondition(obj.GetConditions()); fc != nil {
+		summary.FailingCondition = &common.ConditionSummary{
+			Type:    fc.Type,
+			Reason:  fc.Reason,
+			Message: fc.Message,
+		}
+	}
+
+	if wr, ok := obj.(common.WithReleases); ok {
+		if releases := wr.GetReleaseStatus(); releases != nil && len(*releases) > 0 {
+			summary.Releases = slices.Clone(*releases)
+		}
+	}
+
+	if wi, ok := obj.(common.WithImages); ok {
+		if images := wi.GetImageStatus(); images != nil && len(*images) > 0 {
+			summary.Images = make([]common.ComponentImage, 0, len(*images))
+			for i := range *images {
+				summary.Images = append(summary.Images, *(*images)[i].DeepCopy())
+			}
+		}
+	}
+
+	return summary
+}
+
+// topFailingCondition returns the condition best describing why a component is not ready: False
+// error conditions are preferred to Unknown ones, then the error conditions other than Ready to the
+// Ready condition, and the most recent transition first. It returns nil when all the error
+// conditions are True.
+func topFailingCondition(conds []common.Condition) *common.Condition {
+	var top *common.Condition
+
+	rank := func(c *common.Condition) int {
+		r := 0
+		if c.Status == metav1.ConditionFalse {
+			r += 2
+		}
+		if c.Type != status.ConditionTypeReady {
+			r++
+		}
+		return r
+	}
+
+	for i := range conds {
+		c := &conds[i]
+		if c.Status == metav1.ConditionTrue || c.Severity != common.ConditionSeverityError {
+			continue
+		}
+
+		switch {
+		case top == nil:
+			top = c
+		case rank(c) > rank(top):
+			top = c
+		case rank(c) == rank(top) && c.LastTransitionTime.After(top.LastTransitionTime.Time):
+			top = c
+		}
+	}
+
+	return top
+}
@@ -0,0 +1,105 @@
+//nolint:testpackage
+package datasciencecluster
+
+import (
+	"testing"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestNewComponentStatusSummary(t *testing.T) {
+	g := NewWithT(t)
+
+	now := metav1.NewTime(time.Now())
+	earlier := metav1.NewTime(now.Add(-time.Minute))
+
+	ray := &componentApi.Ray{}
+	ray.Name = componentApi.RayInstanceName
+	ray.Generation = 5
+	ray.Status.Phase = status.PhaseNotReady
+	ray.Status.ObservedGeneration = 3
+	ray.Status.Releases = []common.ComponentRelease{{Name: "KubeRay", Version: "1.4.2"}}
+	ray.Status.Images = []common.ComponentImage{{Deployment: "kuberay-operator", Container: "manager", Digests: []string{"sha256:abc"}}}
+	ray.Status.Conditions = []common.Condition{
+		{Type: status.ConditionTypeReady, Status: metav1.ConditionFalse, Reason: "NotReady", LastTransitionTime: now},
+		{Type: status.ConditionTypeProvisioningSucceeded, Status: metav1.ConditionTrue, LastTransitionTime: now},
+		{Type: status.ConditionDeploymentsAvailable, Status: metav1.ConditionFalse, Reason: "DeploymentsNotReady", Message: "0/1 deployments ready", LastTransitionTime: earlier},
+		{Type: "Degraded", Status: metav1.ConditionFalse, Severity: common.ConditionSeverityInfo, LastTransitionTime: now},
+	}
+
+	summary := newComponentStatusSummary(componentApi.RayComponentName, operatorv1.Managed, metav1.ConditionFalse, ray)
+
+	g.Expect(summary.Name).To(Equal(componentApi.RayComponentName))
+	g.Expect(summary.ManagementState).To(Equal(operatorv1.Managed))
+	g.Expect(summary.Phase).To(Equal(status.PhaseNotReady))
+	g.Expect(summary.Ready).To(Equal(metav1.ConditionFalse))
+	g.Expect(summary.GenerationLag).To(Equal(int64(2)))
+	g.Expect(summary.LastTransitionTime).NotTo(BeNil())
+	g.Expect(summary.LastTransitionTime.Time).To(BeTemporally("==", now.Time))
+	g.Expect(summary.Releases).To(ConsistOf(common.ComponentRelease{Name: "KubeRay", Version: "1.4.2"}))
+	g.Expect(summary.Images).To(Equal(ray.Status.Images))
+	g.Expect(summary.FailingCondition).To(Equal(&common.ConditionSummary{
+		Type:    status.ConditionDeploymentsAvailable,
+		Reason:  "DeploymentsNotReady",
+		Message: "0/1 deployments ready",
+	}))
+
+	summary = newComponentStatusSummary(componentApi.RayComponentName, operatorv1.Managed, metav1.ConditionUnknown, nil)
+	g.Expect(summary.FailingCondition).NotTo(BeNil())
+	g.Expect(summary.FailingCondition.Type).To(Equal(status.ConditionTypeReady))
+}
+
+func TestTopFailingCondition(t *testing.T) {
+	g := NewWithT(t)
+
+	now := metav1.NewTime(time.Now())
+	earlier := metav1.NewTime(now.Add(-time.Minute))
+
+	g.Expect(topFailingCondition(nil)).To(BeNil())
+	g.Expect(topFailingCondition([]common.Condition{
+		{Type: status.ConditionTypeReady, Status: metav1.ConditionTrue},
+	})).To(BeNil())
+
+	top := topFailingCondition([]common.Condition{
+		{Type: status.ConditionTypeReady, Status: metav1.ConditionFalse, LastTransitionTime: now},
+		{Type: "A", Status: metav1.ConditionUnknown, LastTransitionTime: now},
+		{Type: "B", Status: metav1.ConditionFalse, LastTransitionTime: earlier},
+		{Type: "C", Status: metav1.ConditionFalse, LastTransitionTime: now},
+	})
+	g.Expect(top).NotTo(BeNil())
+	g.Expect(top.Type).To(Equal("C"))
+
+	// a False Ready condition is preferred to an Unknown one
+	top = topFailingCondition([]common.Condition{
+		{Type: status.ConditionTypeReady, Status: metav1.ConditionFalse, LastTransitionTime: earlier},
+		{Type: "A", Status: metav1.ConditionUnknown, LastTransitionTime: now},
+	})
+	g.Expect(top).NotTo(BeNil())
+	g.Expect(top.Type).To(Equal(status.ConditionTypeReady))
+
+	top = topFailingCondition([]common.Condition{
+		{Type: status.ConditionTypeReady, Status: metav1.ConditionUnknown, LastTransitionTime: now},
+		{Type: "A", Status: metav1.ConditionUnknown, LastTransitionTime: earlier},
+	})
+	g.Expect(top).NotTo(BeNil())
+	g.Expect(top.Type).To(Equal("A"))
+}
+
+func TestComponentsReady(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(componentsReady(nil)).To(Equal("0/0"))
+	g.Expect(componentsReady([]common.ComponentStatusSummary{
+		{Name: "a", ManagementState: operatorv1.Managed, Ready: metav1.ConditionTrue},
+		{Name: "b", ManagementState: operatorv1.Managed, Ready: metav1.ConditionFalse},
+		{Name: "c", ManagementState: operatorv1.Removed},
+	})).To(Equal("1/2"))
+}
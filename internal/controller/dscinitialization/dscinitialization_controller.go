@@ -524,6 +524,7 @@ func (r *DSCInitializationReconciler) newMonitoringCR(ctx context.Context, dsci
 
 	metricsEnabled := dsci.Spec.Monitoring.Metrics != nil && dsci.Spec.Monitoring.Metrics.Storage != nil
 	tracesEnabled := dsci.Spec.Monitoring.Traces != nil
+	logsEnabled := dsci.Spec.Monitoring.Logs != nil
 
 	if metricsEnabled {
 		defaultMonitoring.Spec.Metrics = dsci.Spec.Monitoring.Metrics
@@ -537,9 +538,15 @@ func (r *DSCInitializationReconciler) newMonitoringCR(ctx context.Context, dsci
 		defaultMonitoring.Spec.Traces = nil
 	}
 
+	if logsEnabled {
+		defaultMonitoring.Spec.Logs = dsci.Spec.Monitoring.Logs
+	} else {
+		defaultMonitoring.Spec.Logs = nil
+	}
+
 	defaultMonitoring.Spec.Alerting = dsci.Spec.Monitoring.Alerting
 
-	if metricsEnabled || tracesEnabled {
+	if metricsEnabled || tracesEnabled || logsEnabled {
 		if dsci.Spec.Monitoring.CollectorReplicas != 0 {
 			defaultMonitoring.Spec.CollectorReplicas = dsci.Spec.Monitoring.CollectorReplicas
 		} else {
@@ -5,11 +5,14 @@ import (
 	"crypto/rand"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"time"
 
 	operatorv1 "github.com/openshift/api/operator/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -19,12 +22,19 @@ import (
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 )
 
+const (
+	additionalIngressPolicySuffix = "-additional-ingress"
+	egressPolicySuffix            = "-egress"
+)
+
 var (
 	resourceInterval = 2 * time.Second
 )
@@ -53,10 +63,19 @@ func (r *DSCInitializationReconciler) createOperatorResource(ctx context.Context
 	}
 
 	// Create default NetworkPolicy for the namespace
-	if err := ReconcileDefaultNetworkPolicy(ctx, r.Client, dscInit, platform); err != nil {
+	networkPolicies, err := ReconcileDefaultNetworkPolicy(ctx, r.Client, dscInit, platform)
+	if err != nil {
 		return err
 	}
 
+	if !equality.Semantic.DeepEqual(dscInit.Status.NetworkPolicies, networkPolicies) {
+		if _, err := status.UpdateWithRetry(ctx, r.Client, dscInit, func(saved *dsciv2.DSCInitialization) {
+			saved.Status.NetworkPolicies = networkPolicies
+		}); err != nil {
+			return fmt.Errorf("failed to update generated NetworkPolicies in status: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -154,49 +173,129 @@ func PatchMonitoringNS(ctx context.Context, cli client.Client, dscInit *dsciv2.D
 	return err
 }
 
+// ReconcileDefaultNetworkPolicy creates the NetworkPolicies of the applications namespace and
+// returns the list of policies generated by the operator:
+// - the default ingress policy, generated on Open Data Hub or shipped with the manifests otherwise
+// - an ingress policy for the additional peers of the DSCI network policy configuration
+// - an egress policy denying traffic not allowed by the DSCI network policy configuration.
 func ReconcileDefaultNetworkPolicy(
 	ctx context.Context,
 	cli client.Client,
 	dscInit *dsciv2.DSCInitialization,
 	platform common.Platform,
-) error {
-	if platform == cluster.ManagedRhoai || platform == cluster.SelfManagedRhoai {
-		log := logf.FromContext(ctx)
+) ([]serviceApi.GeneratedNetworkPolicy, error) {
+	log := logf.FromContext(ctx)
+
+	appNamespace := dscInit.Spec.ApplicationsNamespace
+	npConfig := dscInit.Spec.NetworkPolicy
+	mode := cluster.NetworkPolicyModeOf(npConfig)
+
+	ingressEnabled := npConfig == nil || npConfig.Ingress == nil || npConfig.Ingress.Enabled
+
+	generated := make([]serviceApi.GeneratedNetworkPolicy, 0)
 
+	if platform == cluster.ManagedRhoai || platform == cluster.SelfManagedRhoai {
 		// Get operator namespace
 		operatorNs, err := cluster.GetOperatorNamespace()
 		if err != nil {
 			log.Error(err, "error getting operator namespace for networkpolicy creation")
-			return err
+			return nil, err
 		}
 		// Deploy networkpolicy for operator namespace
 		err = deploy.DeployManifestsFromPath(ctx, cli, dscInit, networkpolicyPath+"/operator", operatorNs, "networkpolicy", true)
 		if err != nil {
 			log.Error(err, "error to set networkpolicy in operator namespace", "path", networkpolicyPath)
-			return err
+			return nil, err
 		}
 		// Deploy networkpolicy for monitoring namespace only when it is managed cluster.
 		if platform == cluster.ManagedRhoai {
 			err = deploy.DeployManifestsFromPath(ctx, cli, dscInit, networkpolicyPath+"/monitoring", dscInit.Spec.Monitoring.Namespace, "networkpolicy", true)
 			if err != nil {
 				log.Error(err, "error to set networkpolicy in monitoring namespace", "path", networkpolicyPath)
-				return err
+				return nil, err
 			}
 		}
 		// Deploy networkpolicy for applications namespace
-		err = deploy.DeployManifestsFromPath(ctx, cli, dscInit, networkpolicyPath+"/applications", dscInit.Spec.ApplicationsNamespace, "networkpolicy", true)
+		err = deploy.DeployManifestsFromPath(ctx, cli, dscInit, networkpolicyPath+"/applications", appNamespace, "networkpolicy", true)
 		if err != nil {
 			log.Error(err, "error to set networkpolicy in applications namespace", "path", networkpolicyPath)
-			return err
+			return nil, err
 		}
-		return nil
+	} else {
+		var np *networkingv1.NetworkPolicy
+		if ingressEnabled {
+			np = newDefaultNetworkPolicy(appNamespace)
+		}
+
+		npStatus, err := reconcileGeneratedNetworkPolicy(ctx, cli, dscInit, appNamespace, np, mode)
+		if err != nil {
+			return nil, err
+		}
+		if npStatus != nil {
+			generated = append(generated, *npStatus)
+		}
+	}
+
+	var additionalPeers []serviceApi.NetworkPolicyPeer
+	if ingressEnabled && npConfig != nil && npConfig.Ingress != nil {
+		additionalPeers = npConfig.Ingress.AdditionalPeers
+	}
+
+	var egressConfig *serviceApi.EgressPolicyConfig
+	if npConfig != nil {
+		egressConfig = npConfig.Egress
+	}
+
+	monitoringNamespaces := []string{"openshift-monitoring", "openshift-user-workload-monitoring"}
+	if dscInit.Spec.Monitoring.Namespace != "" {
+		monitoringNamespaces = append(monitoringNamespaces, dscInit.Spec.Monitoring.Namespace)
 	}
 
+	apiServerPeers, err := cluster.EgressAPIServerPeers(ctx, cli, egressConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	policies := map[string]*networkingv1.NetworkPolicy{
+		appNamespace + additionalIngressPolicySuffix: cluster.NewIngressNetworkPolicy(
+			appNamespace+additionalIngressPolicySuffix,
+			appNamespace,
+			metav1.LabelSelector{},
+			additionalPeers,
+		),
+		appNamespace + egressPolicySuffix: cluster.NewEgressNetworkPolicy(
+			appNamespace+egressPolicySuffix,
+			appNamespace,
+			metav1.LabelSelector{},
+			egressConfig,
+			monitoringNamespaces,
+			apiServerPeers,
+			// traffic between the pods of the namespace and to the other ODH namespaces is allowed.
+			append(cluster.ODHNamespacePeers(), networkingv1.NetworkPolicyPeer{PodSelector: &metav1.LabelSelector{}})...,
+		),
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(policies)) {
+		npStatus, err := reconcileGeneratedNetworkPolicy(ctx, cli, dscInit, name, policies[name], mode)
+		if err != nil {
+			return nil, err
+		}
+		if npStatus != nil {
+			generated = append(generated, *npStatus)
+		}
+	}
+
+	return generated, nil
+}
+
+// newDefaultNetworkPolicy returns the NetworkPolicy allowing traffic to the applications namespace
+// from the ODH namespaces, the ingress controllers and monitoring.
+func newDefaultNetworkPolicy(appNamespace string) *networkingv1.NetworkPolicy {
 	// Expected namespace for the given name in ODH
-	np := networkingv1.NetworkPolicy{
+	return &networkingv1.NetworkPolicy{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      dscInit.Spec.ApplicationsNamespace,
-			Namespace: dscInit.Spec.ApplicationsNamespace,
+			Name:      appNamespace,
+			Namespace: appNamespace,
 		},
 		Spec: networkingv1.NetworkPolicySpec{
 			Ingress: []networkingv1.NetworkPolicyIngressRule{{
@@ -212,28 +311,54 @@ func ReconcileDefaultNetworkPolicy(
 			},
 		},
 	}
+}
+
+// reconcileGeneratedNetworkPolicy applies np with the given mode, or deletes the policy named name
+// from the applications namespace when np is nil. It returns the status entry of the applied policy.
+func reconcileGeneratedNetworkPolicy(
+	ctx context.Context,
+	cli client.Client,
+	dscInit *dsciv2.DSCInitialization,
+	name string,
+	np *networkingv1.NetworkPolicy,
+	mode serviceApi.NetworkPolicyMode,
+) (*serviceApi.GeneratedNetworkPolicy, error) {
+	if np == nil {
+		existing := &networkingv1.NetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: dscInit.Spec.ApplicationsNamespace,
+			},
+		}
+		if err := cli.Delete(ctx, existing); client.IgnoreNotFound(err) != nil {
+			return nil, fmt.Errorf("unable to delete NetworkPolicy %s: %w", name, err)
+		}
+		return nil, nil
+	}
 
-	if err := resources.EnsureGroupVersionKind(cli.Scheme(), &np); err != nil {
-		return fmt.Errorf("unable to set GVK to NetworkPolicy: %w", err)
+	cluster.ApplyNetworkPolicyMode(np, mode)
+
+	if err := resources.EnsureGroupVersionKind(cli.Scheme(), np); err != nil {
+		return nil, fmt.Errorf("unable to set GVK to NetworkPolicy: %w", err)
 	}
 
-	if err := controllerutil.SetControllerReference(dscInit, &np, cli.Scheme()); err != nil {
-		return fmt.Errorf("unable to add OwnerReference to the Network policy: %w", err)
+	if err := controllerutil.SetControllerReference(dscInit, np, cli.Scheme()); err != nil {
+		return nil, fmt.Errorf("unable to add OwnerReference to the Network policy: %w", err)
 	}
 
 	err := resources.Apply(
 		ctx,
 		cli,
-		&np,
+		np,
 		client.FieldOwner(fieldManager),
 		client.ForceOwnership,
 	)
-
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	npStatus := cluster.GeneratedNetworkPolicyStatus(np)
+	return &npStatus, nil
 }
 
 func (r *DSCInitializationReconciler) waitForManagedSecret(ctx context.Context, name string, namespace string) (*corev1.Secret, error) {
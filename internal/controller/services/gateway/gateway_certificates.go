@@ -0,0 +1,276 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+)
+
+const (
+	// Issuer names reported in the GatewayConfig certificate status.
+	serviceCAIssuerName = "ServiceCA"
+
+	defaultCertificateRenewBefore = 30 * 24 * time.Hour
+	defaultIssuerKind             = "ClusterIssuer"
+	defaultIssuerGroup            = "cert-manager.io"
+)
+
+// certificateIssuer provisions the TLS secret referenced by the Gateway listener.
+type certificateIssuer interface {
+	// name identifies the issuer in the GatewayConfig status.
+	name() string
+	// issue makes sure the certificate secret for hostname exists, or is requested,
+	// and returns the name of the secret.
+	issue(ctx context.Context, rr *odhtypes.ReconciliationRequest, gatewayConfig *serviceApi.GatewayConfig, hostname string) (string, error)
+}
+
+// newCertificateIssuer selects the issuer for a gateway. A cert-manager issuer reference
+// takes precedence over the certificate type, as long as the cert-manager Certificate CRD
+// is available on the cluster.
+func newCertificateIssuer(ctx context.Context, rr *odhtypes.ReconciliationRequest, gw *gatewayInstance) (certificateIssuer, error) {
+	var certConfig infrav1.CertificateSpec
+	if gw.certificate != nil {
+		certConfig = *gw.certificate
+	}
+
+	if certConfig.Type == "" {
+		certConfig.Type = infrav1.OpenshiftDefaultIngress
+	}
+
+	secretName := gw.certSecretName()
+	duration, renewBefore := getCertificateLifetime(gw.lifecycle)
+
+	if lc := gw.lifecycle; lc != nil && lc.IssuerRef != nil {
+		hasCRD, err := cluster.HasCRD(ctx, rr.Client, gvk.CertManagerCertificate)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check for cert-manager Certificate CRD: %w", err)
+		}
+
+		if hasCRD {
+			return &certManagerIssuer{
+				secretName:  secretName,
+				issuerRef:   *lc.IssuerRef,
+				duration:    duration,
+				renewBefore: renewBefore,
+			}, nil
+		}
+
+		logf.FromContext(ctx).Info("cert-manager Certificate CRD not found, falling back to certificate type",
+			"gateway", gw.name,
+			"issuer", lc.IssuerRef.Name,
+			"type", certConfig.Type)
+	}
+
+	switch certConfig.Type {
+	case infrav1.OpenshiftDefaultIngress:
+		return &defaultIngressIssuer{secretName: secretName}, nil
+	case infrav1.SelfSigned:
+		return &selfSignedIssuer{
+			secretName:  secretName,
+			duration:    duration,
+			renewBefore: renewBefore,
+		}, nil
+	case infrav1.Provided:
+		return &providedIssuer{secretName: secretName}, nil
+	default:
+		return nil, fmt.Errorf("unsupported certificate type: %s", certConfig.Type)
+	}
+}
+
+// defaultIngressIssuer copies the OpenShift default ingress certificate.
+type defaultIngressIssuer struct {
+	secretName string
+}
+
+func (i *defaultIngressIssuer) name() string {
+	return string(infrav1.OpenshiftDefaultIngress)
+}
+
+func (i *defaultIngressIssuer) issue(ctx context.Context, rr *odhtypes.ReconciliationRequest, gatewayConfig *serviceApi.GatewayConfig, _ string) (string, error) {
+	if err := cluster.PropagateDefaultIngressCertificate(ctx, rr.Client, i.secretName, GatewayNamespace,
+		cluster.WithLabels( // add label easy to know it is from us.
+			labels.PlatformPartOf, ServiceName,
+		),
+		cluster.OwnedBy(gatewayConfig, rr.Client.Scheme()), // set ownerreference for cleanup
+	); err != nil {
+		return "", fmt.Errorf("failed to propagate default ingress certificate: %w", err)
+	}
+
+	return i.secretName, nil
+}
+
+// selfSignedIssuer generates a self-signed certificate and rotates it before it expires.
+// The secret is updated in place, the gateway picks up the new certificate through SDS
+// without restarting its pods.
+type selfSignedIssuer struct {
+	secretName  string
+	duration    time.Duration
+	renewBefore time.Duration
+}
+
+func (i *selfSignedIssuer) name() string {
+	return string(infrav1.SelfSigned)
+}
+
+func (i *selfSignedIssuer) issue(ctx context.Context, rr *odhtypes.ReconciliationRequest, gatewayConfig *serviceApi.GatewayConfig, hostname string) (string, error) {
+	rotated, err := cluster.EnsureSelfSignedCertificate(ctx, rr.Client, i.secretName, hostname, GatewayNamespace,
+		i.duration,
+		i.renewBefore,
+		cluster.WithLabels( // add label easy to know it is from us.
+			labels.PlatformPartOf, ServiceName,
+		),
+		cluster.OwnedBy(gatewayConfig, rr.Client.Scheme()), // set ownerreference for cleanup
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to create self-signed certificate: %w", err)
+	}
+
+	if rotated {
+		logf.FromContext(ctx).Info("Generated self-signed gateway certificate", "secretName", i.secretName, "hostname", hostname)
+	}
+
+	return i.secretName, nil
+}
+
+// providedIssuer uses a secret managed by the user.
+type providedIssuer struct {
+	secretName string
+}
+
+func (i *providedIssuer) name() string {
+	return string(infrav1.Provided)
+}
+
+func (i *providedIssuer) issue(_ context.Context, _ *odhtypes.ReconciliationRequest, _ *serviceApi.GatewayConfig, _ string) (string, error) {
+	return i.secretName, nil
+}
+
+// certManagerIssuer requests the certificate from a cert-manager issuer. Issuance and
+// renewal are performed by cert-manager, which writes the secret used by the gateway.
+type certManagerIssuer struct {
+	secretName  string
+	issuerRef   serviceApi.CertificateIssuerRef
+	duration    time.Duration
+	renewBefore time.Duration
+}
+
+func (i *certManagerIssuer) name() string {
+	return fmt.Sprintf("%s/%s", i.kind(), i.issuerRef.Name)
+}
+
+func (i *certManagerIssuer) kind() string {
+	if i.issuerRef.Kind == "" {
+		return defaultIssuerKind
+	}
+	return i.issuerRef.Kind
+}
+
+func (i *certManagerIssuer) group() string {
+	if i.issuerRef.Group == "" {
+		return defaultIssuerGroup
+	}
+	return i.issuerRef.Group
+}
+
+func (i *certManagerIssuer) issue(_ context.Context, rr *odhtypes.ReconciliationRequest, _ *serviceApi.GatewayConfig, hostname string) (string, error) {
+	certificate := unstructured.Unstructured{}
+	certificate.SetGroupVersionKind(gvk.CertManagerCertificate)
+	certificate.SetName(i.secretName)
+	certificate.SetNamespace(GatewayNamespace)
+
+	spec := map[string]any{
+		"secretName":  i.secretName,
+		"dnsNames":    []any{hostname},
+		"duration":    i.duration.String(),
+		"renewBefore": i.renewBefore.String(),
+		"issuerRef": map[string]any{
+			"name":  i.issuerRef.Name,
+			"kind":  i.kind(),
+			"group": i.group(),
+		},
+		"secretTemplate": map[string]any{
+			"labels": map[string]any{
+				labels.PlatformPartOf: ServiceName,
+			},
+		},
+	}
+
+	if err := unstructured.SetNestedField(certificate.Object, spec, "spec"); err != nil {
+		return "", fmt.Errorf("failed to set cert-manager Certificate spec: %w", err)
+	}
+
+	rr.Resources = append(rr.Resources, certificate)
+
+	return i.secretName, nil
+}
+
+// getCertificateLifetime returns the certificate duration and renewal window with defaults.
+// A renewal window that is not shorter than the duration would rotate the certificate on
+// every reconcile, so it is capped to a third of the duration.
+func getCertificateLifetime(lc *serviceApi.CertificateLifecycleConfig) (time.Duration, time.Duration) {
+	duration, renewBefore := cluster.DefaultCertificateValidity, defaultCertificateRenewBefore
+
+	if lc != nil {
+		if lc.Duration.Duration > 0 {
+			duration = lc.Duration.Duration
+		}
+		if lc.RenewBefore.Duration > 0 {
+			renewBefore = lc.RenewBefore.Duration
+		}
+	}
+
+	if renewBefore >= duration {
+		renewBefore = duration / 3
+	}
+
+	return duration, renewBefore
+}
+
+// getCertificateStatus returns the certificate served by a gateway and exports its expiry
+// as a metric. The secret may not exist yet when it is populated asynchronously by
+// cert-manager or the service CA, which is not an error.
+func getCertificateStatus(
+	ctx context.Context,
+	rr *odhtypes.ReconciliationRequest,
+	gatewayName string,
+	secretName string,
+	issuer string,
+) (*serviceApi.GatewayCertificateStatus, error) {
+	certStatus := &serviceApi.GatewayCertificateStatus{
+		SecretName: secretName,
+		Issuer:     issuer,
+	}
+
+	secret, err := cluster.GetSecret(ctx, rr.Client, GatewayNamespace, secretName)
+	if k8serr.IsNotFound(err) {
+		return certStatus, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get certificate secret %s/%s: %w", GatewayNamespace, secretName, err)
+	}
+
+	cert, err := cluster.CertificateFromSecret(secret)
+	if err != nil {
+		logf.FromContext(ctx).V(1).Info("Unable to read gateway certificate", "secretName", secretName, "error", err.Error())
+		return certStatus, nil
+	}
+
+	notAfter := metav1.NewTime(cert.NotAfter)
+	certStatus.NotAfter = &notAfter
+
+	CertificateExpiryTimestamp.WithLabelValues(gatewayName, secretName).Set(float64(cert.NotAfter.Unix()))
+
+	return certStatus, nil
+}
@@ -0,0 +1,151 @@
+//nolint:testpackage
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+
+	. "github.com/onsi/gomega"
+)
+
+// TestGetCertificateLifetime tests the certificate duration and renewal window defaults.
+func TestGetCertificateLifetime(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name                string
+		lifecycle           *serviceApi.CertificateLifecycleConfig
+		expectedDuration    time.Duration
+		expectedRenewBefore time.Duration
+	}{
+		{
+			name:                "returns defaults when lifecycle is nil",
+			lifecycle:           nil,
+			expectedDuration:    cluster.DefaultCertificateValidity,
+			expectedRenewBefore: defaultCertificateRenewBefore,
+		},
+		{
+			name: "returns configured values",
+			lifecycle: &serviceApi.CertificateLifecycleConfig{
+				Duration:    metav1.Duration{Duration: 90 * 24 * time.Hour},
+				RenewBefore: metav1.Duration{Duration: 15 * 24 * time.Hour},
+			},
+			expectedDuration:    90 * 24 * time.Hour,
+			expectedRenewBefore: 15 * 24 * time.Hour,
+		},
+		{
+			name: "caps renewal window longer than duration",
+			lifecycle: &serviceApi.CertificateLifecycleConfig{
+				Duration:    metav1.Duration{Duration: 24 * time.Hour},
+				RenewBefore: metav1.Duration{Duration: 48 * time.Hour},
+			},
+			expectedDuration:    24 * time.Hour,
+			expectedRenewBefore: 8 * time.Hour,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			duration, renewBefore := getCertificateLifetime(tc.lifecycle)
+			g.Expect(duration).To(Equal(tc.expectedDuration))
+			g.Expect(renewBefore).To(Equal(tc.expectedRenewBefore))
+		})
+	}
+}
+
+// TestNewCertificateIssuer tests issuer selection, including the fallback when cert-manager is missing.
+func TestNewCertificateIssuer(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	rr := &odhtypes.ReconciliationRequest{Client: setupTestClient().Build()}
+
+	gatewayConfig := &serviceApi.GatewayConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: serviceApi.GatewayConfigName},
+		Spec: serviceApi.GatewayConfigSpec{
+			Certificate: &infrav1.CertificateSpec{Type: infrav1.SelfSigned},
+			CertificateLifecycle: &serviceApi.CertificateLifecycleConfig{
+				IssuerRef: &serviceApi.CertificateIssuerRef{Name: "letsencrypt"},
+			},
+		},
+	}
+
+	gw := newDefaultGateway(gatewayConfig)
+	issuer, err := newCertificateIssuer(ctx, rr, &gw)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(issuer).To(BeAssignableToTypeOf(&selfSignedIssuer{}))
+	g.Expect(issuer.name()).To(Equal(string(infrav1.SelfSigned)))
+
+	gw.certificate = &infrav1.CertificateSpec{Type: "Unknown"}
+	_, err = newCertificateIssuer(ctx, rr, &gw)
+	g.Expect(err).To(HaveOccurred())
+}
+
+// TestCertManagerIssuer tests the cert-manager Certificate requested for the gateway.
+func TestCertManagerIssuer(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	issuer := &certManagerIssuer{
+		secretName:  "default-gateway-tls",
+		issuerRef:   serviceApi.CertificateIssuerRef{Name: "letsencrypt"},
+		duration:    90 * 24 * time.Hour,
+		renewBefore: 30 * 24 * time.Hour,
+	}
+	g.Expect(issuer.name()).To(Equal("ClusterIssuer/letsencrypt"))
+
+	rr := &odhtypes.ReconciliationRequest{}
+	secretName, err := issuer.issue(t.Context(), rr, &serviceApi.GatewayConfig{}, "gw.example.com")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(secretName).To(Equal("default-gateway-tls"))
+	g.Expect(rr.Resources).To(HaveLen(1))
+
+	certificate := rr.Resources[0]
+	g.Expect(certificate.GroupVersionKind()).To(Equal(gvk.CertManagerCertificate))
+	g.Expect(certificate.GetNamespace()).To(Equal(GatewayNamespace))
+
+	dnsNames, _, _ := unstructured.NestedStringSlice(certificate.Object, "spec", "dnsNames")
+	g.Expect(dnsNames).To(ConsistOf("gw.example.com"))
+	group, _, _ := unstructured.NestedString(certificate.Object, "spec", "issuerRef", "group")
+	g.Expect(group).To(Equal(defaultIssuerGroup))
+	renewBefore, _, _ := unstructured.NestedString(certificate.Object, "spec", "renewBefore")
+	g.Expect(renewBefore).To(Equal("720h0m0s"))
+}
+
+// TestGetCertificateStatus tests that the certificate expiry is reported in the GatewayConfig status.
+func TestGetCertificateStatus(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	secret, err := cluster.GenerateSelfSignedCertificateAsSecret("default-gateway-tls", "gw.example.com", GatewayNamespace)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	rr := &odhtypes.ReconciliationRequest{Client: setupTestClient().WithObjects(secret).Build()}
+
+	certStatus, err := getCertificateStatus(ctx, rr, DefaultGatewayName, "default-gateway-tls", string(infrav1.SelfSigned))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(certStatus).NotTo(BeNil())
+	g.Expect(certStatus.Issuer).To(Equal(string(infrav1.SelfSigned)))
+	g.Expect(certStatus.NotAfter).NotTo(BeNil())
+	g.Expect(certStatus.NotAfter.Time).To(
+		BeTemporally("~", time.Now().Add(cluster.DefaultCertificateValidity), time.Minute))
+
+	certStatus, err = getCertificateStatus(ctx, rr, DefaultGatewayName, "missing-tls", serviceCAIssuerName)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(certStatus.SecretName).To(Equal("missing-tls"))
+	g.Expect(certStatus.NotAfter).To(BeNil())
+}
@@ -50,8 +50,10 @@ func (h *ServiceHandler) NewReconciler(ctx context.Context, mgr ctrl.Manager) er
 		OwnsGVK(gvk.Deployment).
 		OwnsGVK(gvk.HTTPRoute).
 		OwnsGVK(gvk.Route).
+		OwnsGVK(gvk.NetworkPolicy).
 		OwnsGVK(gvk.EnvoyFilter, reconciler.Dynamic(reconciler.CrdExists(gvk.EnvoyFilter))).
 		OwnsGVK(gvk.DestinationRule, reconciler.Dynamic(reconciler.CrdExists(gvk.DestinationRule))).
+		OwnsGVK(gvk.CertManagerCertificate, reconciler.Dynamic(reconciler.CrdExists(gvk.CertManagerCertificate))).
 		// Watch for certificate secrets (both OpenShift default ingress and provided).
 		Watches(
 			&corev1.Secret{},
@@ -22,9 +22,12 @@ import (
 	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -55,45 +58,93 @@ func createGatewayInfrastructure(ctx context.Context, rr *odhtypes.Reconciliatio
 		}
 	}
 
-	hostname, err := GetFQDN(ctx, rr.Client, gatewayConfig)
-	if err != nil {
-		return fmt.Errorf("failed to resolve domain: %w", err)
+	if err := createGatewayClass(rr); err != nil {
+		return fmt.Errorf("failed to create GatewayClass: %w", err)
 	}
 
-	// Handle ingress mode changes by deleting Gateway if configuration doesn't match.
-	// This is necessary because SSA doesn't remove fields that are omitted from the desired object.
-	if err := reconcileGatewayForModeChange(ctx, rr, gatewayConfig.Spec.IngressMode); err != nil {
-		return fmt.Errorf("failed to reconcile Gateway for mode change: %w", err)
-	}
+	// Expiry metrics are recomputed for every gateway, drop the ones of removed gateways.
+	CertificateExpiryTimestamp.Reset()
 
-	if err := createGatewayClass(rr); err != nil {
-		return fmt.Errorf("failed to create GatewayClass: %w", err)
+	defaultGateway := newDefaultGateway(gatewayConfig)
+
+	hostname, certStatus, err := createGatewayInstance(ctx, rr, gatewayConfig, &defaultGateway)
+	if err != nil {
+		return err
 	}
+	gatewayConfig.Status.Certificate = certStatus
 
-	var certSecretName string
-	if gatewayConfig.Spec.IngressMode == serviceApi.IngressModeOcpRoute {
-		certSecretName = GatewayServiceTLSSecretName
-		l.V(1).Info("Using service-CA generated certificate for OcpRoute mode", "secretName", certSecretName)
-	} else {
-		certSecretName, err = handleCertificates(ctx, rr, gatewayConfig, hostname)
+	var additionalStatus []serviceApi.AdditionalGatewayStatus
+	for _, gw := range newAdditionalGateways(gatewayConfig) {
+		gwHostname, gwCertStatus, err := createGatewayInstance(ctx, rr, gatewayConfig, &gw)
 		if err != nil {
-			return fmt.Errorf("failed to handle certificates: %w", err)
+			return fmt.Errorf("failed to create additional gateway %s: %w", gw.specName, err)
 		}
-	}
 
-	if err := createGateway(rr, certSecretName, hostname, gatewayConfig.Spec.IngressMode); err != nil {
-		return fmt.Errorf("failed to create Gateway: %w", err)
+		additionalStatus = append(additionalStatus, serviceApi.AdditionalGatewayStatus{
+			Name:        gw.specName,
+			GatewayName: gw.name,
+			Hostname:    gwHostname,
+			Certificate: gwCertStatus,
+		})
 	}
+	gatewayConfig.Status.AdditionalGateways = additionalStatus
 
 	l.V(1).Info("Successfully created Gateway infrastructure",
 		"gateway", DefaultGatewayName,
 		"namespace", GatewayNamespace,
 		"domain", hostname,
-		"certificateType", getCertificateType(gatewayConfig))
+		"certificateType", getCertificateType(gatewayConfig),
+		"additionalGateways", len(additionalStatus))
 
 	return nil
 }
 
+// createGatewayInstance adds the Gateway, its certificate and, in OcpRoute mode, its service
+// configuration to the reconciliation request. It returns the gateway host name and the
+// status of the certificate served by the gateway.
+func createGatewayInstance(
+	ctx context.Context,
+	rr *odhtypes.ReconciliationRequest,
+	gatewayConfig *serviceApi.GatewayConfig,
+	gw *gatewayInstance,
+) (string, *serviceApi.GatewayCertificateStatus, error) {
+	l := logf.FromContext(ctx).WithName("createGatewayInstance")
+
+	hostname, err := gw.fqdn(ctx, rr.Client)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to resolve domain: %w", err)
+	}
+
+	// Handle ingress mode changes by deleting Gateway if configuration doesn't match.
+	// This is necessary because SSA doesn't remove fields that are omitted from the desired object.
+	if err := reconcileGatewayForModeChange(ctx, rr, gw.name, gw.ingressMode); err != nil {
+		return "", nil, fmt.Errorf("failed to reconcile Gateway for mode change: %w", err)
+	}
+
+	var certSecretName, certIssuer string
+	if gw.ingressMode == serviceApi.IngressModeOcpRoute {
+		certSecretName = gw.serviceTLSSecretName
+		certIssuer = serviceCAIssuerName
+		l.V(1).Info("Using service-CA generated certificate for OcpRoute mode", "gateway", gw.name, "secretName", certSecretName)
+	} else {
+		certSecretName, certIssuer, err = handleCertificates(ctx, rr, gatewayConfig, gw, hostname)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to handle certificates: %w", err)
+		}
+	}
+
+	certStatus, err := getCertificateStatus(ctx, rr, gw.name, certSecretName, certIssuer)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to update certificate status: %w", err)
+	}
+
+	if err := createGateway(rr, gw, certSecretName, hostname); err != nil {
+		return "", nil, fmt.Errorf("failed to create Gateway: %w", err)
+	}
+
+	return hostname, certStatus, nil
+}
+
 // Check authentication mode and deploy auth proxy (secret + service + deployment) + OAuth client (if integrated mode) + HTTPRoute + DestinationRule.
 func createKubeAuthProxyInfrastructure(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
 	l := logf.FromContext(ctx).WithName("createAuthProxy")
@@ -227,7 +278,8 @@ func createEnvoyFilter(ctx context.Context, rr *odhtypes.ReconciliationRequest)
 	return nil
 }
 
-// createNetworkPolicy creates a NetworkPolicy for kube-auth-proxy.
+// createNetworkPolicy creates a NetworkPolicy for kube-auth-proxy and for the pods of
+// each additional gateway.
 func createNetworkPolicy(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
 	l := logf.FromContext(ctx).WithName("createNetworkPolicy")
 	gatewayConfig, err := validateGatewayConfig(rr)
@@ -235,29 +287,162 @@ func createNetworkPolicy(ctx context.Context, rr *odhtypes.ReconciliationRequest
 		return err
 	}
 
-	// Ingress is enabled by default (when NetworkPolicy is nil or Ingress is nil)
-	// If Ingress is specified, use the explicit Enabled value
-	ingressEnabled := true
-	if gatewayConfig.Spec.NetworkPolicy != nil && gatewayConfig.Spec.NetworkPolicy.Ingress != nil {
-		ingressEnabled = gatewayConfig.Spec.NetworkPolicy.Ingress.Enabled
+	generated := make([]serviceApi.GeneratedNetworkPolicy, 0)
+	addPolicy := func(np *networkingv1.NetworkPolicy, mode serviceApi.NetworkPolicyMode) error {
+		if np == nil {
+			return nil
+		}
+		cluster.ApplyNetworkPolicyMode(np, mode)
+		generated = append(generated, cluster.GeneratedNetworkPolicyStatus(np))
+		return rr.AddResources(np)
+	}
+
+	defaultGateway := newDefaultGateway(gatewayConfig)
+	for _, gw := range append([]gatewayInstance{defaultGateway}, newAdditionalGateways(gatewayConfig)...) {
+		mode := cluster.NetworkPolicyModeOf(gw.networkPolicy)
+		gatewaySelector := metav1.LabelSelector{
+			MatchLabels: map[string]string{labels.GatewayAPI.GatewayName: gw.name},
+		}
+
+		// the default gateway pods are only protected by the kube-auth-proxy policy below
+		if gw.specName != "" {
+			if !gw.networkPolicyEnabled() {
+				l.V(1).Info("Ingress disabled, skipping NetworkPolicy creation", "gateway", gw.name)
+			} else {
+				if err := addPolicy(newGatewayNetworkPolicy(&gw), mode); err != nil {
+					return err
+				}
+				if err := addPolicy(cluster.NewIngressNetworkPolicy(gw.name+"-additional-ingress", GatewayNamespace, gatewaySelector, gw.ingressPeers()), mode); err != nil {
+					return err
+				}
+			}
+		}
+
+		apiServerPeers, err := cluster.EgressAPIServerPeers(ctx, rr.Client, gw.egressConfig())
+		if err != nil {
+			return err
+		}
+
+		// gateways forward requests to the kube-auth-proxy and to the ODH namespaces
+		egress := cluster.NewEgressNetworkPolicy(
+			gw.name+"-egress",
+			GatewayNamespace,
+			gatewaySelector,
+			gw.egressConfig(),
+			gatewayMonitoringNamespaces,
+			apiServerPeers,
+			append(cluster.ODHNamespacePeers(), cluster.NamespacePeer(GatewayNamespace))...,
+		)
+		if err := addPolicy(egress, mode); err != nil {
+			return err
+		}
+	}
+
+	mode := cluster.NetworkPolicyModeOf(gatewayConfig.Spec.NetworkPolicy)
+	kubeAuthProxySelector := metav1.LabelSelector{
+		MatchLabels: map[string]string{"app": KubeAuthProxyName},
+	}
+
+	apiServerPeers, err := cluster.EgressAPIServerPeers(ctx, rr.Client, defaultGateway.egressConfig())
+	if err != nil {
+		return err
+	}
+
+	// kube-auth-proxy reaches the gateways and the OpenShift OAuth server, OIDC providers
+	// must be allowed with additional egress peers
+	egress := cluster.NewEgressNetworkPolicy(
+		KubeAuthProxyName+"-egress",
+		GatewayNamespace,
+		kubeAuthProxySelector,
+		defaultGateway.egressConfig(),
+		gatewayMonitoringNamespaces,
+		apiServerPeers,
+		cluster.NamespacePeer(GatewayNamespace),
+		cluster.NamespacePeer(openshiftAuthenticationNamespace),
+	)
+	if err := addPolicy(egress, mode); err != nil {
+		return err
 	}
 
 	// Only skip NetworkPolicy creation if ingress is explicitly disabled
-	if !ingressEnabled {
+	if !defaultGateway.networkPolicyEnabled() {
 		l.V(1).Info("Ingress disabled, skipping NetworkPolicy creation")
+		gatewayConfig.Status.NetworkPolicies = generated
 		return nil
 	}
 
-	l.V(1).Info("Creating NetworkPolicy for kube-auth-proxy", "ingress", ingressEnabled)
+	l.V(1).Info("Creating NetworkPolicy for kube-auth-proxy", "mode", mode)
 
 	rr.Templates = append(rr.Templates, odhtypes.TemplateInfo{
 		FS:   gatewayResources,
 		Path: networkPolicyTemplate,
 	})
+	generated = append(generated, serviceApi.GeneratedNetworkPolicy{
+		Name:        KubeAuthProxyName,
+		Namespace:   GatewayNamespace,
+		PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+		Mode:        mode,
+	})
+
+	additional := cluster.NewIngressNetworkPolicy(KubeAuthProxyName+"-additional-ingress", GatewayNamespace, kubeAuthProxySelector, defaultGateway.ingressPeers())
+	if err := addPolicy(additional, mode); err != nil {
+		return err
+	}
+
+	gatewayConfig.Status.NetworkPolicies = generated
 
 	return nil
 }
 
+// newGatewayNetworkPolicy returns the NetworkPolicy of an additional gateway. It allows
+// traffic to the HTTPS listener and to the health port from anywhere, and metrics
+// collection from the cluster monitoring namespaces.
+func newGatewayNetworkPolicy(gw *gatewayInstance) *networkingv1.NetworkPolicy {
+	tcp := corev1.ProtocolTCP
+	port := func(p int32) networkingv1.NetworkPolicyPort {
+		return networkingv1.NetworkPolicyPort{Protocol: &tcp, Port: ptr.To(intstr.FromInt32(p))}
+	}
+	fromNamespace := func(name string) networkingv1.NetworkPolicyPeer {
+		return networkingv1.NetworkPolicyPeer{
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"kubernetes.io/metadata.name": name},
+			},
+		}
+	}
+
+	return &networkingv1.NetworkPolicy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: networkingv1.SchemeGroupVersion.String(),
+			Kind:       "NetworkPolicy",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      gw.name,
+			Namespace: GatewayNamespace,
+			Labels: map[string]string{
+				labels.K8SCommon.PartOf: PartOfGatewayConfig,
+			},
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{
+				MatchLabels: map[string]string{labels.GatewayAPI.GatewayName: gw.name},
+			},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+			Ingress: []networkingv1.NetworkPolicyIngressRule{
+				{
+					Ports: []networkingv1.NetworkPolicyPort{port(StandardHTTPSPort), port(GatewayHealthPort)},
+				},
+				{
+					From: []networkingv1.NetworkPolicyPeer{
+						fromNamespace(gatewayMonitoringNamespaces[0]),
+						fromNamespace(gatewayMonitoringNamespaces[1]),
+					},
+					Ports: []networkingv1.NetworkPolicyPort{port(GatewayMetricsPort)},
+				},
+			},
+		},
+	}
+}
+
 func getTemplateData(ctx context.Context, rr *odhtypes.ReconciliationRequest) (map[string]any, error) {
 	gatewayConfig, err := validateGatewayConfig(rr)
 	if err != nil {
@@ -308,7 +493,6 @@ func getTemplateData(ctx context.Context, rr *odhtypes.ReconciliationRequest) (m
 		"AuthProxyCookieName":      AuthProxyCookieName,
 		"TLSCertsVolumeName":       TLSCertsVolumeName,
 		"TLSCertsMountPath":        TLSCertsMountPath,
-		"EnvoyFilter":              AuthnFilterName,
 		"RedirectURL":              fmt.Sprintf("https://%s/oauth2/callback", hostname),
 		"DestinationRuleName":      DestinationRuleName,
 		"CookieExpire":             cookieExpire,
@@ -323,6 +507,22 @@ func getTemplateData(ctx context.Context, rr *odhtypes.ReconciliationRequest) (m
 		"GatewayNameLabelKey":      labels.GatewayAPI.GatewayName,
 	}
 
+	// Every gateway gets the authn EnvoyFilter, the routes attached to an additional gateway are
+	// protected by kube-auth-proxy as the routes of the default one.
+	defaultGateway := newDefaultGateway(gatewayConfig)
+	authnGateways := []map[string]string{{"Name": defaultGateway.name, "FilterName": defaultGateway.authnFilterName()}}
+	for _, gw := range newAdditionalGateways(gatewayConfig) {
+		authnGateways = append(authnGateways, map[string]string{"Name": gw.name, "FilterName": gw.authnFilterName()})
+	}
+	templateData["AuthnGateways"] = authnGateways
+
+	// Label the kube-auth-proxy NetworkPolicy with its mode, in Audit mode it only selects opted-in pods
+	npMode := cluster.NetworkPolicyModeOf(gatewayConfig.Spec.NetworkPolicy)
+	templateData["NetworkPolicyModeLabelKey"] = labels.NetworkPolicy.Mode
+	templateData["NetworkPolicyMode"] = string(npMode)
+	templateData["NetworkPolicyAuditLabelKey"] = labels.NetworkPolicy.Audit
+	templateData["NetworkPolicyAudit"] = npMode == serviceApi.NetworkPolicyModeAudit
+
 	// Add OIDC-specific fields only if OIDC config is present
 	if gatewayConfig.Spec.OIDC != nil {
 		templateData["OIDCIssuerURL"] = gatewayConfig.Spec.OIDC.IssuerURL
@@ -351,7 +551,7 @@ func getTemplateData(ctx context.Context, rr *odhtypes.ReconciliationRequest) (m
 // from "ProvisioningSucceeded" and component-specific conditions like "GatewayConfigReady".
 func syncGatewayConfigStatus(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
 	// Use helper function for consistent validation
-	_, err := validateGatewayConfig(rr)
+	gatewayConfig, err := validateGatewayConfig(rr)
 	if err != nil {
 		return err
 	}
@@ -387,6 +587,23 @@ func syncGatewayConfigStatus(ctx context.Context, rr *odhtypes.ReconciliationReq
 		return nil
 	}
 
+	for _, gw := range newAdditionalGateways(gatewayConfig) {
+		additional := &gwapiv1.Gateway{}
+		err := rr.Client.Get(ctx, types.NamespacedName{Name: gw.name, Namespace: GatewayNamespace}, additional)
+		if client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to get Gateway %s: %w", gw.name, err)
+		}
+
+		if err != nil || !isGatewayReady(additional) {
+			rr.Conditions.MarkFalse(
+				ReadyConditionType,
+				conditions.WithReason(status.NotReadyReason),
+				conditions.WithMessage("%s: %s", status.GatewayNotReadyMessage, gw.name),
+			)
+			return nil
+		}
+	}
+
 	// Gateway is ready - mark GatewayConfigReady as true if not already set to false
 	// (e.g., if createKubeAuthProxyInfrastructure set it to false due to missing OIDC config)
 	existingCondition := rr.Conditions.GetCondition(ReadyConditionType)
@@ -0,0 +1,202 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+)
+
+// gatewayInstance describes a Gateway resource managed by the GatewayConfig controller,
+// either the default data-science-gateway or one declared in spec.additionalGateways.
+type gatewayInstance struct {
+	// specName is the name used in spec.additionalGateways, empty for the default gateway.
+	specName string
+	// name is the name of the Gateway resource.
+	name string
+
+	ingressMode   serviceApi.IngressMode
+	certificate   *infrav1.CertificateSpec
+	lifecycle     *serviceApi.CertificateLifecycleConfig
+	domain        string
+	subdomain     string
+	networkPolicy *serviceApi.NetworkPolicyConfig
+
+	// defaultCertSecretName is used when the certificate spec does not name a secret.
+	defaultCertSecretName string
+	// serviceTLSSecretName holds the service CA certificate used in OcpRoute mode.
+	serviceTLSSecretName string
+	// infraConfigMapName configures the Gateway service in OcpRoute mode.
+	infraConfigMapName string
+}
+
+// GatewayResourceName returns the name of the Gateway resource for a gateway declared in the
+// GatewayConfig. An empty name selects the default data-science-gateway.
+func GatewayResourceName(name string) string {
+	if name == "" {
+		return DefaultGatewayName
+	}
+	return DefaultGatewayName + "-" + name
+}
+
+// AttachHTTPRoutes moves the HTTPRoutes in resources that are attached to the default gateway
+// to the gateway declared in the GatewayConfig under name. An empty name keeps the default gateway.
+func AttachHTTPRoutes(resources []unstructured.Unstructured, name string) error {
+	if name == "" {
+		return nil
+	}
+
+	gatewayName := GatewayResourceName(name)
+
+	for i := range resources {
+		if resources[i].GroupVersionKind().GroupKind() != gvk.HTTPRoute.GroupKind() {
+			continue
+		}
+
+		parentRefs, found, err := unstructured.NestedSlice(resources[i].Object, "spec", "parentRefs")
+		if err != nil {
+			return fmt.Errorf("failed to get parentRefs of HTTPRoute %s: %w", resources[i].GetName(), err)
+		}
+		if !found {
+			continue
+		}
+
+		for j := range parentRefs {
+			ref, ok := parentRefs[j].(map[string]any)
+			if !ok || ref["name"] != DefaultGatewayName {
+				continue
+			}
+			if ns, ok := ref["namespace"]; ok && ns != GatewayNamespace {
+				continue
+			}
+
+			ref["name"] = gatewayName
+		}
+
+		if err := unstructured.SetNestedSlice(resources[i].Object, parentRefs, "spec", "parentRefs"); err != nil {
+			return fmt.Errorf("failed to set parentRefs of HTTPRoute %s: %w", resources[i].GetName(), err)
+		}
+	}
+
+	return nil
+}
+
+// newDefaultGateway returns the default data-science-gateway of the GatewayConfig.
+func newDefaultGateway(gatewayConfig *serviceApi.GatewayConfig) gatewayInstance {
+	return gatewayInstance{
+		name:                  DefaultGatewayName,
+		ingressMode:           gatewayConfig.Spec.IngressMode,
+		certificate:           gatewayConfig.Spec.Certificate,
+		lifecycle:             gatewayConfig.Spec.CertificateLifecycle,
+		domain:                gatewayConfig.Spec.Domain,
+		subdomain:             gatewayConfig.Spec.Subdomain,
+		networkPolicy:         gatewayConfig.Spec.NetworkPolicy,
+		defaultCertSecretName: fmt.Sprintf("%s-tls", gatewayConfig.Name),
+		serviceTLSSecretName:  GatewayServiceTLSSecretName,
+		infraConfigMapName:    GatewayInfraConfigMapName,
+	}
+}
+
+// newAdditionalGateways returns the gateways declared in spec.additionalGateways.
+func newAdditionalGateways(gatewayConfig *serviceApi.GatewayConfig) []gatewayInstance {
+	gateways := make([]gatewayInstance, 0, len(gatewayConfig.Spec.AdditionalGateways))
+
+	for _, spec := range gatewayConfig.Spec.AdditionalGateways {
+		name := GatewayResourceName(spec.Name)
+
+		ingressMode := spec.IngressMode
+		if ingressMode == "" {
+			ingressMode = serviceApi.IngressModeOcpRoute
+		}
+
+		gateways = append(gateways, gatewayInstance{
+			specName:              spec.Name,
+			name:                  name,
+			ingressMode:           ingressMode,
+			certificate:           spec.Certificate,
+			lifecycle:             spec.CertificateLifecycle,
+			domain:                spec.Domain,
+			subdomain:             spec.Subdomain,
+			networkPolicy:         spec.NetworkPolicy,
+			defaultCertSecretName: fmt.Sprintf("%s-%s-tls", gatewayConfig.Name, spec.Name),
+			serviceTLSSecretName:  name + "-service-tls",
+			infraConfigMapName:    name + "-config",
+		})
+	}
+
+	return gateways
+}
+
+// fqdn returns the host name served by the gateway.
+func (gw *gatewayInstance) fqdn(ctx context.Context, cli client.Client) (string, error) {
+	subdomain := strings.TrimSpace(gw.subdomain)
+	if subdomain == "" {
+		subdomain = gw.name
+	}
+
+	baseDomain := strings.TrimSpace(gw.domain)
+	if baseDomain != "" {
+		return fmt.Sprintf("%s.%s", subdomain, baseDomain), nil
+	}
+
+	clusterDomain, err := cluster.GetDomain(ctx, cli)
+	if err != nil {
+		return "", fmt.Errorf("failed to get cluster domain: %w", err)
+	}
+
+	return fmt.Sprintf("%s.%s", subdomain, clusterDomain), nil
+}
+
+// certSecretName returns the name of the secret holding the gateway certificate.
+func (gw *gatewayInstance) certSecretName() string {
+	if gw.certificate != nil && gw.certificate.SecretName != "" {
+		return gw.certificate.SecretName
+	}
+	return gw.defaultCertSecretName
+}
+
+// serviceName returns the name of the Service created for the Gateway by the gateway controller.
+// Format: <gateway-name>-<gatewayclass-name>.
+func (gw *gatewayInstance) serviceName() string {
+	return gw.name + "-" + GatewayClassName
+}
+
+// authnFilterName returns the name of the EnvoyFilter delegating the authentication of the
+// requests served by the gateway to kube-auth-proxy.
+func (gw *gatewayInstance) authnFilterName() string {
+	if gw.specName == "" {
+		return AuthnFilterName
+	}
+	return AuthnFilterName + "-" + gw.specName
+}
+
+// networkPolicyEnabled returns true unless ingress rules are explicitly disabled.
+func (gw *gatewayInstance) networkPolicyEnabled() bool {
+	if gw.networkPolicy != nil && gw.networkPolicy.Ingress != nil {
+		return gw.networkPolicy.Ingress.Enabled
+	}
+	return true
+}
+
+// ingressPeers returns the additional peers allowed to reach the gateway.
+func (gw *gatewayInstance) ingressPeers() []serviceApi.NetworkPolicyPeer {
+	if gw.networkPolicy == nil || gw.networkPolicy.Ingress == nil {
+		return nil
+	}
+	return gw.networkPolicy.Ingress.AdditionalPeers
+}
+
+// egressConfig returns the egress restrictions of the gateway, nil when not configured.
+func (gw *gatewayInstance) egressConfig() *serviceApi.EgressPolicyConfig {
+	if gw.networkPolicy == nil {
+		return nil
+	}
+	return gw.networkPolicy.Egress
+}
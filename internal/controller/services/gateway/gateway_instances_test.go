@@ -0,0 +1,270 @@
+//nolint:testpackage
+package gateway
+
+import (
+	"testing"
+
+	routev1 "github.com/openshift/api/route/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/api/infrastructure/v1"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	mt "github.com/opendatahub-io/opendatahub-operator/v2/pkg/manifests/template"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+
+	. "github.com/onsi/gomega"
+)
+
+// TestNewAdditionalGateways tests the names of the resources created for additional gateways.
+func TestNewAdditionalGateways(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gatewayConfig := &serviceApi.GatewayConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: serviceApi.GatewayConfigName},
+		Spec: serviceApi.GatewayConfigSpec{
+			IngressMode: serviceApi.IngressModeLoadBalancer,
+			AdditionalGateways: []serviceApi.AdditionalGatewaySpec{
+				{Name: "internal"},
+				{
+					Name:        "public",
+					IngressMode: serviceApi.IngressModeLoadBalancer,
+					Certificate: &infrav1.CertificateSpec{Type: infrav1.Provided, SecretName: "public-cert"},
+				},
+			},
+		},
+	}
+
+	defaultGateway := newDefaultGateway(gatewayConfig)
+	g.Expect(defaultGateway.name).To(Equal(DefaultGatewayName))
+	g.Expect(defaultGateway.certSecretName()).To(Equal(serviceApi.GatewayConfigName + "-tls"))
+	g.Expect(defaultGateway.serviceName()).To(Equal(GatewayServiceFullName))
+
+	gateways := newAdditionalGateways(gatewayConfig)
+	g.Expect(gateways).To(HaveLen(2))
+
+	g.Expect(gateways[0].name).To(Equal("data-science-gateway-internal"))
+	g.Expect(gateways[0].ingressMode).To(Equal(serviceApi.IngressModeOcpRoute))
+	g.Expect(gateways[0].certSecretName()).To(Equal(serviceApi.GatewayConfigName + "-internal-tls"))
+	g.Expect(gateways[0].serviceTLSSecretName).To(Equal("data-science-gateway-internal-service-tls"))
+	g.Expect(gateways[0].infraConfigMapName).To(Equal("data-science-gateway-internal-config"))
+	g.Expect(gateways[0].networkPolicyEnabled()).To(BeTrue())
+
+	g.Expect(gateways[1].ingressMode).To(Equal(serviceApi.IngressModeLoadBalancer))
+	g.Expect(gateways[1].certSecretName()).To(Equal("public-cert"))
+}
+
+// TestGatewayInstanceFQDN tests the host name of additional gateways.
+func TestGatewayInstanceFQDN(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	cli := setupTestClient().Build()
+
+	gw := gatewayInstance{name: GatewayResourceName("internal"), domain: "example.com"}
+	hostname, err := gw.fqdn(ctx, cli)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(hostname).To(Equal("data-science-gateway-internal.example.com"))
+
+	gw.subdomain = "models"
+	gw.domain = "internal.example.com"
+	hostname, err = gw.fqdn(ctx, cli)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(hostname).To(Equal("models.internal.example.com"))
+
+	gw.domain = ""
+	_, err = gw.fqdn(ctx, cli)
+	g.Expect(err).To(HaveOccurred())
+}
+
+// TestAttachHTTPRoutes tests that HTTPRoutes attached to the default gateway are moved to the selected gateway.
+func TestAttachHTTPRoutes(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	newRoute := func(parentRefs ...any) unstructured.Unstructured {
+		route := unstructured.Unstructured{}
+		route.SetGroupVersionKind(gvk.HTTPRoute)
+		route.SetName("dashboard")
+		_ = unstructured.SetNestedSlice(route.Object, parentRefs, "spec", "parentRefs")
+		return route
+	}
+
+	resources := []unstructured.Unstructured{
+		newRoute(
+			map[string]any{"name": DefaultGatewayName, "namespace": GatewayNamespace},
+			map[string]any{"name": "other-gateway", "namespace": GatewayNamespace},
+		),
+	}
+
+	g.Expect(AttachHTTPRoutes(resources, "")).To(Succeed())
+	parentRefs, _, _ := unstructured.NestedSlice(resources[0].Object, "spec", "parentRefs")
+	g.Expect(parentRefs[0]).To(HaveKeyWithValue("name", DefaultGatewayName))
+
+	g.Expect(AttachHTTPRoutes(resources, "internal")).To(Succeed())
+	parentRefs, _, _ = unstructured.NestedSlice(resources[0].Object, "spec", "parentRefs")
+	g.Expect(parentRefs[0]).To(HaveKeyWithValue("name", "data-science-gateway-internal"))
+	g.Expect(parentRefs[1]).To(HaveKeyWithValue("name", "other-gateway"))
+}
+
+// TestNewGatewayRoute tests the Route exposing an additional gateway in OcpRoute mode.
+func TestNewGatewayRoute(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gw := gatewayInstance{name: GatewayResourceName("internal")}
+	route := newGatewayRoute(&gw, "internal.example.com")
+
+	g.Expect(route.Name).To(Equal("data-science-gateway-internal"))
+	g.Expect(route.Namespace).To(Equal(GatewayNamespace))
+	g.Expect(route.Spec.Host).To(Equal("internal.example.com"))
+	g.Expect(route.Spec.To.Name).To(Equal("data-science-gateway-internal-" + GatewayClassName))
+	g.Expect(route.Spec.TLS.Termination).To(Equal(routev1.TLSTerminationReencrypt))
+	g.Expect(route.Labels).To(HaveKeyWithValue(labels.K8SCommon.PartOf, PartOfGatewayConfig))
+}
+
+// TestNewGatewayNetworkPolicy tests the NetworkPolicy of an additional gateway.
+func TestNewGatewayNetworkPolicy(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gw := gatewayInstance{name: GatewayResourceName("internal")}
+	np := newGatewayNetworkPolicy(&gw)
+
+	g.Expect(np.Name).To(Equal("data-science-gateway-internal"))
+	g.Expect(np.Spec.PodSelector.MatchLabels).To(HaveKeyWithValue(labels.GatewayAPI.GatewayName, "data-science-gateway-internal"))
+	g.Expect(np.Spec.Ingress).To(HaveLen(2))
+	g.Expect(np.Spec.Ingress[0].From).To(BeEmpty())
+	g.Expect(np.Spec.Ingress[0].Ports).To(HaveLen(2))
+	g.Expect(np.Spec.Ingress[1].From).To(HaveLen(2))
+	g.Expect(np.Spec.Ingress[1].Ports[0].Port.IntValue()).To(Equal(GatewayMetricsPort))
+
+	gw.networkPolicy = &serviceApi.NetworkPolicyConfig{Ingress: &serviceApi.IngressPolicyConfig{Enabled: false}}
+	g.Expect(gw.networkPolicyEnabled()).To(BeFalse())
+}
+
+// TestGetNamedGatewayDomain tests the domain lookup of additional gateways.
+func TestGetNamedGatewayDomain(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	gatewayConfig := &serviceApi.GatewayConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: serviceApi.GatewayConfigName},
+		Spec: serviceApi.GatewayConfigSpec{
+			Domain: "example.com",
+			AdditionalGateways: []serviceApi.AdditionalGatewaySpec{
+				{Name: "internal", Domain: "internal.example.com"},
+			},
+		},
+	}
+	cli := setupTestClient().WithObjects(gatewayConfig).Build()
+
+	domain, err := GetNamedGatewayDomain(ctx, cli, "internal")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(domain).To(Equal("data-science-gateway-internal.internal.example.com"))
+
+	domain, err = GetNamedGatewayDomain(ctx, cli, "")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(domain).To(Equal("data-science-gateway.example.com"))
+
+	_, err = GetNamedGatewayDomain(ctx, cli, "unknown")
+	g.Expect(err).To(MatchError(ErrUnknownGateway))
+
+	g.Expect(ValidateGatewayName(ctx, cli, "")).To(Succeed())
+	g.Expect(ValidateGatewayName(ctx, cli, "internal")).To(Succeed())
+	g.Expect(ValidateGatewayName(ctx, cli, "unknown")).To(MatchError(ErrUnknownGateway))
+	g.Expect(ValidateGatewayName(ctx, setupTestClient().Build(), "internal")).To(MatchError(ErrUnknownGateway))
+}
+
+// TestCreateNetworkPolicyAuditMode tests the additional and egress NetworkPolicies and the generated policies status.
+func TestCreateNetworkPolicyAuditMode(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gatewayConfig := &serviceApi.GatewayConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: serviceApi.GatewayConfigName},
+		Spec: serviceApi.GatewayConfigSpec{
+			NetworkPolicy: &serviceApi.NetworkPolicyConfig{
+				Mode: serviceApi.NetworkPolicyModeAudit,
+				Ingress: &serviceApi.IngressPolicyConfig{
+					Enabled:         true,
+					AdditionalPeers: []serviceApi.NetworkPolicyPeer{{CIDR: "10.0.0.0/8"}},
+				},
+				Egress: &serviceApi.EgressPolicyConfig{Enabled: true},
+			},
+		},
+	}
+	apiServer := metav1.ObjectMeta{Name: "kubernetes", Namespace: metav1.NamespaceDefault}
+	cli := setupTestClient().WithObjects(
+		&corev1.Service{ObjectMeta: apiServer, Spec: corev1.ServiceSpec{ClusterIPs: []string{"172.30.0.1"}}},
+		&corev1.Endpoints{ObjectMeta: apiServer, Subsets: []corev1.EndpointSubset{{Addresses: []corev1.EndpointAddress{{IP: "10.0.0.1"}}}}},
+	).Build()
+	rr := &odhtypes.ReconciliationRequest{Client: cli, Instance: gatewayConfig}
+
+	g.Expect(createNetworkPolicy(t.Context(), rr)).To(Succeed())
+	g.Expect(rr.Templates).To(HaveLen(1))
+
+	names := make([]string, 0, len(gatewayConfig.Status.NetworkPolicies))
+	for _, np := range gatewayConfig.Status.NetworkPolicies {
+		g.Expect(np.Mode).To(Equal(serviceApi.NetworkPolicyModeAudit))
+		names = append(names, np.Name)
+	}
+	g.Expect(names).To(ConsistOf(
+		"data-science-gateway-egress",
+		"kube-auth-proxy-egress",
+		"kube-auth-proxy",
+		"kube-auth-proxy-additional-ingress",
+	))
+
+	for _, res := range rr.Resources {
+		g.Expect(res.GetLabels()).To(HaveKeyWithValue(labels.NetworkPolicy.Mode, string(serviceApi.NetworkPolicyModeAudit)))
+		matchLabels, _, _ := unstructured.NestedStringMap(res.Object, "spec", "podSelector", "matchLabels")
+		g.Expect(matchLabels).To(HaveKeyWithValue(labels.NetworkPolicy.Audit, labels.True))
+
+		// the egress rules are all restricted to peers
+		egress, _, _ := unstructured.NestedSlice(res.Object, "spec", "egress")
+		for _, rule := range egress {
+			g.Expect(rule).To(HaveKeyWithValue("to", Not(BeEmpty())))
+		}
+	}
+}
+
+// TestEnvoyFilterAdditionalGateways tests that the routes of every gateway are protected by kube-auth-proxy.
+func TestEnvoyFilterAdditionalGateways(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gatewayConfig := &serviceApi.GatewayConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: serviceApi.GatewayConfigName},
+		Spec: serviceApi.GatewayConfigSpec{
+			Domain:             "example.com",
+			AdditionalGateways: []serviceApi.AdditionalGatewaySpec{{Name: "internal"}},
+		},
+	}
+	cli := setupTestClient().Build()
+	rr := &odhtypes.ReconciliationRequest{Client: cli, Instance: gatewayConfig}
+
+	data, err := getTemplateData(t.Context(), rr)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	filters, err := mt.Render(serializer.NewCodecFactory(cli.Scheme()).UniversalDeserializer(), gatewayResources, envoyFilterTemplate, data)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(filters).To(HaveLen(2))
+
+	selected := make(map[string]string, len(filters))
+	for _, filter := range filters {
+		workloadLabels, _, _ := unstructured.NestedStringMap(filter.Object, "spec", "workloadSelector", "labels")
+		selected[filter.GetName()] = workloadLabels[labels.GatewayAPI.GatewayName]
+	}
+	g.Expect(selected).To(Equal(map[string]string{
+		AuthnFilterName:               DefaultGatewayName,
+		AuthnFilterName + "-internal": "data-science-gateway-internal",
+	}))
+}
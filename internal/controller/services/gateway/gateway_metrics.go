@@ -0,0 +1,32 @@
+package gateway
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	// CertificateExpiryTimestamp is a prometheus gauge metrics which holds the expiry
+	// time, in seconds since epoch, of the certificate served by the gateway.
+	// It has two labels.
+	// gateway label refers to the name of the Gateway resource.
+	// secret label refers to the name of the certificate secret.
+	CertificateExpiryTimestamp = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "gateway_certificate_expiry_timestamp_seconds",
+			Help: "Expiry time of the gateway TLS certificate in seconds since epoch",
+		},
+		[]string{
+			"gateway",
+			"secret",
+		},
+	)
+)
+
+// init register metrics to the global registry from controller-runtime/pkg/metrics.
+// see https://book.kubebuilder.io/reference/metrics#publishing-additional-metrics
+//
+//nolint:gochecknoinits
+func init() {
+	metrics.Registry.MustRegister(CertificateExpiryTimestamp)
+}
@@ -54,6 +54,8 @@ const (
 	AuthProxyMetricsPort = 9000
 	StandardHTTPSPort    = 443
 	GatewayHTTPSPort     = 8443
+	GatewayHealthPort    = 15021 // Envoy readiness port of gateway pods
+	GatewayMetricsPort   = 15090 // Envoy prometheus port of gateway pods
 
 	AuthProxyOAuth2Path = "/oauth2"
 	// OAuth2 proxy cookie name - used in both proxy args and EnvoyFilter Lua filter.
@@ -94,6 +96,16 @@ const (
 	ocpRouteTemplate                     = "resources/gateway-ocp-route.tmpl.yaml"
 )
 
+// ErrUnknownGateway is returned when a gateway is not declared in the GatewayConfig additionalGateways.
+var ErrUnknownGateway = errors.New("gateway is not declared in the GatewayConfig additionalGateways")
+
+const (
+	openshiftAuthenticationNamespace = "openshift-authentication"
+)
+
+// gatewayMonitoringNamespaces are the namespaces scraping the gateway and kube-auth-proxy metrics.
+var gatewayMonitoringNamespaces = []string{"openshift-monitoring", "openshift-user-workload-monitoring"}
+
 // GetFQDN returns the fully qualified domain name for the gateway based on the GatewayConfig.
 // It constructs the FQDN by combining the subdomain (or default) with either the user-specified
 // domain or the cluster domain.
@@ -123,10 +135,26 @@ func GetFQDN(ctx context.Context, cli client.Client, gatewayConfig *serviceApi.G
 // GetGatewayDomain reads the domain directly from the Gateway CR's listener hostname.
 // Falls back to GatewayConfig if Gateway CR doesn't exist yet.
 func GetGatewayDomain(ctx context.Context, cli client.Client) (string, error) {
+	return GetNamedGatewayDomain(ctx, cli, "")
+}
+
+// GetNamedGatewayDomain returns the domain of a gateway declared in spec.additionalGateways
+// of the GatewayConfig, an empty name selects the default gateway. An error wrapping
+// ErrUnknownGateway is returned when the additional gateway is not declared.
+func GetNamedGatewayDomain(ctx context.Context, cli client.Client, name string) (string, error) {
+	var additional *gatewayInstance
+	if name != "" {
+		gw, err := lookupAdditionalGateway(ctx, cli, name)
+		if err != nil {
+			return "", err
+		}
+		additional = gw
+	}
+
 	// Try to get the Gateway CR first
 	gateway := &gwapiv1.Gateway{}
 	err := cli.Get(ctx, client.ObjectKey{
-		Name:      DefaultGatewayName,
+		Name:      GatewayResourceName(name),
 		Namespace: GatewayNamespace,
 	}, gateway)
 	if err == nil {
@@ -135,6 +163,10 @@ func GetGatewayDomain(ctx context.Context, cli client.Client) (string, error) {
 		}
 	}
 
+	if additional != nil {
+		return additional.fqdn(ctx, cli)
+	}
+
 	gatewayConfig := &serviceApi.GatewayConfig{}
 	err = cli.Get(ctx, client.ObjectKey{Name: serviceApi.GatewayConfigName}, gatewayConfig)
 	if err != nil {
@@ -145,6 +177,35 @@ func GetGatewayDomain(ctx context.Context, cli client.Client) (string, error) {
 	return GetFQDN(ctx, cli, gatewayConfig)
 }
 
+// ValidateGatewayName returns an error wrapping ErrUnknownGateway when name is not declared in
+// spec.additionalGateways of the GatewayConfig. An empty name selects the default gateway.
+func ValidateGatewayName(ctx context.Context, cli client.Client, name string) error {
+	if name == "" {
+		return nil
+	}
+
+	_, err := lookupAdditionalGateway(ctx, cli, name)
+	return err
+}
+
+func lookupAdditionalGateway(ctx context.Context, cli client.Client, name string) (*gatewayInstance, error) {
+	gatewayConfig := &serviceApi.GatewayConfig{}
+	if err := cli.Get(ctx, client.ObjectKey{Name: serviceApi.GatewayConfigName}, gatewayConfig); err != nil {
+		if k8serr.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownGateway, name)
+		}
+		return nil, fmt.Errorf("failed to get GatewayConfig: %w", err)
+	}
+
+	for _, gw := range newAdditionalGateways(gatewayConfig) {
+		if gw.specName == name {
+			return &gw, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrUnknownGateway, name)
+}
+
 // This helper function optimizes the condition checking logic.
 func isGatewayReady(gateway *gwapiv1.Gateway) bool {
 	if gateway == nil {
@@ -169,48 +230,26 @@ func getCertificateType(gatewayConfig *serviceApi.GatewayConfig) string {
 	return string(gatewayConfig.Spec.Certificate.Type)
 }
 
-func handleCertificates(ctx context.Context, rr *odhtypes.ReconciliationRequest, gatewayConfig *serviceApi.GatewayConfig, domain string) (string, error) {
-	var certConfig infrav1.CertificateSpec
-	if gatewayConfig.Spec.Certificate != nil {
-		certConfig = *gatewayConfig.Spec.Certificate
-	}
-
-	if certConfig.Type == "" {
-		certConfig.Type = infrav1.OpenshiftDefaultIngress
+// handleCertificates provisions the certificate of a gateway through the issuer selected by
+// its configuration and returns the name of the certificate secret and of the issuer.
+func handleCertificates(
+	ctx context.Context,
+	rr *odhtypes.ReconciliationRequest,
+	gatewayConfig *serviceApi.GatewayConfig,
+	gw *gatewayInstance,
+	hostname string,
+) (string, string, error) {
+	issuer, err := newCertificateIssuer(ctx, rr, gw)
+	if err != nil {
+		return "", "", err
 	}
 
-	secretName := certConfig.SecretName
-	if secretName == "" {
-		secretName = fmt.Sprintf("%s-tls", gatewayConfig.Name)
+	secretName, err := issuer.issue(ctx, rr, gatewayConfig, hostname)
+	if err != nil {
+		return "", "", err
 	}
 
-	switch certConfig.Type {
-	case infrav1.OpenshiftDefaultIngress:
-		if err := cluster.PropagateDefaultIngressCertificate(ctx, rr.Client, secretName, GatewayNamespace,
-			cluster.WithLabels( // add label easy to know it is from us.
-				labels.PlatformPartOf, ServiceName,
-			),
-			cluster.OwnedBy(gatewayConfig, rr.Client.Scheme()), // set ownerreference for cleanup
-		); err != nil {
-			return "", fmt.Errorf("failed to propagate default ingress certificate: %w", err)
-		}
-		return secretName, nil
-	case infrav1.SelfSigned:
-		hostname := fmt.Sprintf("%s.%s", DefaultGatewayName, domain)
-		if err := cluster.CreateSelfSignedCertificate(ctx, rr.Client, secretName, hostname, GatewayNamespace,
-			cluster.WithLabels( // add label easy to know it is from us.
-				labels.PlatformPartOf, ServiceName,
-			),
-			cluster.OwnedBy(gatewayConfig, rr.Client.Scheme()), // set ownerreference for cleanup
-		); err != nil {
-			return "", fmt.Errorf("failed to create self-signed certificate: %w", err)
-		}
-		return secretName, nil
-	case infrav1.Provided:
-		return secretName, nil
-	default:
-		return "", fmt.Errorf("unsupported certificate type: %s", certConfig.Type)
-	}
+	return secretName, issuer.name(), nil
 }
 
 func createGatewayClass(rr *odhtypes.ReconciliationRequest) error {
@@ -226,7 +265,7 @@ func createGatewayClass(rr *odhtypes.ReconciliationRequest) error {
 	return rr.AddResources(gatewayClass)
 }
 
-func createGateway(rr *odhtypes.ReconciliationRequest, certSecretName string, domain string, ingressMode serviceApi.IngressMode) error {
+func createGateway(rr *odhtypes.ReconciliationRequest, gw *gatewayInstance, certSecretName string, domain string) error {
 	listeners := []gwapiv1.Listener{}
 
 	if certSecretName != "" {
@@ -266,7 +305,7 @@ func createGateway(rr *odhtypes.ReconciliationRequest, certSecretName string, do
 			},
 		}
 
-		if ingressMode != serviceApi.IngressModeOcpRoute {
+		if gw.ingressMode != serviceApi.IngressModeOcpRoute {
 			hostname := gwapiv1.Hostname(domain)
 			httpsListener.Hostname = &hostname
 		}
@@ -276,7 +315,7 @@ func createGateway(rr *odhtypes.ReconciliationRequest, certSecretName string, do
 
 	gateway := &gwapiv1.Gateway{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      DefaultGatewayName,
+			Name:      gw.name,
 			Namespace: GatewayNamespace,
 			Labels: map[string]string{
 				IstioRevisionLabel: IstioRevisionValue,
@@ -288,8 +327,8 @@ func createGateway(rr *odhtypes.ReconciliationRequest, certSecretName string, do
 		},
 	}
 
-	if ingressMode == serviceApi.IngressModeOcpRoute {
-		if err := configureClusterIPInfrastructure(rr, gateway); err != nil {
+	if gw.ingressMode == serviceApi.IngressModeOcpRoute {
+		if err := configureClusterIPInfrastructure(rr, gw, gateway); err != nil {
 			return err
 		}
 	}
@@ -299,17 +338,17 @@ func createGateway(rr *odhtypes.ReconciliationRequest, certSecretName string, do
 
 // configureClusterIPInfrastructure creates a ConfigMap for ClusterIP service configuration
 // and sets the Gateway's infrastructure reference.
-func configureClusterIPInfrastructure(rr *odhtypes.ReconciliationRequest, gateway *gwapiv1.Gateway) error {
+func configureClusterIPInfrastructure(rr *odhtypes.ReconciliationRequest, gw *gatewayInstance, gateway *gwapiv1.Gateway) error {
 	serviceConfig := fmt.Sprintf(`metadata:
   annotations:
     service.beta.openshift.io/serving-cert-secret-name: "%s"
 spec:
   type: ClusterIP
-`, GatewayServiceTLSSecretName)
+`, gw.serviceTLSSecretName)
 
 	infraConfigMap := &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      GatewayInfraConfigMapName,
+			Name:      gw.infraConfigMapName,
 			Namespace: GatewayNamespace,
 			Labels: map[string]string{
 				labels.PlatformPartOf: PartOfGatewayConfig,
@@ -327,7 +366,7 @@ spec:
 		ParametersRef: &gwapiv1.LocalParametersReference{
 			Group: "",
 			Kind:  "ConfigMap",
-			Name:  GatewayInfraConfigMapName,
+			Name:  gw.infraConfigMapName,
 		},
 	}
 
@@ -592,12 +631,12 @@ func detectAndSetIngressMode(ctx context.Context, rr *odhtypes.ReconciliationReq
 
 // reconcileGatewayForModeChange deletes the Gateway if its configuration doesn't match
 // the desired ingress mode. SSA won't remove fields like hostname, so we force recreation.
-func reconcileGatewayForModeChange(ctx context.Context, rr *odhtypes.ReconciliationRequest, desiredMode serviceApi.IngressMode) error {
+func reconcileGatewayForModeChange(ctx context.Context, rr *odhtypes.ReconciliationRequest, gatewayName string, desiredMode serviceApi.IngressMode) error {
 	l := logf.FromContext(ctx).WithName("reconcileGatewayForModeChange")
 
 	gateway := &gwapiv1.Gateway{}
 	err := rr.Client.Get(ctx, client.ObjectKey{
-		Name:      DefaultGatewayName,
+		Name:      gatewayName,
 		Namespace: GatewayNamespace,
 	}, gateway)
 
@@ -626,7 +665,7 @@ func reconcileGatewayForModeChange(ctx context.Context, rr *odhtypes.Reconciliat
 		return nil
 	}
 
-	l.Info("Deleting Gateway for ingress mode change", "desiredMode", desiredMode)
+	l.Info("Deleting Gateway for ingress mode change", "gateway", gatewayName, "desiredMode", desiredMode)
 	if err := rr.Client.Delete(ctx, gateway); err != nil {
 		return fmt.Errorf("failed to delete Gateway: %w", err)
 	}
@@ -20,18 +20,25 @@ package gateway
 
 import (
 	"context"
+	"fmt"
 
+	routev1 "github.com/openshift/api/route/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
 	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 )
 
 // GatewayServiceFullName is the name of the auto-created Gateway service.
 // Format: <gateway-name>-<gatewayclass-name>.
 var GatewayServiceFullName = DefaultGatewayName + "-" + GatewayClassName
 
-// createOCPRoutes adds OCP Route template when in OcpRoute mode.
+// createOCPRoutes adds OCP Route template when in OcpRoute mode, and a Route for each
+// additional gateway in OcpRoute mode.
 func createOCPRoutes(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
 	l := logf.FromContext(ctx).WithName("createOCPRoutes")
 
@@ -42,15 +49,67 @@ func createOCPRoutes(ctx context.Context, rr *odhtypes.ReconciliationRequest) er
 
 	if gatewayConfig.Spec.IngressMode != serviceApi.IngressModeOcpRoute {
 		l.V(1).Info("IngressMode is not OcpRoute, skipping OCP Route creation")
-		return nil
+	} else {
+		l.V(1).Info("Adding OCP Route template for Gateway")
+
+		rr.Templates = append(rr.Templates, odhtypes.TemplateInfo{
+			FS:   gatewayResources,
+			Path: ocpRouteTemplate,
+		})
 	}
 
-	l.V(1).Info("Adding OCP Route template for Gateway")
+	for _, gw := range newAdditionalGateways(gatewayConfig) {
+		if gw.ingressMode != serviceApi.IngressModeOcpRoute {
+			continue
+		}
+
+		hostname, err := gw.fqdn(ctx, rr.Client)
+		if err != nil {
+			return fmt.Errorf("failed to resolve domain for gateway %s: %w", gw.name, err)
+		}
 
-	rr.Templates = append(rr.Templates, odhtypes.TemplateInfo{
-		FS:   gatewayResources,
-		Path: ocpRouteTemplate,
-	})
+		l.V(1).Info("Adding OCP Route for additional Gateway", "gateway", gw.name)
+
+		if err := rr.AddResources(newGatewayRoute(&gw, hostname)); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
+
+// newGatewayRoute returns the Route exposing a gateway in OcpRoute mode, it matches
+// the Route rendered from the ocp route template for the default gateway.
+func newGatewayRoute(gw *gatewayInstance, hostname string) *routev1.Route {
+	return &routev1.Route{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: routev1.GroupVersion.String(),
+			Kind:       "Route",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      gw.name,
+			Namespace: GatewayNamespace,
+			Labels: map[string]string{
+				labels.K8SCommon.PartOf: PartOfGatewayConfig,
+			},
+			Annotations: map[string]string{
+				"router.openshift.io/service-ca-certificate": "true",
+			},
+		},
+		Spec: routev1.RouteSpec{
+			Host: hostname,
+			To: routev1.RouteTargetReference{
+				Kind:   "Service",
+				Name:   gw.serviceName(),
+				Weight: ptr.To[int32](100),
+			},
+			Port: &routev1.RoutePort{
+				TargetPort: intstr.FromInt32(StandardHTTPSPort),
+			},
+			TLS: &routev1.TLSConfig{
+				Termination:                   routev1.TLSTerminationReencrypt,
+				InsecureEdgeTerminationPolicy: routev1.InsecureEdgeTerminationPolicyRedirect,
+			},
+		},
+	}
+}
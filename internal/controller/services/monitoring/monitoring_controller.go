@@ -30,6 +30,7 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v2"
@@ -139,6 +140,36 @@ func (h *serviceHandler) NewReconciler(ctx context.Context, mgr ctrl.Manager) er
 			reconciler.WithEventHandler(
 				handlers.ToNamed(serviceApi.MonitoringInstanceName)),
 		).
+		// Watch Namespaces and workloads to update the namespaces selected by the instrumentation
+		// policies, only once a policy needs them
+		WatchesGVK(gvk.Namespace,
+			reconciler.WithEventHandler(handlers.ToNamed(serviceApi.MonitoringInstanceName)),
+			reconciler.WithPredicates(predicate.LabelChangedPredicate{}),
+			reconciler.Dynamic(hasInstrumentationPolicies())).
+		WatchesGVK(gvk.Notebook,
+			reconciler.WithEventHandler(handlers.ToNamed(serviceApi.MonitoringInstanceName)),
+			reconciler.WithPredicates(resources.CreatedOrDeleted()),
+			reconciler.Dynamic(
+				hasInstrumentationPolicies(serviceApi.WorkloadKindNotebook),
+				reconciler.CrdExists(gvk.Notebook))).
+		WatchesGVK(gvk.InferenceServices,
+			reconciler.WithEventHandler(handlers.ToNamed(serviceApi.MonitoringInstanceName)),
+			reconciler.WithPredicates(resources.CreatedOrDeleted()),
+			reconciler.Dynamic(
+				hasInstrumentationPolicies(serviceApi.WorkloadKindInferenceService),
+				reconciler.CrdExists(gvk.InferenceServices))).
+		WatchesGVK(gvk.RayClusterV1,
+			reconciler.WithEventHandler(handlers.ToNamed(serviceApi.MonitoringInstanceName)),
+			reconciler.WithPredicates(resources.CreatedOrDeleted()),
+			reconciler.Dynamic(
+				hasInstrumentationPolicies(serviceApi.WorkloadKindRayCluster),
+				reconciler.CrdExists(gvk.RayClusterV1))).
+		WatchesGVK(gvk.ArgoWorkflow,
+			reconciler.WithEventHandler(handlers.ToNamed(serviceApi.MonitoringInstanceName)),
+			reconciler.WithPredicates(resources.CreatedOrDeleted()),
+			reconciler.Dynamic(
+				hasInstrumentationPolicies(serviceApi.WorkloadKindPipelineRun),
+				reconciler.CrdExists(gvk.ArgoWorkflow))).
 		// Watch ConfigMaps for CA rotation sync (specifically prometheus-web-tls-ca)
 		Watches(
 			&corev1.ConfigMap{},
@@ -152,11 +183,14 @@ func (h *serviceHandler) NewReconciler(ctx context.Context, mgr ctrl.Manager) er
 		WithAction(addMonitoringCapability).
 		WithAction(deployMonitoringStackWithQuerierAndRestrictions).
 		WithAction(deployTracingStack).
+		WithAction(deployInstrumentationPolicies).
 		WithAction(deployAlerting).
+		WithAction(deployServiceLevelObjectives).
 		WithAction(deployOpenTelemetryCollector).
 		WithAction(deployPerses).
 		WithAction(deployPersesTempoIntegration).
 		WithAction(deployPersesPrometheusIntegration).
+		WithAction(deployPersesDashboards).
 		WithAction(deployNodeMetricsEndpoint).
 		WithAction(template.NewAction(
 			template.WithDataFn(getTemplateData),
@@ -164,6 +198,8 @@ func (h *serviceHandler) NewReconciler(ctx context.Context, mgr ctrl.Manager) er
 		WithAction(deploy.NewAction(
 			deploy.WithCache(),
 		)).
+		WithAction(updateBackendsStatus).
+		WithAction(updateServiceLevelObjectivesStatus).
 		// Sync CA from ConfigMap to Secret (handles initial creation and rotation updates)
 		WithAction(syncPrometheusWebTLSCA).
 		WithAction(gc.NewAction()).
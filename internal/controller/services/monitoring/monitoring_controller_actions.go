@@ -282,9 +282,9 @@ func deployOpenTelemetryCollector(ctx context.Context, rr *odhtypes.Reconciliati
 		return errors.New("instance is not of type *services.Monitoring")
 	}
 
-	// Read metrics and traces configuration directly from Monitoring CR
-	if monitoring.Spec.Metrics == nil && monitoring.Spec.Traces == nil {
-		// No metrics and traces configuration - skip OpenTelemetry collector deployment
+	// Read metrics, traces and logs configuration directly from Monitoring CR
+	if monitoring.Spec.Metrics == nil && monitoring.Spec.Traces == nil && monitoring.Spec.Logs == nil {
+		// No metrics, traces and logs configuration - skip OpenTelemetry collector deployment
 		rr.Conditions.MarkFalse(
 			status.ConditionOpenTelemetryCollectorAvailable,
 			conditions.WithReason(status.MetricsNotConfiguredReason+"And"+status.TracesNotConfiguredReason),
@@ -0,0 +1,234 @@
+package monitoring
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	componentMonitoring "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components"
+	cr "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/registry"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/common"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+)
+
+const (
+	// PersesPlatformOverviewDashboardName is the name of the dashboard summarizing the active components.
+	PersesPlatformOverviewDashboardName = "data-science-platform-overview"
+
+	persesPrometheusDatasourceName = "data-science-prometheus-datasource"
+)
+
+// componentDashboardTemplate returns the path of the Perses dashboard a component can ship, in
+// the components monitoring file system.
+func componentDashboardTemplate(componentName string) string {
+	return componentName + "/monitoring/perses-dashboard.tmpl.yaml"
+}
+
+// componentDashboardName returns the name the PersesDashboard shipped by a component must have,
+// so that it can be cleaned up once the component is disabled.
+func componentDashboardName(componentName string) string {
+	return componentName + "-dashboard"
+}
+
+// deployPersesDashboards deploys the Perses dashboards shipped by the enabled components, and a
+// platform overview dashboard listing the ready ones. The dashboard of a component not ready is
+// kept, so that it is not garbage collected while the component is being reconciled. The
+// dashboards of the disabled components are deleted, mirroring how the component prometheus
+// rules are handled by deployAlerting.
+func deployPersesDashboards(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	monitoring, ok := rr.Instance.(*serviceApi.Monitoring)
+	if !ok {
+		return errors.New("instance is not of type *services.Monitoring")
+	}
+
+	// the dashboards query the Prometheus datasource, only deployed when metrics are configured
+	if monitoring.Spec.Metrics == nil {
+		return nil
+	}
+
+	exists, err := cluster.HasCRD(ctx, rr.Client, gvk.PersesDashboard)
+	if err != nil {
+		return fmt.Errorf("failed to check if %s CRD exists: %w", gvk.PersesDashboard.Kind, err)
+	}
+	if !exists {
+		return nil
+	}
+
+	dsc, err := cluster.GetDSC(ctx, rr.Client)
+	if err != nil {
+		if k8serr.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to retrieve DataScienceCluster: %w", err)
+	}
+
+	var active []componentDashboard
+	var allErrors *multierror.Error
+
+	forEachErr := cr.ForEach(func(ch cr.ComponentHandler) error {
+		componentName := ch.GetName()
+		dashboard := componentDashboardTemplate(componentName)
+		hasDashboard := common.FileExists(componentMonitoring.ComponentRulesFS, dashboard)
+
+		if !ch.IsEnabled(dsc) {
+			if hasDashboard {
+				if err := cleanupPersesDashboard(ctx, rr, monitoring.Spec.Namespace, componentName); err != nil {
+					allErrors = multierror.Append(allErrors, err)
+				}
+			}
+			return nil
+		}
+
+		if hasDashboard {
+			rr.Templates = append(rr.Templates, odhtypes.TemplateInfo{
+				FS:   componentMonitoring.ComponentRulesFS,
+				Path: dashboard,
+			})
+		}
+
+		ready, err := isComponentReady(ctx, rr.Client, ch.NewCRObject(dsc))
+		if err != nil {
+			allErrors = multierror.Append(allErrors, fmt.Errorf("failed to get status for component %s: %w", componentName, err))
+			return nil
+		}
+		if ready {
+			active = append(active, componentDashboard{name: componentName, hasDashboard: hasDashboard})
+		}
+
+		return nil
+	})
+
+	if forEachErr != nil {
+		return fmt.Errorf("failed to iterate components: %w", forEachErr)
+	}
+
+	if err := rr.AddResources(newPlatformOverviewDashboard(monitoring.Spec.Namespace, active)); err != nil {
+		return fmt.Errorf("failed to add the platform overview dashboard: %w", err)
+	}
+
+	return allErrors.ErrorOrNil()
+}
+
+// cleanupPersesDashboard deletes the dashboard of a disabled component.
+func cleanupPersesDashboard(ctx context.Context, rr *odhtypes.ReconciliationRequest, namespace string, componentName string) error {
+	dashboard := &unstructured.Unstructured{}
+	dashboard.SetGroupVersionKind(gvk.PersesDashboard)
+	dashboard.SetName(componentDashboardName(componentName))
+	dashboard.SetNamespace(namespace)
+
+	if err := rr.Client.Delete(ctx, dashboard); err != nil && !k8serr.IsNotFound(err) {
+		return fmt.Errorf("failed to delete perses dashboard for component %s: %w", componentName, err)
+	}
+
+	return nil
+}
+
+type componentDashboard struct {
+	name         string
+	hasDashboard bool
+}
+
+// newPlatformOverviewDashboard returns the dashboard summarizing the active components, with the
+// alerts firing for the platform.
+func newPlatformOverviewDashboard(namespace string, active []componentDashboard) *unstructured.Unstructured {
+	var text strings.Builder
+
+	if len(active) == 0 {
+		text.WriteString("No component is enabled and ready.\n")
+	} else {
+		text.WriteString("| Component | Dashboard |\n| --- | --- |\n")
+		for _, c := range active {
+			dashboard := "-"
+			if c.hasDashboard {
+				dashboard = componentDashboardName(c.name)
+			}
+			fmt.Fprintf(&text, "| %s | %s |\n", c.name, dashboard)
+		}
+	}
+
+	dashboard := unstructured.Unstructured{
+		Object: map[string]any{
+			"spec": map[string]any{
+				"display": map[string]any{
+					"name": "Data Science Platform Overview",
+				},
+				"duration": "1h",
+				"panels": map[string]any{
+					"components": map[string]any{
+						"kind": "Panel",
+						"spec": map[string]any{
+							"display": map[string]any{"name": "Active Components"},
+							"plugin": map[string]any{
+								"kind": "Markdown",
+								"spec": map[string]any{"text": text.String()},
+							},
+						},
+					},
+					"alerts": map[string]any{
+						"kind": "Panel",
+						"spec": map[string]any{
+							"display": map[string]any{"name": "Firing Alerts"},
+							"plugin": map[string]any{
+								"kind": "TimeSeriesChart",
+								"spec": map[string]any{},
+							},
+							"queries": []any{
+								map[string]any{
+									"kind": "TimeSeriesQuery",
+									"spec": map[string]any{
+										"plugin": map[string]any{
+											"kind": "PrometheusTimeSeriesQuery",
+											"spec": map[string]any{
+												"query": `sum by (alertname) (ALERTS{alertstate="firing"})`,
+												"datasource": map[string]any{
+													"kind": "PrometheusDatasource",
+													"name": persesPrometheusDatasourceName,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"layouts": []any{
+					map[string]any{
+						"kind": "Grid",
+						"spec": map[string]any{
+							"display": map[string]any{"title": "Platform"},
+							"items": []any{
+								gridItem(0, 0, 12, 8, "components"),
+								gridItem(12, 0, 12, 8, "alerts"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	dashboard.SetGroupVersionKind(gvk.PersesDashboard)
+	dashboard.SetName(PersesPlatformOverviewDashboardName)
+	dashboard.SetNamespace(namespace)
+
+	return &dashboard
+}
+
+func gridItem(x, y, width, height int64, panel string) map[string]any {
+	return map[string]any{
+		"x":       x,
+		"y":       y,
+		"width":   width,
+		"height":  height,
+		"content": map[string]any{"$ref": "#/spec/panels/" + panel},
+	}
+}
@@ -0,0 +1,141 @@
+//nolint:testpackage // Need to test unexported dashboard functions
+package monitoring
+
+import (
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v2"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components"
+	_ "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/kueue"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	pkgcommon "github.com/opendatahub-io/opendatahub-operator/v2/pkg/common"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestComponentDashboardTemplate(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(pkgcommon.FileExists(components.ComponentRulesFS, componentDashboardTemplate("kueue"))).Should(BeTrue())
+	g.Expect(componentDashboardName("kueue")).Should(Equal("kueue-dashboard"))
+}
+
+func TestNewPlatformOverviewDashboard(t *testing.T) {
+	g := NewWithT(t)
+
+	dashboard := newPlatformOverviewDashboard("monitoring", []componentDashboard{
+		{name: "kueue", hasDashboard: true},
+		{name: "dashboard"},
+	})
+
+	g.Expect(dashboard.GroupVersionKind()).Should(Equal(gvk.PersesDashboard))
+	g.Expect(dashboard.GetName()).Should(Equal(PersesPlatformOverviewDashboardName))
+	g.Expect(dashboard.GetNamespace()).Should(Equal("monitoring"))
+
+	g.Expect(dashboard.Object).Should(And(
+		jq.Match(`.spec.panels.components.spec.plugin.spec.text | contains("| kueue | kueue-dashboard |")`),
+		jq.Match(`.spec.panels.components.spec.plugin.spec.text | contains("| dashboard | - |")`),
+		jq.Match(`.spec.panels.alerts.spec.queries[0].spec.plugin.spec.datasource.name == "%s"`, persesPrometheusDatasourceName),
+		jq.Match(`.spec.layouts[0].spec.items | length == 2`),
+	))
+
+	empty := newPlatformOverviewDashboard("monitoring", nil)
+	g.Expect(empty.Object).Should(
+		jq.Match(`.spec.panels.components.spec.plugin.spec.text == "No component is enabled and ready.\n"`),
+	)
+}
+
+func TestDeployPersesDashboards(t *testing.T) {
+	const namespace = "monitoring"
+
+	kueueDashboard := componentDashboardTemplate("kueue")
+
+	tests := []struct {
+		name            string
+		state           operatorv1.ManagementState
+		ready           bool
+		templates       []string
+		overviewMatcher string
+		deleted         bool
+	}{
+		{
+			name:            "deploys the dashboard of a ready component",
+			state:           operatorv1.Managed,
+			ready:           true,
+			templates:       []string{kueueDashboard},
+			overviewMatcher: `.spec.panels.components.spec.plugin.spec.text | contains("| kueue | kueue-dashboard |")`,
+		},
+		{
+			name:            "keeps the dashboard of a component not ready",
+			state:           operatorv1.Managed,
+			templates:       []string{kueueDashboard},
+			overviewMatcher: `.spec.panels.components.spec.plugin.spec.text | contains("kueue") | not`,
+		},
+		{
+			name:            "deletes the dashboard of a disabled component",
+			state:           operatorv1.Removed,
+			overviewMatcher: `.spec.panels.components.spec.plugin.spec.text | contains("kueue") | not`,
+			deleted:         true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := t.Context()
+
+			dsc := dscv2.DataScienceCluster{ObjectMeta: metav1.ObjectMeta{Name: "default-dsc"}}
+			dsc.Spec.Components.Kueue.ManagementState = tt.state
+
+			kueue := componentApi.Kueue{ObjectMeta: metav1.ObjectMeta{Name: componentApi.KueueInstanceName}}
+			if tt.ready {
+				kueue.Status.Conditions = []common.Condition{{Type: status.ConditionTypeReady, Status: metav1.ConditionTrue}}
+			}
+
+			dashboard := newWorkload(gvk.PersesDashboard, namespace, componentDashboardName("kueue"))
+
+			cli := newExternalTypesTestClient(t,
+				newStoredCRD(gvk.PersesDashboard),
+				&dsc,
+				&kueue,
+				dashboard,
+			)
+
+			monitoring := serviceApi.Monitoring{ObjectMeta: metav1.ObjectMeta{Name: serviceApi.MonitoringInstanceName}}
+			monitoring.Spec.Namespace = namespace
+			monitoring.Spec.Metrics = &serviceApi.Metrics{}
+
+			rr := odhtypes.ReconciliationRequest{Client: cli, Instance: &monitoring}
+
+			g.Expect(deployPersesDashboards(ctx, &rr)).Should(Succeed())
+
+			paths := make([]string, 0, len(rr.Templates))
+			for _, tmpl := range rr.Templates {
+				paths = append(paths, tmpl.Path)
+			}
+			g.Expect(paths).Should(ConsistOf(tt.templates))
+
+			g.Expect(rr.Resources).Should(HaveLen(1))
+			g.Expect(rr.Resources[0].GetName()).Should(Equal(PersesPlatformOverviewDashboardName))
+			g.Expect(rr.Resources[0].Object).Should(jq.Match("%s", tt.overviewMatcher))
+
+			err := cli.Get(ctx, client.ObjectKeyFromObject(dashboard), dashboard)
+			if tt.deleted {
+				g.Expect(k8serr.IsNotFound(err)).Should(BeTrue())
+			} else {
+				g.Expect(err).ShouldNot(HaveOccurred())
+			}
+		})
+	}
+}
@@ -0,0 +1,307 @@
+package monitoring
+
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"k8s.io/kube-openapi/pkg/validation/spec"
+	"k8s.io/kube-openapi/pkg/validation/strfmt"
+	"k8s.io/kube-openapi/pkg/validation/validate"
+)
+
+// Telemetry signals, i.e. the pipelines of the OpenTelemetry collector the exporters are used in.
+const (
+	signalMetrics = "metrics"
+	signalTraces  = "traces"
+	signalLogs    = "logs"
+)
+
+const (
+	exporterSchemasDir = "exporters"
+
+	// signalsExtension lists the signals supported by an exporter type, all of them when not set.
+	signalsExtension = "x-odh-signals"
+	// rulesExtension lists the exporterRules applied to a field, on top of the JSON Schema constraints.
+	rulesExtension = "x-odh-rules"
+)
+
+// exporterSchemasFS holds a JSON Schema document per exporter type, named after the type, e.g.
+// otlp.json. Exporters of a type without a schema are only subject to the security validations.
+//
+//go:embed exporters
+var exporterSchemasFS embed.FS
+
+// exporterRules are the validations which can't be expressed with a JSON Schema, referenced by
+// name in the rulesExtension of the fields.
+var exporterRules = map[string]func(value any) error{
+	"secure_endpoint_check": func(value any) error {
+		if str, ok := value.(string); ok {
+			if strings.HasPrefix(str, "http://") && !isLocalServiceEndpoint(str) {
+				return errors.New("insecure HTTP endpoints not allowed for external services")
+			}
+		}
+		return nil
+	},
+	"no_path_traversal_check": func(value any) error {
+		if str, ok := value.(string); ok {
+			if slices.Contains(strings.Split(str, "/"), "..") {
+				return errors.New("path must not contain '..' elements")
+			}
+		}
+		return nil
+	},
+}
+
+// exporterSchemaSet is the set of the known exporter schemas.
+type exporterSchemaSet struct {
+	schemas map[string]*spec.Schema
+}
+
+// getExporterSchemas loads the embedded exporter schemas once.
+var getExporterSchemas = sync.OnceValues(func() (*exporterSchemaSet, error) {
+	return loadExporterSchemas(exporterSchemasFS, exporterSchemasDir)
+})
+
+// loadExporterSchemas loads and checks the exporter schemas of a directory.
+func loadExporterSchemas(fsys embed.FS, dir string) (*exporterSchemaSet, error) {
+	entries, err := fsys.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read exporter schemas: %w", err)
+	}
+
+	set := exporterSchemaSet{
+		schemas: make(map[string]*spec.Schema, len(entries)),
+	}
+
+	for _, e := range entries {
+		if e.IsDir() || path.Ext(e.Name()) != ".json" {
+			continue
+		}
+
+		data, err := fsys.ReadFile(path.Join(dir, e.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read exporter schema %s: %w", e.Name(), err)
+		}
+
+		schema := spec.Schema{}
+		if err := json.Unmarshal(data, &schema); err != nil {
+			return nil, fmt.Errorf("failed to parse exporter schema %s: %w", e.Name(), err)
+		}
+
+		exporterType := strings.TrimSuffix(e.Name(), ".json")
+		if schema.Title != exporterType {
+			return nil, fmt.Errorf("exporter schema %s: title must be the exporter type %q, got %q", e.Name(), exporterType, schema.Title)
+		}
+
+		if err := compile(&schema); err != nil {
+			return nil, fmt.Errorf("exporter schema %s: %w", e.Name(), err)
+		}
+
+		set.schemas[exporterType] = &schema
+	}
+
+	return &set, nil
+}
+
+// compile checks a schema and its nested schemas: the patterns must compile, the rules they
+// reference must exist, and the keywords must be supported. The schemas are validated by the
+// OpenAPI schema validator, which does not resolve references, while the rules are applied by
+// following the properties, additional properties and items only: the references, the
+// composition and conditional keywords are thus rejected, as well as the unknown formats.
+func compile(schema *spec.Schema) error {
+	switch {
+	case schema.Ref.String() != "":
+		return fmt.Errorf("unsupported keyword %q", "$ref")
+	case len(schema.AllOf) != 0:
+		return fmt.Errorf("unsupported keyword %q", "allOf")
+	case len(schema.AnyOf) != 0:
+		return fmt.Errorf("unsupported keyword %q", "anyOf")
+	case len(schema.OneOf) != 0:
+		return fmt.Errorf("unsupported keyword %q", "oneOf")
+	case schema.Not != nil:
+		return fmt.Errorf("unsupported keyword %q", "not")
+	case len(schema.Dependencies) != 0:
+		return fmt.Errorf("unsupported keyword %q", "dependencies")
+	case len(schema.PatternProperties) != 0:
+		return fmt.Errorf("unsupported keyword %q", "patternProperties")
+	case len(schema.Definitions) != 0:
+		return fmt.Errorf("unsupported keyword %q", "definitions")
+	case schema.AdditionalItems != nil:
+		return fmt.Errorf("unsupported keyword %q", "additionalItems")
+	case schema.Items != nil && len(schema.Items.Schemas) != 0:
+		return errors.New("unsupported tuple items")
+	case schema.Format != "" && !strfmt.Default.ContainsName(schema.Format):
+		return fmt.Errorf("unsupported format %q", schema.Format)
+	}
+
+	if schema.Pattern != "" {
+		if _, err := regexp.Compile(schema.Pattern); err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", schema.Pattern, err)
+		}
+	}
+
+	rules, _ := schema.Extensions.GetStringSlice(rulesExtension)
+	for _, r := range rules {
+		if _, ok := exporterRules[r]; !ok {
+			return fmt.Errorf("unknown rule %q", r)
+		}
+	}
+
+	for name, p := range schema.Properties {
+		if err := compile(&p); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+	}
+
+	if schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil {
+		if err := compile(schema.AdditionalProperties.Schema); err != nil {
+			return err
+		}
+	}
+
+	if schema.Items != nil && schema.Items.Schema != nil {
+		if err := compile(schema.Items.Schema); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateExporterSchema validates an exporter config against the schema of its type, and that
+// the type supports the signal of the pipeline the exporter is used in.
+func validateExporterSchema(exporterName string, signal string, config map[string]interface{}) error {
+	set, err := getExporterSchemas()
+	if err != nil {
+		return err
+	}
+
+	exporterType := getExporterType(exporterName)
+
+	schema, exists := set.schemas[exporterType]
+	if !exists {
+		// For unknown exporters, schema validation is skipped
+		// Security validation already applied above
+		return nil
+	}
+
+	if signals, ok := schema.Extensions.GetStringSlice(signalsExtension); ok && !slices.Contains(signals, signal) {
+		return fmt.Errorf("exporter '%s' of type '%s' does not support %s (supported: %v)",
+			exporterName, exporterType, signal, signals)
+	}
+
+	value, err := toJSONValue(config)
+	if err != nil {
+		return fmt.Errorf("exporter '%s': %w", exporterName, err)
+	}
+
+	res := validate.NewSchemaValidator(schema, nil, "config", strfmt.Default).Validate(value)
+	if res.HasErrors() {
+		return fmt.Errorf("exporter '%s': %w", exporterName, errors.Join(res.Errors...))
+	}
+
+	return applyExporterRules(exporterName, "", schema, value)
+}
+
+// getExporterType extracts the base exporter type from a name like "otlp/custom".
+func getExporterType(exporterName string) string {
+	if idx := strings.Index(exporterName, "/"); idx != -1 {
+		return exporterName[:idx]
+	}
+	return exporterName
+}
+
+// toJSONValue converts a config decoded from YAML to its JSON representation, as expected by the
+// schema validator. The null fields are removed, since they are the same as unset ones for the
+// collector.
+func toJSONValue(config map[string]any) (any, error) {
+	data, err := json.Marshal(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode config: %w", err)
+	}
+
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, fmt.Errorf("failed to decode config: %w", err)
+	}
+
+	return dropNulls(value), nil
+}
+
+func dropNulls(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for k, item := range v {
+			if item == nil {
+				delete(v, k)
+				continue
+			}
+			v[k] = dropNulls(item)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = dropNulls(item)
+		}
+	}
+
+	return value
+}
+
+// applyExporterRules applies the exporterRules referenced by the rulesExtension of a schema and of
+// its nested schemas to a value, at the given field path, already validated against the schema.
+func applyExporterRules(exporterName string, field string, schema *spec.Schema, value any) error {
+	rules, _ := schema.Extensions.GetStringSlice(rulesExtension)
+	for _, r := range rules {
+		if err := exporterRules[r](value); err != nil {
+			return fmt.Errorf("exporter '%s' field '%s' failed rule '%s': %w",
+				exporterName, field, r, err)
+		}
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		keys := slices.Sorted(maps.Keys(v))
+
+		for _, k := range keys {
+			fieldSchema, ok := schema.Properties[k]
+			switch {
+			case ok:
+			case schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil:
+				fieldSchema = *schema.AdditionalProperties.Schema
+			default:
+				continue
+			}
+
+			if err := applyExporterRules(exporterName, joinField(field, k), &fieldSchema, v[k]); err != nil {
+				return err
+			}
+		}
+	case []any:
+		if schema.Items == nil || schema.Items.Schema == nil {
+			return nil
+		}
+
+		for i, item := range v {
+			if err := applyExporterRules(exporterName, fmt.Sprintf("%s[%d]", field, i), schema.Items.Schema, item); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func joinField(parent string, field string) string {
+	if parent == "" {
+		return field
+	}
+	return parent + "." + field
+}
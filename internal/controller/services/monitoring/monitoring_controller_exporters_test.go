@@ -0,0 +1,265 @@
+//nolint:testpackage // Need to test unexported exporter validation functions
+package monitoring
+
+import (
+	"encoding/json"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestExporterSchemasLoad(t *testing.T) {
+	g := NewWithT(t)
+
+	set, err := getExporterSchemas()
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(set.schemas).Should(HaveKey("otlp"))
+	g.Expect(set.schemas).Should(HaveKey("otlphttp"))
+	g.Expect(set.schemas).Should(HaveKey("debug"))
+	g.Expect(set.schemas).Should(HaveKey("prometheusremotewrite"))
+	g.Expect(set.schemas).Should(HaveKey("kafka"))
+	g.Expect(set.schemas).Should(HaveKey("file"))
+	g.Expect(set.schemas).Should(HaveKey("loadbalancing"))
+}
+
+func TestExporterSchemaCompile(t *testing.T) {
+	tests := []struct {
+		name     string
+		schema   string
+		errorMsg string
+	}{
+		{
+			name:   "supported keywords",
+			schema: `{"type": "object", "properties": {"endpoint": {"type": "string", "pattern": "^https://", "x-odh-rules": ["secure_endpoint_check"]}}}`,
+		},
+		{
+			name:     "composition",
+			schema:   `{"type": "object", "properties": {"endpoint": {"oneOf": [{"type": "string"}, {"type": "integer"}]}}}`,
+			errorMsg: `endpoint: unsupported keyword "oneOf"`,
+		},
+		{
+			name:     "reference",
+			schema:   `{"type": "object", "properties": {"endpoint": {"$ref": "#/definitions/endpoint"}}}`,
+			errorMsg: `endpoint: unsupported keyword "$ref"`,
+		},
+		{
+			name:     "dependencies",
+			schema:   `{"type": "object", "dependencies": {"tls": ["endpoint"]}}`,
+			errorMsg: `unsupported keyword "dependencies"`,
+		},
+		{
+			name:     "unknown format",
+			schema:   `{"type": "object", "properties": {"endpoint": {"type": "string", "format": "grpc-endpoint"}}}`,
+			errorMsg: `endpoint: unsupported format "grpc-endpoint"`,
+		},
+		{
+			name:     "invalid pattern",
+			schema:   `{"type": "object", "properties": {"endpoint": {"type": "string", "pattern": "^(https"}}}`,
+			errorMsg: "endpoint: invalid pattern",
+		},
+		{
+			name:     "unknown rule",
+			schema:   `{"type": "object", "properties": {"endpoint": {"type": "string", "x-odh-rules": ["dns_check"]}}}`,
+			errorMsg: `endpoint: unknown rule "dns_check"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			schema := spec.Schema{}
+			g.Expect(json.Unmarshal([]byte(tt.schema), &schema)).Should(Succeed())
+
+			err := compile(&schema)
+			if tt.errorMsg != "" {
+				g.Expect(err).Should(MatchError(ContainSubstring(tt.errorMsg)))
+				return
+			}
+
+			g.Expect(err).ShouldNot(HaveOccurred())
+		})
+	}
+}
+
+func TestValidateExporters(t *testing.T) {
+	tests := []struct {
+		name      string
+		signal    string
+		exporters map[string]runtime.RawExtension
+		errorMsg  string
+	}{
+		{
+			name:   "valid kafka exporter",
+			signal: signalLogs,
+			exporters: map[string]runtime.RawExtension{
+				"kafka/logs": stringToRawExtension(`brokers: ["kafka-0.kafka:9092", "kafka-1.kafka:9092"]
+topic: otlp_logs
+encoding: otlp_json
+producer:
+  compression: zstd
+  required_acks: -1`),
+			},
+		},
+		{
+			name:   "kafka exporter without brokers",
+			signal: signalLogs,
+			exporters: map[string]runtime.RawExtension{
+				"kafka": stringToRawExtension("topic: otlp_logs"),
+			},
+			errorMsg: "config.brokers in body is required",
+		},
+		{
+			name:   "kafka exporter with invalid broker",
+			signal: signalTraces,
+			exporters: map[string]runtime.RawExtension{
+				"kafka": stringToRawExtension(`brokers: ["kafka:port"]`),
+			},
+			errorMsg: "config.brokers[0] in body should match",
+		},
+		{
+			name:   "kafka exporter with invalid nested field",
+			signal: signalMetrics,
+			exporters: map[string]runtime.RawExtension{
+				"kafka": stringToRawExtension("brokers: [\"kafka:9092\"]\nproducer:\n  compression: brotli"),
+			},
+			errorMsg: "config.producer.compression in body should be one of [none gzip snappy lz4 zstd]",
+		},
+		{
+			name:   "kafka exporter with disallowed nested field",
+			signal: signalMetrics,
+			exporters: map[string]runtime.RawExtension{
+				"kafka": stringToRawExtension("brokers: [\"kafka:9092\"]\nproducer:\n  linger: 5ms"),
+			},
+			errorMsg: "config.producer.linger in body is a forbidden property",
+		},
+		{
+			name:   "valid file exporter",
+			signal: signalLogs,
+			exporters: map[string]runtime.RawExtension{
+				"file": stringToRawExtension("path: /var/log/otel/logs.json\nrotation:\n  max_megabytes: 10\n  max_backups: 3"),
+			},
+		},
+		{
+			name:   "file exporter with relative path",
+			signal: signalLogs,
+			exporters: map[string]runtime.RawExtension{
+				"file": stringToRawExtension("path: logs.json"),
+			},
+			errorMsg: "config.path in body should match",
+		},
+		{
+			name:   "file exporter with path traversal",
+			signal: signalLogs,
+			exporters: map[string]runtime.RawExtension{
+				"file": stringToRawExtension("path: /var/log/../../etc/passwd"),
+			},
+			errorMsg: "failed rule 'no_path_traversal_check'",
+		},
+		{
+			name:   "file exporter with wrong integer type",
+			signal: signalLogs,
+			exporters: map[string]runtime.RawExtension{
+				"file": stringToRawExtension("path: /tmp/logs.json\nrotation:\n  max_megabytes: ten"),
+			},
+			errorMsg: "config.rotation.max_megabytes in body must be of type integer",
+		},
+		{
+			name:   "valid loadbalancing exporter",
+			signal: signalTraces,
+			exporters: map[string]runtime.RawExtension{
+				"loadbalancing": stringToRawExtension(`routing_key: traceID
+protocol:
+  otlp:
+    tls:
+      insecure: true
+resolver:
+  k8s:
+    service: collector-backend.observability
+    ports: [4317]`),
+			},
+		},
+		{
+			name:   "loadbalancing exporter with two resolvers",
+			signal: signalTraces,
+			exporters: map[string]runtime.RawExtension{
+				"loadbalancing": stringToRawExtension(`protocol:
+  otlp: {}
+resolver:
+  static:
+    hostnames: [backend-1:4317]
+  dns:
+    hostname: backend`),
+			},
+			errorMsg: "config.resolver in body should have at most 1 properties",
+		},
+		{
+			name:   "loadbalancing exporter without resolver",
+			signal: signalLogs,
+			exporters: map[string]runtime.RawExtension{
+				"loadbalancing": stringToRawExtension("protocol:\n  otlp: {}"),
+			},
+			errorMsg: "config.resolver in body is required",
+		},
+		{
+			name:   "prometheusremotewrite exporter for logs",
+			signal: signalLogs,
+			exporters: map[string]runtime.RawExtension{
+				"prometheusremotewrite": stringToRawExtension("endpoint: https://prometheus.example.com/api/v1/write"),
+			},
+			errorMsg: "exporter 'prometheusremotewrite' of type 'prometheusremotewrite' does not support logs",
+		},
+		{
+			name:   "null field is ignored",
+			signal: signalLogs,
+			exporters: map[string]runtime.RawExtension{
+				"otlphttp": stringToRawExtension("endpoint: https://logs.example.com\nheaders:"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			validated, err := validateExporters(tt.signal, tt.exporters)
+			if tt.errorMsg != "" {
+				g.Expect(err).Should(MatchError(ContainSubstring(tt.errorMsg)))
+				return
+			}
+
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(validated).Should(HaveLen(len(tt.exporters)))
+		})
+	}
+}
+
+func TestAddLogsTemplateData(t *testing.T) {
+	g := NewWithT(t)
+
+	templateData := map[string]any{
+		"MetricsExporters": map[string]string{"otlphttp/shared": "endpoint: https://otel.example.com"},
+	}
+
+	logs := &serviceApi.Logs{
+		Exporters: map[string]runtime.RawExtension{
+			"otlphttp/shared": stringToRawExtension("endpoint: https://otel.example.com"),
+			"debug":           stringToRawExtension("verbosity: basic"),
+		},
+	}
+
+	g.Expect(addLogsTemplateData(templateData, logs)).Should(Succeed())
+	g.Expect(templateData).Should(HaveKeyWithValue("LogsExporterNames", []string{"debug", "otlphttp/shared"}))
+	g.Expect(templateData).Should(HaveKeyWithValue("LogsOnlyExporterNames", []string{"debug"}))
+
+	logs.Exporters["otlphttp/shared"] = stringToRawExtension("endpoint: https://logs.example.com")
+
+	g.Expect(addLogsTemplateData(templateData, logs)).Should(
+		MatchError(ContainSubstring("exporter 'otlphttp/shared' is configured differently for logs and metrics")),
+	)
+}
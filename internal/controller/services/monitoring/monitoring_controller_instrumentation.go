@@ -0,0 +1,324 @@
+package monitoring
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/reconciler"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+)
+
+const (
+	// InstrumentationName is the name of the Instrumentation created in each instrumented namespace,
+	// it matches the one of the monitoring namespace so that workloads can reference it the same way.
+	InstrumentationName = "data-science-instrumentation"
+
+	maxReportedNamespaces = 50
+)
+
+// instrumentationWorkloads maps the workload kinds an instrumentation policy can select to the
+// resources that identify them.
+var instrumentationWorkloads = map[serviceApi.InstrumentationWorkloadKind]schema.GroupVersionKind{
+	serviceApi.WorkloadKindNotebook:         gvk.Notebook,
+	serviceApi.WorkloadKindInferenceService: gvk.InferenceServices,
+	serviceApi.WorkloadKindRayCluster:       gvk.RayClusterV1,
+	serviceApi.WorkloadKindPipelineRun:      gvk.ArgoWorkflow,
+}
+
+// deployInstrumentationPolicies creates an Instrumentation in each namespace selected by the
+// instrumentation policies and reports the coverage of the policies in the status.
+// The monitoring namespace keeps the default Instrumentation rendered by deployTracingStack.
+func deployInstrumentationPolicies(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	monitoring, ok := rr.Instance.(*serviceApi.Monitoring)
+	if !ok {
+		return errors.New("instance is not of type *services.Monitoring")
+	}
+
+	monitoring.Status.InstrumentationPolicies = nil
+
+	traces := monitoring.Spec.Traces
+	if traces == nil || len(traces.InstrumentationPolicies) == 0 {
+		return nil
+	}
+
+	// the InstrumentationAvailable condition is already reported by deployTracingStack
+	exists, err := cluster.HasCRD(ctx, rr.Client, gvk.Instrumentation)
+	if err != nil {
+		return fmt.Errorf("failed to check if CRD Instrumentation exists: %w", err)
+	}
+	if !exists {
+		return nil
+	}
+
+	selected, err := selectInstrumentationNamespaces(ctx, rr.Client, traces.InstrumentationPolicies)
+	if err != nil {
+		return err
+	}
+
+	endpoint := collectorOtlpEndpoint(monitoring.Spec.Namespace)
+	instrumented := make(map[string][]string, len(traces.InstrumentationPolicies))
+
+	for _, ns := range slices.Sorted(maps.Keys(selected)) {
+		if ns == monitoring.Spec.Namespace {
+			continue
+		}
+
+		policy := selected[ns]
+
+		obj, err := newPolicyInstrumentation(ns, endpoint, traces.SampleRatio, policy)
+		if err != nil {
+			return err
+		}
+		if err := rr.AddResources(obj); err != nil {
+			return fmt.Errorf("failed to add Instrumentation for namespace %s: %w", ns, err)
+		}
+
+		instrumented[policy.Name] = append(instrumented[policy.Name], ns)
+	}
+
+	policiesStatus := make([]serviceApi.InstrumentationPolicyStatus, 0, len(traces.InstrumentationPolicies))
+	for _, policy := range traces.InstrumentationPolicies {
+		namespaces := instrumented[policy.Name]
+
+		policiesStatus = append(policiesStatus, serviceApi.InstrumentationPolicyStatus{
+			Name:                   policy.Name,
+			InstrumentedNamespaces: int32(len(namespaces)), //nolint:gosec // bounded by the number of namespaces
+			Namespaces:             namespaces[:min(len(namespaces), maxReportedNamespaces)],
+		})
+	}
+
+	monitoring.Status.InstrumentationPolicies = policiesStatus
+
+	return nil
+}
+
+// selectInstrumentationNamespaces returns the policy that applies to each selected namespace. When
+// a namespace matches more than one policy, the first one in the list is used. The reserved
+// namespaces (openshift-*, kube-*, default and openshift) are never instrumented.
+func selectInstrumentationNamespaces(
+	ctx context.Context,
+	cli client.Client,
+	policies []serviceApi.InstrumentationPolicy,
+) (map[string]*serviceApi.InstrumentationPolicy, error) {
+	selectors := make([]k8slabels.Selector, len(policies))
+	for i, policy := range policies {
+		if policy.NamespaceSelector == nil {
+			// a policy without any selector must not instrument the whole cluster
+			if len(policy.WorkloadKinds) == 0 {
+				selectors[i] = k8slabels.Nothing()
+			} else {
+				selectors[i] = k8slabels.Everything()
+			}
+			continue
+		}
+
+		selector, err := metav1.LabelSelectorAsSelector(policy.NamespaceSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid namespace selector in instrumentation policy %s: %w", policy.Name, err)
+		}
+
+		selectors[i] = selector
+	}
+
+	// the namespaces running each workload kind, only listed when a policy needs them
+	workloads := make(map[serviceApi.InstrumentationWorkloadKind]sets.Set[string])
+
+	hasWorkloads := func(ns string, kinds []serviceApi.InstrumentationWorkloadKind) (bool, error) {
+		if len(kinds) == 0 {
+			return true, nil
+		}
+
+		for _, kind := range kinds {
+			if _, ok := workloads[kind]; !ok {
+				namespaces, err := workloadNamespaces(ctx, cli, kind)
+				if err != nil {
+					return false, err
+				}
+
+				workloads[kind] = namespaces
+			}
+
+			if workloads[kind].Has(ns) {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	}
+
+	namespaces := corev1.NamespaceList{}
+	if err := cli.List(ctx, &namespaces); err != nil {
+		return nil, fmt.Errorf("failed to list namespaces: %w", err)
+	}
+
+	selected := make(map[string]*serviceApi.InstrumentationPolicy)
+
+	for _, ns := range namespaces.Items {
+		if ns.DeletionTimestamp != nil || cluster.IsReservedNamespace(&ns) {
+			continue
+		}
+
+		for i := range policies {
+			if !selectors[i].Matches(k8slabels.Set(ns.Labels)) {
+				continue
+			}
+
+			ok, err := hasWorkloads(ns.Name, policies[i].WorkloadKinds)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				selected[ns.Name] = &policies[i]
+				break
+			}
+		}
+	}
+
+	return selected, nil
+}
+
+// hasInstrumentationPolicies is a DynamicPredicate that checks if the Monitoring instance defines
+// instrumentation policies, optionally selecting one of the given workload kinds.
+func hasInstrumentationPolicies(kinds ...serviceApi.InstrumentationWorkloadKind) reconciler.DynamicPredicate {
+	return func(_ context.Context, rr *odhtypes.ReconciliationRequest) bool {
+		monitoring, ok := rr.Instance.(*serviceApi.Monitoring)
+		if !ok || monitoring.Spec.Traces == nil {
+			return false
+		}
+
+		for _, policy := range monitoring.Spec.Traces.InstrumentationPolicies {
+			if len(kinds) == 0 {
+				return true
+			}
+
+			for _, kind := range kinds {
+				if slices.Contains(policy.WorkloadKinds, kind) {
+					return true
+				}
+			}
+		}
+
+		return false
+	}
+}
+
+// workloadNamespaces returns the namespaces running at least one workload of the given kind, none
+// when the workload CRD is not installed.
+func workloadNamespaces(ctx context.Context, cli client.Client, kind serviceApi.InstrumentationWorkloadKind) (sets.Set[string], error) {
+	namespaces := sets.New[string]()
+
+	workloadGVK, ok := instrumentationWorkloads[kind]
+	if !ok {
+		return nil, fmt.Errorf("unsupported workload kind %s", kind)
+	}
+
+	exists, err := cluster.HasCRD(ctx, cli, workloadGVK)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check if CRD %s exists: %w", workloadGVK.Kind, err)
+	}
+	if !exists {
+		return namespaces, nil
+	}
+
+	items := unstructured.UnstructuredList{}
+	items.SetGroupVersionKind(workloadGVK)
+
+	if err := cli.List(ctx, &items); err != nil {
+		return nil, fmt.Errorf("failed to list %s: %w", workloadGVK.Kind, err)
+	}
+
+	for _, item := range items.Items {
+		namespaces.Insert(item.GetNamespace())
+	}
+
+	return namespaces, nil
+}
+
+// newPolicyInstrumentation returns the Instrumentation of a namespace selected by an
+// instrumentation policy.
+func newPolicyInstrumentation(
+	namespace string,
+	endpoint string,
+	defaultSampleRatio string,
+	policy *serviceApi.InstrumentationPolicy,
+) (*unstructured.Unstructured, error) {
+	sampleRatio := policy.SampleRatio
+	if sampleRatio == "" {
+		sampleRatio = defaultSampleRatio
+	}
+
+	spec := map[string]any{
+		"exporter": map[string]any{
+			"endpoint": endpoint,
+		},
+		"sampler": map[string]any{
+			"type":     "traceidratio",
+			"argument": sampleRatio,
+		},
+	}
+
+	if len(policy.ResourceAttributes) > 0 {
+		attributes := make(map[string]any, len(policy.ResourceAttributes))
+		for k, v := range policy.ResourceAttributes {
+			attributes[k] = v
+		}
+
+		spec["resource"] = map[string]any{
+			"resourceAttributes": attributes,
+		}
+	}
+
+	if ai := policy.AutoInstrumentation; ai != nil {
+		languages := map[string]*serviceApi.LanguageInstrumentation{
+			"java":   ai.Java,
+			"nodejs": ai.NodeJS,
+			"python": ai.Python,
+			"dotnet": ai.DotNet,
+			"go":     ai.Go,
+		}
+
+		for name, language := range languages {
+			if language == nil {
+				continue
+			}
+
+			u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(language)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert %s instrumentation of policy %s: %w", name, policy.Name, err)
+			}
+
+			spec[name] = u
+		}
+	}
+
+	obj := unstructured.Unstructured{
+		Object: map[string]any{
+			"spec": spec,
+		},
+	}
+
+	obj.SetGroupVersionKind(gvk.Instrumentation)
+	obj.SetName(InstrumentationName)
+	obj.SetNamespace(namespace)
+	obj.SetLabels(map[string]string{
+		labels.Monitoring.InstrumentationPolicy: policy.Name,
+	})
+
+	return &obj, nil
+}
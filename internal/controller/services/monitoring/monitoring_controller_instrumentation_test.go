@@ -0,0 +1,254 @@
+//nolint:testpackage // Need to test unexported instrumentation policy functions
+package monitoring
+
+import (
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+	testScheme "github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/scheme"
+
+	. "github.com/onsi/gomega"
+)
+
+func newStoredCRD(kgvk schema.GroupVersionKind) *extv1.CustomResourceDefinition {
+	plural := strings.ToLower(kgvk.Kind) + "s"
+
+	return &extv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: plural + "." + kgvk.Group},
+		Spec: extv1.CustomResourceDefinitionSpec{
+			Group: kgvk.Group,
+			Names: extv1.CustomResourceDefinitionNames{Kind: kgvk.Kind, Plural: plural},
+			Scope: extv1.NamespaceScoped,
+			Versions: []extv1.CustomResourceDefinitionVersion{
+				{Name: kgvk.Version, Served: true, Storage: true},
+			},
+		},
+		Status: extv1.CustomResourceDefinitionStatus{
+			StoredVersions: []string{kgvk.Version},
+		},
+	}
+}
+
+func newWorkload(kgvk schema.GroupVersionKind, namespace string, name string) *unstructured.Unstructured {
+	u := unstructured.Unstructured{}
+	u.SetGroupVersionKind(kgvk)
+	u.SetNamespace(namespace)
+	u.SetName(name)
+
+	return &u
+}
+
+func newExternalTypesTestClient(t *testing.T, objects ...client.Object) client.Client {
+	t.Helper()
+
+	g := NewWithT(t)
+
+	s, err := testScheme.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	mapper := meta.NewDefaultRESTMapper(s.PreferredVersionAllGroups())
+	for kt := range s.AllKnownTypes() {
+		switch kt {
+		case gvk.CustomResourceDefinition, gvk.Namespace:
+			mapper.Add(kt, meta.RESTScopeRoot)
+		default:
+			mapper.Add(kt, meta.RESTScopeNamespace)
+		}
+	}
+
+	externalTypes := []schema.GroupVersionKind{
+		gvk.Instrumentation,
+		gvk.Notebook,
+		gvk.InferenceServices,
+		gvk.RayClusterV1,
+		gvk.MonitoringStack,
+		gvk.ThanosQuerier,
+		gvk.TempoStack,
+		gvk.OpenTelemetryCollector,
+		gvk.PersesDashboard,
+	}
+
+	for _, kt := range externalTypes {
+		mapper.Add(kt, meta.RESTScopeNamespace)
+	}
+
+	return fake.NewClientBuilder().
+		WithScheme(s).
+		WithRESTMapper(mapper).
+		WithObjects(objects...).
+		Build()
+}
+
+func TestDeployInstrumentationPolicies(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	namespace := func(name string, nsLabels map[string]string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: nsLabels}}
+	}
+
+	cli := newExternalTypesTestClient(t,
+		newStoredCRD(gvk.Instrumentation),
+		newStoredCRD(gvk.Notebook),
+		newStoredCRD(gvk.InferenceServices),
+		namespace("monitoring", map[string]string{"team": "ai"}),
+		namespace("team-a", map[string]string{"team": "ai"}),
+		namespace("notebooks", map[string]string{"team": "ai"}),
+		namespace("models", nil),
+		namespace("other", nil),
+		namespace("openshift-ai", map[string]string{"team": "ai"}),
+		namespace("kube-system", map[string]string{"team": "ai"}),
+		newWorkload(gvk.Notebook, "notebooks", "wb"),
+		newWorkload(gvk.Notebook, "other", "wb"),
+		newWorkload(gvk.InferenceServices, "models", "isvc"),
+	)
+
+	monitoring := serviceApi.Monitoring{
+		ObjectMeta: metav1.ObjectMeta{Name: serviceApi.MonitoringInstanceName},
+		Spec: serviceApi.MonitoringSpec{
+			MonitoringCommonSpec: serviceApi.MonitoringCommonSpec{
+				Namespace: "monitoring",
+				Traces: &serviceApi.Traces{
+					SampleRatio: "0.1",
+					InstrumentationPolicies: []serviceApi.InstrumentationPolicy{
+						{
+							Name:               "model-serving",
+							WorkloadKinds:      []serviceApi.InstrumentationWorkloadKind{serviceApi.WorkloadKindInferenceService},
+							SampleRatio:        "1.0",
+							ResourceAttributes: map[string]string{"deployment.environment": "prod"},
+						},
+						{
+							Name:              "team",
+							NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "ai"}},
+							AutoInstrumentation: &serviceApi.AutoInstrumentation{
+								Python: &serviceApi.LanguageInstrumentation{Image: "python-autoinstrumentation:latest"},
+							},
+						},
+						{
+							Name:          "ray",
+							WorkloadKinds: []serviceApi.InstrumentationWorkloadKind{serviceApi.WorkloadKindRayCluster},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	rr := odhtypes.ReconciliationRequest{Client: cli, Instance: &monitoring}
+
+	g.Expect(deployInstrumentationPolicies(ctx, &rr)).Should(Succeed())
+
+	g.Expect(monitoring.Status.InstrumentationPolicies).Should(Equal([]serviceApi.InstrumentationPolicyStatus{
+		{Name: "model-serving", InstrumentedNamespaces: 1, Namespaces: []string{"models"}},
+		{Name: "team", InstrumentedNamespaces: 2, Namespaces: []string{"notebooks", "team-a"}},
+		{Name: "ray", InstrumentedNamespaces: 0},
+	}))
+
+	g.Expect(rr.Resources).Should(HaveLen(3))
+
+	byNamespace := make(map[string]unstructured.Unstructured, len(rr.Resources))
+	for _, res := range rr.Resources {
+		g.Expect(res.GroupVersionKind()).Should(Equal(gvk.Instrumentation))
+		g.Expect(res.GetName()).Should(Equal(InstrumentationName))
+		byNamespace[res.GetNamespace()] = res
+	}
+
+	models := byNamespace["models"]
+	g.Expect(models.GetLabels()).Should(HaveKeyWithValue(labels.Monitoring.InstrumentationPolicy, "model-serving"))
+	g.Expect(models).Should(And(
+		jq.Match(`.spec.sampler.argument == "1.0"`),
+		jq.Match(`.spec.exporter.endpoint == "http://data-science-collector.monitoring.svc.cluster.local:4317"`),
+		jq.Match(`.spec.resource.resourceAttributes["deployment.environment"] == "prod"`),
+	))
+
+	teamA := byNamespace["team-a"]
+	g.Expect(teamA.GetLabels()).Should(HaveKeyWithValue(labels.Monitoring.InstrumentationPolicy, "team"))
+	g.Expect(teamA).Should(And(
+		jq.Match(`.spec.sampler.argument == "0.1"`),
+		jq.Match(`.spec.python.image == "python-autoinstrumentation:latest"`),
+		jq.Match(`.spec | has("resource") | not`),
+	))
+}
+
+func TestDeployInstrumentationPoliciesWithoutCRD(t *testing.T) {
+	g := NewWithT(t)
+
+	monitoring := serviceApi.Monitoring{
+		Spec: serviceApi.MonitoringSpec{
+			MonitoringCommonSpec: serviceApi.MonitoringCommonSpec{
+				Namespace: "monitoring",
+				Traces: &serviceApi.Traces{
+					InstrumentationPolicies: []serviceApi.InstrumentationPolicy{
+						{Name: "all", NamespaceSelector: &metav1.LabelSelector{}},
+					},
+				},
+			},
+		},
+		Status: serviceApi.MonitoringStatus{
+			InstrumentationPolicies: []serviceApi.InstrumentationPolicyStatus{{Name: "stale"}},
+		},
+	}
+
+	rr := odhtypes.ReconciliationRequest{Client: newExternalTypesTestClient(t), Instance: &monitoring}
+
+	g.Expect(deployInstrumentationPolicies(t.Context(), &rr)).Should(Succeed())
+	g.Expect(rr.Resources).Should(BeEmpty())
+	g.Expect(monitoring.Status.InstrumentationPolicies).Should(BeNil())
+}
+
+func TestHasInstrumentationPolicies(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	monitoring := func(traces *serviceApi.Traces) *odhtypes.ReconciliationRequest {
+		return &odhtypes.ReconciliationRequest{
+			Instance: &serviceApi.Monitoring{
+				Spec: serviceApi.MonitoringSpec{
+					MonitoringCommonSpec: serviceApi.MonitoringCommonSpec{Traces: traces},
+				},
+			},
+		}
+	}
+
+	withPolicies := monitoring(&serviceApi.Traces{
+		InstrumentationPolicies: []serviceApi.InstrumentationPolicy{
+			{Name: "team", NamespaceSelector: &metav1.LabelSelector{}},
+			{Name: "notebooks", WorkloadKinds: []serviceApi.InstrumentationWorkloadKind{serviceApi.WorkloadKindNotebook}},
+		},
+	})
+
+	g.Expect(hasInstrumentationPolicies()(ctx, monitoring(nil))).Should(BeFalse())
+	g.Expect(hasInstrumentationPolicies()(ctx, monitoring(&serviceApi.Traces{}))).Should(BeFalse())
+	g.Expect(hasInstrumentationPolicies()(ctx, withPolicies)).Should(BeTrue())
+	g.Expect(hasInstrumentationPolicies(serviceApi.WorkloadKindNotebook)(ctx, withPolicies)).Should(BeTrue())
+	g.Expect(hasInstrumentationPolicies(serviceApi.WorkloadKindPipelineRun)(ctx, withPolicies)).Should(BeFalse())
+}
+
+func TestSelectInstrumentationNamespacesWithoutSelector(t *testing.T) {
+	g := NewWithT(t)
+
+	cli := newExternalTypesTestClient(t,
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}},
+	)
+
+	selected, err := selectInstrumentationNamespaces(t.Context(), cli, []serviceApi.InstrumentationPolicy{
+		{Name: "empty"},
+	})
+
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(selected).Should(BeEmpty())
+}
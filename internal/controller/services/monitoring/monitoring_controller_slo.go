@@ -0,0 +1,347 @@
+package monitoring
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	promapi "github.com/prometheus/client_golang/api"
+	promapiv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+)
+
+const (
+	// SLORulesName is the name of the PrometheusRule generated from the service level objectives.
+	SLORulesName = "data-science-slo-rules"
+
+	sloDefaultWindow     = "30d"
+	sloWindowPlaceholder = "$window"
+	sloErrorRatioRecord  = "slo:sli_error:ratio_rate"
+	sloBudgetRecord      = "slo:error_budget_remaining:ratio"
+	sloBurnRateAlertName = "SLOErrorBudgetBurn"
+	sloQueryTimeout      = 5 * time.Second
+
+	// sloStatusRefreshInterval is the interval at which the error budgets are refreshed in the
+	// status, since they do not change with the watched resources.
+	sloStatusRefreshInterval = 5 * time.Minute
+)
+
+// sloBudgetWindow is the window of the error ratio averaged over the SLO window to compute the
+// error budget remaining.
+const sloBudgetWindow = 5 * time.Minute
+
+// sloBurnRateAlert is a multi-window burn rate alert, as described in the Google SRE workbook: it
+// fires when the error ratio over both the long and the short windows consumes the error budget
+// fast enough to exhaust budgetPercent percent of it within the long window.
+type sloBurnRateAlert struct {
+	severity      string
+	long          time.Duration
+	short         time.Duration
+	budgetPercent int64
+}
+
+// sloBurnRateAlerts are the alerts generated for each SLO, the alerts whose long window exceeds
+// the window of the SLO are skipped.
+var sloBurnRateAlerts = []sloBurnRateAlert{
+	{severity: "critical", long: time.Hour, short: 5 * time.Minute, budgetPercent: 2},
+	{severity: "critical", long: 6 * time.Hour, short: 30 * time.Minute, budgetPercent: 5},
+	{severity: "warning", long: 24 * time.Hour, short: 2 * time.Hour, budgetPercent: 10},
+	{severity: "warning", long: 72 * time.Hour, short: 6 * time.Hour, budgetPercent: 10},
+}
+
+// sloQuerier evaluates the PromQL queries reporting the error budgets.
+type sloQuerier interface {
+	Query(ctx context.Context, query string, ts time.Time, opts ...promapiv1.Option) (model.Value, promapiv1.Warnings, error)
+}
+
+// newSLOQuerier returns the querier for the given address, it is a variable so that tests can
+// replace it.
+var newSLOQuerier = func(address string) (sloQuerier, error) {
+	c, err := promapi.NewClient(promapi.Config{Address: address})
+	if err != nil {
+		return nil, err
+	}
+
+	return promapiv1.NewAPI(c), nil
+}
+
+// deployServiceLevelObjectives generates the recording rules and burn rate alerts of the service
+// level objectives. The rules are garbage collected once no SLO is defined.
+func deployServiceLevelObjectives(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	monitoring, ok := rr.Instance.(*serviceApi.Monitoring)
+	if !ok {
+		return errors.New("instance is not of type *services.Monitoring")
+	}
+
+	if monitoring.Spec.Alerting == nil || len(monitoring.Spec.Alerting.ServiceLevelObjectives) == 0 {
+		return nil
+	}
+
+	// the availability of the CRD is reported by deployAlerting
+	exists, err := cluster.HasCRD(ctx, rr.Client, gvk.PrometheusRule)
+	if err != nil {
+		return fmt.Errorf("failed to check if %s CRD exists: %w", gvk.PrometheusRule.Kind, err)
+	}
+	if !exists {
+		return nil
+	}
+
+	rules, err := newSLORules(monitoring.Spec.Namespace, monitoring.Spec.Alerting.ServiceLevelObjectives)
+	if err != nil {
+		return err
+	}
+
+	return rr.AddResources(rules)
+}
+
+// updateServiceLevelObjectivesStatus reports in the status the error budget remaining of the
+// service level objectives, as recorded by the rules generated by deployServiceLevelObjectives.
+// The budgets of all the SLOs are read with a single query to the ThanosQuerier, refreshed every
+// sloStatusRefreshInterval. A budget that cannot be evaluated is reported as such and does not
+// fail the reconciliation. It must run after updateBackendsStatus.
+func updateServiceLevelObjectivesStatus(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	monitoring, ok := rr.Instance.(*serviceApi.Monitoring)
+	if !ok {
+		return errors.New("instance is not of type *services.Monitoring")
+	}
+
+	if monitoring.Spec.Alerting == nil || len(monitoring.Spec.Alerting.ServiceLevelObjectives) == 0 {
+		monitoring.Status.ServiceLevelObjectives = nil
+		return nil
+	}
+
+	slos := monitoring.Spec.Alerting.ServiceLevelObjectives
+
+	budgets, message := errorBudgetsRemaining(ctx, rr, monitoring)
+
+	statuses := make([]serviceApi.ServiceLevelObjectiveStatus, 0, len(slos))
+
+	for _, slo := range slos {
+		st := serviceApi.ServiceLevelObjectiveStatus{Name: slo.Name, Message: message}
+
+		if message == "" {
+			remaining, found := budgets[slo.Name]
+			switch {
+			case !found:
+				st.Message = "the error budget has not been recorded yet"
+			case math.IsNaN(remaining) || math.IsInf(remaining, 0):
+				st.Message = "no event has been recorded over the window"
+			default:
+				st.ErrorBudgetRemaining = strconv.FormatFloat(remaining, 'f', 4, 64)
+			}
+		}
+
+		statuses = append(statuses, st)
+	}
+
+	monitoring.Status.ServiceLevelObjectives = statuses
+
+	return nil
+}
+
+// errorBudgetsRemaining returns the error budget remaining of the SLOs by name, or a message
+// explaining why they could not be evaluated. The ThanosQuerier is not queried when the metrics
+// are not configured or when it is not deployed, otherwise the request is requeued to refresh
+// the budgets.
+func errorBudgetsRemaining(ctx context.Context, rr *odhtypes.ReconciliationRequest, monitoring *serviceApi.Monitoring) (map[string]float64, string) {
+	if monitoring.Spec.Metrics == nil {
+		return nil, "the error budget cannot be evaluated without metrics"
+	}
+
+	querierIndex := slices.IndexFunc(monitoring.Status.Backends, func(b serviceApi.MonitoringBackendStatus) bool {
+		return b.Kind == gvk.ThanosQuerier.Kind
+	})
+	if querierIndex == -1 {
+		return nil, "the error budget cannot be evaluated without ThanosQuerier"
+	}
+	if monitoring.Status.Backends[querierIndex].Ready == metav1.ConditionFalse {
+		return nil, "the error budget cannot be evaluated until ThanosQuerier is ready"
+	}
+
+	rr.Requeue(sloStatusRefreshInterval)
+
+	querier, err := newSLOQuerier(thanosQuerierURL(monitoring.Spec.Namespace))
+	if err != nil {
+		return nil, fmt.Sprintf("failed to create the query client: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, sloQueryTimeout)
+	defer cancel()
+
+	value, _, err := querier.Query(ctx, sloBudgetRecord, time.Now())
+	if err != nil {
+		return nil, fmt.Sprintf("failed to query the error budget: %v", err)
+	}
+
+	vector, _ := value.(model.Vector)
+	budgets := make(map[string]float64, len(vector))
+
+	for _, sample := range vector {
+		budgets[string(sample.Metric["slo"])] = float64(sample.Value)
+	}
+
+	return budgets, ""
+}
+
+// newSLORules returns the PrometheusRule holding a rule group per service level objective.
+func newSLORules(namespace string, slos []serviceApi.ServiceLevelObjective) (*unstructured.Unstructured, error) {
+	groups := make([]any, 0, len(slos))
+
+	for i := range slos {
+		group, err := sloRuleGroup(&slos[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid service level objective %s: %w", slos[i].Name, err)
+		}
+
+		groups = append(groups, group)
+	}
+
+	rules := unstructured.Unstructured{
+		Object: map[string]any{
+			"spec": map[string]any{
+				"groups": groups,
+			},
+		},
+	}
+
+	rules.SetGroupVersionKind(gvk.PrometheusRule)
+	rules.SetName(SLORulesName)
+	rules.SetNamespace(namespace)
+
+	return &rules, nil
+}
+
+// sloRuleGroup returns the rule group of an SLO: the error ratio recorded over each window used
+// by the alerts, the error budget remaining and the burn rate alerts.
+func sloRuleGroup(slo *serviceApi.ServiceLevelObjective) (map[string]any, error) {
+	window, err := model.ParseDuration(getStringValueOrDefault(slo.Window, sloDefaultWindow))
+	if err != nil {
+		return nil, fmt.Errorf("invalid window: %w", err)
+	}
+
+	target, err := strconv.ParseFloat(slo.Target, 64)
+	if err != nil || target <= 0 || target >= 100 {
+		return nil, fmt.Errorf("invalid target %q, it must be a percentage between 0 and 100 excluded", slo.Target)
+	}
+
+	alerts := make([]sloBurnRateAlert, 0, len(sloBurnRateAlerts))
+	windows := []time.Duration{sloBudgetWindow}
+
+	for _, a := range sloBurnRateAlerts {
+		if a.long > time.Duration(window) {
+			continue
+		}
+
+		alerts = append(alerts, a)
+		windows = append(windows, a.long, a.short)
+	}
+
+	slices.Sort(windows)
+	windows = slices.Compact(windows)
+
+	budget := fmt.Sprintf("(1 - %s / 100)", slo.Target)
+	selector := fmt.Sprintf(`{slo=%q}`, slo.Name)
+
+	rules := make([]any, 0, len(windows)+len(alerts)+1)
+
+	for _, w := range windows {
+		expr, err := sloErrorRatio(slo, model.Duration(w).String())
+		if err != nil {
+			return nil, err
+		}
+
+		rules = append(rules, map[string]any{
+			"record": sloErrorRatioRecord + model.Duration(w).String(),
+			"expr":   expr,
+			"labels": sloLabels(slo),
+		})
+	}
+
+	rules = append(rules, map[string]any{
+		"record": sloBudgetRecord,
+		"expr": fmt.Sprintf("1 - (avg_over_time(%s%s%s[%s]) / %s)",
+			sloErrorRatioRecord, model.Duration(sloBudgetWindow), selector, window, budget),
+		"labels": sloLabels(slo),
+	})
+
+	for _, a := range alerts {
+		long := model.Duration(a.long).String()
+		short := model.Duration(a.short).String()
+
+		// the burn rate exhausting budgetPercent of the error budget within the long window
+		burnRate := float64(a.budgetPercent*int64(time.Duration(window)/time.Hour)) / float64(100*int64(a.long/time.Hour))
+		threshold := fmt.Sprintf("(%s * %s)", strconv.FormatFloat(burnRate, 'g', -1, 64), budget)
+
+		labels := sloLabels(slo)
+		labels["severity"] = a.severity
+		labels["long_window"] = long
+
+		description := fmt.Sprintf(
+			"The error budget of the SLO %s is consumed %s times faster than sustainable over the last %s and %s.",
+			slo.Name, strconv.FormatFloat(burnRate, 'g', -1, 64), long, short)
+		if slo.Description != "" {
+			description = slo.Description + ". " + description
+		}
+
+		rules = append(rules, map[string]any{
+			"alert": sloBurnRateAlertName,
+			"expr": fmt.Sprintf("%s%s%s > %s\nand\n%s%s%s > %s",
+				sloErrorRatioRecord, long, selector, threshold,
+				sloErrorRatioRecord, short, selector, threshold),
+			"labels": labels,
+			"annotations": map[string]any{
+				"summary":     fmt.Sprintf("SLO %s is burning its error budget too fast", slo.Name),
+				"description": description,
+			},
+		})
+	}
+
+	return map[string]any{
+		"name":  "slo-" + slo.Name,
+		"rules": rules,
+	}, nil
+}
+
+// sloErrorRatio returns the PromQL expression of the error ratio of an SLO over the given window.
+func sloErrorRatio(slo *serviceApi.ServiceLevelObjective, window string) (string, error) {
+	switch {
+	case slo.Ratio != nil:
+		return fmt.Sprintf("(%s)\n/\n(%s)",
+			strings.ReplaceAll(slo.Ratio.ErrorQuery, sloWindowPlaceholder, window),
+			strings.ReplaceAll(slo.Ratio.TotalQuery, sloWindowPlaceholder, window),
+		), nil
+	case slo.Latency != nil:
+		matchers := slo.Latency.Selector
+		if matchers != "" {
+			matchers = "," + matchers
+		}
+
+		return fmt.Sprintf("1 - (\n  sum(rate(%s_bucket{le=%q%s}[%s]))\n  /\n  sum(rate(%s_count{%s}[%s]))\n)",
+			slo.Latency.Metric, slo.Latency.Threshold, matchers, window,
+			slo.Latency.Metric, slo.Latency.Selector, window,
+		), nil
+	default:
+		return "", errors.New("exactly one of ratio or latency must be set")
+	}
+}
+
+func sloLabels(slo *serviceApi.ServiceLevelObjective) map[string]any {
+	labels := map[string]any{"slo": slo.Name}
+	if slo.Component != "" {
+		labels["component"] = slo.Component
+	}
+
+	return labels
+}
@@ -0,0 +1,212 @@
+//nolint:testpackage // Need to test unexported SLO functions
+package monitoring
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	promapiv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+
+	. "github.com/onsi/gomega"
+)
+
+type fakeSLOQuerier map[string]model.Value
+
+func (f fakeSLOQuerier) Query(_ context.Context, query string, _ time.Time, _ ...promapiv1.Option) (model.Value, promapiv1.Warnings, error) {
+	v, ok := f[query]
+	if !ok {
+		return nil, nil, errors.New("connection refused")
+	}
+
+	return v, nil, nil
+}
+
+func TestNewSLORules(t *testing.T) {
+	g := NewWithT(t)
+
+	rules, err := newSLORules("monitoring", []serviceApi.ServiceLevelObjective{
+		{
+			Name:      "dashboard-availability",
+			Component: "dashboard",
+			Target:    "99.9",
+			Window:    "30d",
+			Ratio: &serviceApi.RatioIndicator{
+				ErrorQuery: `sum(rate(haproxy_backend_http_responses_total{route="odh-dashboard",code="5xx"}[$window]))`,
+				TotalQuery: `sum(rate(haproxy_backend_http_responses_total{route="odh-dashboard"}[$window]))`,
+			},
+		},
+		{
+			Name:   "predictor-latency",
+			Target: "95",
+			Window: "1d",
+			Latency: &serviceApi.LatencyIndicator{
+				Metric:    "revision_app_request_latencies",
+				Selector:  `namespace="models"`,
+				Threshold: "0.5",
+			},
+		},
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	g.Expect(rules.GroupVersionKind()).Should(Equal(gvk.PrometheusRule))
+	g.Expect(rules.GetName()).Should(Equal(SLORulesName))
+	g.Expect(rules.GetNamespace()).Should(Equal("monitoring"))
+
+	g.Expect(rules.Object).Should(And(
+		jq.Match(`.spec.groups | length == 2`),
+		jq.Match(`.spec.groups[0].name == "slo-dashboard-availability"`),
+		jq.Match(`[.spec.groups[0].rules[] | select(.record) | .record] == [
+			"slo:sli_error:ratio_rate5m", "slo:sli_error:ratio_rate30m", "slo:sli_error:ratio_rate1h",
+			"slo:sli_error:ratio_rate2h", "slo:sli_error:ratio_rate6h", "slo:sli_error:ratio_rate1d",
+			"slo:sli_error:ratio_rate3d", "slo:error_budget_remaining:ratio"]`),
+		jq.Match(`.spec.groups[0].rules[2].expr | contains("[1h]")`),
+		jq.Match(`.spec.groups[0].rules[2].labels == {"slo": "dashboard-availability", "component": "dashboard"}`),
+		jq.Match(`.spec.groups[0].rules[7].expr | contains("avg_over_time(slo:sli_error:ratio_rate5m{slo=\"dashboard-availability\"}[30d])")`),
+		jq.Match(`[.spec.groups[0].rules[] | select(.alert) | .labels.severity] == ["critical", "critical", "warning", "warning"]`),
+		jq.Match(`.spec.groups[0].rules[8].expr | startswith("slo:sli_error:ratio_rate1h{slo=\"dashboard-availability\"} > (14.4 * (1 - 99.9 / 100))")`),
+		jq.Match(`.spec.groups[0].rules[11].expr | contains("slo:sli_error:ratio_rate6h{slo=\"dashboard-availability\"} > (1 * (1 - 99.9 / 100))")`),
+
+		// the alerts with a long window exceeding the window of the SLO are skipped
+		jq.Match(`[.spec.groups[1].rules[] | select(.alert) | .labels.long_window] == ["1h", "6h", "1d"]`),
+		jq.Match(`.spec.groups[1].rules[0].expr | contains("sum(rate(revision_app_request_latencies_bucket{le=\"0.5\",namespace=\"models\"}[5m]))")`),
+		jq.Match(`.spec.groups[1].rules[0].labels == {"slo": "predictor-latency"}`),
+	))
+
+	_, err = newSLORules("monitoring", []serviceApi.ServiceLevelObjective{{Name: "invalid", Target: "100"}})
+	g.Expect(err).Should(MatchError(ContainSubstring("invalid service level objective invalid")))
+}
+
+func TestUpdateServiceLevelObjectivesStatus(t *testing.T) {
+	g := NewWithT(t)
+
+	querier := fakeSLOQuerier{
+		`slo:error_budget_remaining:ratio`: model.Vector{
+			{Metric: model.Metric{"slo": "available"}, Value: 0.75},
+			{Metric: model.Metric{"slo": "idle"}, Value: model.SampleValue(math.NaN())},
+		},
+	}
+
+	newQuerier := newSLOQuerier
+	t.Cleanup(func() { newSLOQuerier = newQuerier })
+
+	queries := 0
+	newSLOQuerier = func(address string) (sloQuerier, error) {
+		g.Expect(address).Should(Equal("http://thanos-querier-data-science-thanos-querier.monitoring.svc.cluster.local:10902"))
+		queries++
+		return querier, nil
+	}
+
+	monitoring := serviceApi.Monitoring{
+		Spec: serviceApi.MonitoringSpec{
+			MonitoringCommonSpec: serviceApi.MonitoringCommonSpec{
+				Namespace: "monitoring",
+				Metrics:   &serviceApi.Metrics{},
+				Alerting: &serviceApi.Alerting{
+					ServiceLevelObjectives: []serviceApi.ServiceLevelObjective{
+						{Name: "available"},
+						{Name: "idle"},
+						{Name: "pending"},
+					},
+				},
+			},
+		},
+		Status: serviceApi.MonitoringStatus{
+			Backends: []serviceApi.MonitoringBackendStatus{
+				{Kind: gvk.ThanosQuerier.Kind, Ready: metav1.ConditionTrue},
+			},
+		},
+	}
+
+	rr := odhtypes.ReconciliationRequest{Instance: &monitoring}
+
+	// the budgets of all the SLOs are read with a single query
+	g.Expect(updateServiceLevelObjectivesStatus(t.Context(), &rr)).Should(Succeed())
+	g.Expect(queries).Should(Equal(1))
+	g.Expect(rr.RequeueAfter).Should(Equal(sloStatusRefreshInterval))
+	g.Expect(monitoring.Status.ServiceLevelObjectives).Should(HaveExactElements(
+		serviceApi.ServiceLevelObjectiveStatus{Name: "available", ErrorBudgetRemaining: "0.7500"},
+		serviceApi.ServiceLevelObjectiveStatus{Name: "idle", Message: "no event has been recorded over the window"},
+		serviceApi.ServiceLevelObjectiveStatus{Name: "pending", Message: "the error budget has not been recorded yet"},
+	))
+
+	// the ThanosQuerier is not queried until it is ready
+	rr = odhtypes.ReconciliationRequest{Instance: &monitoring}
+	monitoring.Status.Backends[0].Ready = metav1.ConditionFalse
+
+	g.Expect(updateServiceLevelObjectivesStatus(t.Context(), &rr)).Should(Succeed())
+	g.Expect(queries).Should(Equal(1))
+	g.Expect(rr.RequeueAfter).Should(BeZero())
+	g.Expect(monitoring.Status.ServiceLevelObjectives).Should(HaveEach(
+		HaveField("Message", "the error budget cannot be evaluated until ThanosQuerier is ready"),
+	))
+
+	// nor when it is not deployed
+	monitoring.Status.Backends = nil
+
+	g.Expect(updateServiceLevelObjectivesStatus(t.Context(), &rr)).Should(Succeed())
+	g.Expect(queries).Should(Equal(1))
+	g.Expect(monitoring.Status.ServiceLevelObjectives).Should(HaveEach(
+		HaveField("Message", "the error budget cannot be evaluated without ThanosQuerier"),
+	))
+
+	// nor without metrics
+	monitoring.Spec.Metrics = nil
+
+	g.Expect(updateServiceLevelObjectivesStatus(t.Context(), &rr)).Should(Succeed())
+	g.Expect(queries).Should(Equal(1))
+	g.Expect(monitoring.Status.ServiceLevelObjectives).Should(HaveEach(
+		HaveField("Message", "the error budget cannot be evaluated without metrics"),
+	))
+
+	monitoring.Spec.Alerting = nil
+
+	g.Expect(updateServiceLevelObjectivesStatus(t.Context(), &rr)).Should(Succeed())
+	g.Expect(monitoring.Status.ServiceLevelObjectives).Should(BeEmpty())
+}
+
+func TestUpdateServiceLevelObjectivesStatusQueryFailure(t *testing.T) {
+	g := NewWithT(t)
+
+	newQuerier := newSLOQuerier
+	t.Cleanup(func() { newSLOQuerier = newQuerier })
+
+	newSLOQuerier = func(string) (sloQuerier, error) {
+		return fakeSLOQuerier{}, nil
+	}
+
+	monitoring := serviceApi.Monitoring{
+		Spec: serviceApi.MonitoringSpec{
+			MonitoringCommonSpec: serviceApi.MonitoringCommonSpec{
+				Namespace: "monitoring",
+				Metrics:   &serviceApi.Metrics{},
+				Alerting: &serviceApi.Alerting{
+					ServiceLevelObjectives: []serviceApi.ServiceLevelObjective{{Name: "available"}},
+				},
+			},
+		},
+		Status: serviceApi.MonitoringStatus{
+			Backends: []serviceApi.MonitoringBackendStatus{
+				{Kind: gvk.ThanosQuerier.Kind, Ready: metav1.ConditionUnknown},
+			},
+		},
+	}
+
+	rr := odhtypes.ReconciliationRequest{Instance: &monitoring}
+
+	// a failed query is retried on the next refresh
+	g.Expect(updateServiceLevelObjectivesStatus(t.Context(), &rr)).Should(Succeed())
+	g.Expect(rr.RequeueAfter).Should(Equal(sloStatusRefreshInterval))
+	g.Expect(monitoring.Status.ServiceLevelObjectives).Should(HaveExactElements(
+		serviceApi.ServiceLevelObjectiveStatus{Name: "available", Message: "failed to query the error budget: connection refused"},
+	))
+}
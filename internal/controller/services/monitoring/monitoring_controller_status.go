@@ -0,0 +1,199 @@
+package monitoring
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	routev1 "github.com/openshift/api/route/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+// monitoringBackend describes how to report a backend deployed by the monitoring service.
+type monitoringBackend struct {
+	gvk schema.GroupVersionKind
+	// endpoints returns the in-cluster endpoints of the backend.
+	endpoints func(m *serviceApi.Monitoring) []serviceApi.MonitoringEndpoint
+	// routes are the Routes exposing the endpoints outside the cluster.
+	routes []backendRoute
+	// versionFields are the status fields reporting the version of the backend, in order of precedence.
+	versionFields [][]string
+}
+
+// backendRoute is a Route exposing an endpoint of a backend outside the cluster.
+type backendRoute struct {
+	endpoint string
+	name     string
+}
+
+// monitoringBackends are the backends reported in the status, in the order they are listed.
+var monitoringBackends = []monitoringBackend{
+	{
+		gvk: gvk.MonitoringStack,
+		endpoints: func(m *serviceApi.Monitoring) []serviceApi.MonitoringEndpoint {
+			return []serviceApi.MonitoringEndpoint{
+				{Name: "prometheus", URL: fmt.Sprintf("https://prometheus-operated.%s.svc:9090", m.Spec.Namespace)},
+			}
+		},
+		routes: []backendRoute{{endpoint: "prometheus", name: "data-science-prometheus-route"}},
+	},
+	{
+		gvk: gvk.ThanosQuerier,
+		endpoints: func(m *serviceApi.Monitoring) []serviceApi.MonitoringEndpoint {
+			return []serviceApi.MonitoringEndpoint{
+				{Name: "query", URL: thanosQuerierURL(m.Spec.Namespace)},
+			}
+		},
+		routes: []backendRoute{{endpoint: "query", name: "data-science-thanos-querier-route"}},
+	},
+	{
+		gvk:           gvk.TempoMonolithic,
+		endpoints:     tempoBackendEndpoints,
+		versionFields: [][]string{{"status", "tempoVersion"}, {"status", "version"}},
+	},
+	{
+		gvk:           gvk.TempoStack,
+		endpoints:     tempoBackendEndpoints,
+		versionFields: [][]string{{"status", "tempoVersion"}, {"status", "version"}},
+	},
+	{
+		gvk: gvk.OpenTelemetryCollector,
+		endpoints: func(m *serviceApi.Monitoring) []serviceApi.MonitoringEndpoint {
+			return []serviceApi.MonitoringEndpoint{
+				{Name: "otlp-grpc", URL: collectorOtlpEndpoint(m.Spec.Namespace)},
+				{Name: "otlp-http", URL: fmt.Sprintf("http://data-science-collector.%s.svc.cluster.local:4318", m.Spec.Namespace)},
+			}
+		},
+		versionFields: [][]string{{"status", "version"}},
+	},
+	{
+		gvk: gvk.Perses,
+		endpoints: func(m *serviceApi.Monitoring) []serviceApi.MonitoringEndpoint {
+			return []serviceApi.MonitoringEndpoint{
+				{Name: "ui", URL: fmt.Sprintf("http://data-science-perses.%s.svc.cluster.local:8080", m.Spec.Namespace)},
+			}
+		},
+	},
+}
+
+func tempoBackendEndpoints(m *serviceApi.Monitoring) []serviceApi.MonitoringEndpoint {
+	if m.Spec.Traces == nil {
+		return nil
+	}
+
+	ingest, query := tempoEndpoints(m.Spec.Traces.Storage.Backend, m.Spec.Namespace)
+
+	return []serviceApi.MonitoringEndpoint{
+		{Name: "otlp-grpc", URL: ingest},
+		{Name: "query", URL: query},
+	}
+}
+
+// updateBackendsStatus reports in the status the endpoints, version and health of the backends
+// deployed in the current reconciliation, so that consumers can discover them.
+func updateBackendsStatus(ctx context.Context, rr *odhtypes.ReconciliationRequest) error {
+	monitoring, ok := rr.Instance.(*serviceApi.Monitoring)
+	if !ok {
+		return errors.New("instance is not of type *services.Monitoring")
+	}
+
+	backends := make([]serviceApi.MonitoringBackendStatus, 0, len(monitoringBackends))
+
+	for _, backend := range monitoringBackends {
+		for i := range rr.Resources {
+			res := &rr.Resources[i]
+			if res.GroupVersionKind() != backend.gvk {
+				continue
+			}
+
+			bs, err := backendStatus(ctx, rr.Client, monitoring, backend, res)
+			if err != nil {
+				return err
+			}
+
+			backends = append(backends, bs)
+
+			break
+		}
+	}
+
+	monitoring.Status.Backends = backends
+
+	return nil
+}
+
+func backendStatus(
+	ctx context.Context,
+	cli client.Client,
+	monitoring *serviceApi.Monitoring,
+	backend monitoringBackend,
+	res *unstructured.Unstructured,
+) (serviceApi.MonitoringBackendStatus, error) {
+	live := unstructured.Unstructured{}
+	live.SetGroupVersionKind(backend.gvk)
+
+	var current *unstructured.Unstructured
+
+	err := cli.Get(ctx, client.ObjectKeyFromObject(res), &live)
+	switch {
+	case k8serr.IsNotFound(err):
+		// not created yet, its health is reported as unknown
+	case err != nil:
+		return serviceApi.MonitoringBackendStatus{}, fmt.Errorf("failed to get %s %s: %w", backend.gvk.Kind, res.GetName(), err)
+	default:
+		current = &live
+	}
+
+	health := resources.EvaluateHealth(current)
+
+	bs := serviceApi.MonitoringBackendStatus{
+		Kind:      backend.gvk.Kind,
+		Name:      res.GetName(),
+		Namespace: res.GetNamespace(),
+		Ready:     health.Status,
+		Reason:    health.Reason,
+		Message:   health.Message,
+		Endpoints: backend.endpoints(monitoring),
+	}
+
+	if current != nil {
+		for _, f := range backend.versionFields {
+			if v, found, _ := unstructured.NestedString(current.Object, f...); found && v != "" {
+				bs.Version = v
+				break
+			}
+		}
+	}
+
+	for _, r := range backend.routes {
+		route := routev1.Route{}
+
+		err := cli.Get(ctx, client.ObjectKey{Namespace: res.GetNamespace(), Name: r.name}, &route)
+		switch {
+		case k8serr.IsNotFound(err):
+			continue
+		case err != nil:
+			return serviceApi.MonitoringBackendStatus{}, fmt.Errorf("failed to get route %s: %w", r.name, err)
+		}
+
+		if route.Spec.Host == "" {
+			continue
+		}
+
+		bs.Endpoints = append(bs.Endpoints, serviceApi.MonitoringEndpoint{
+			Name:     r.endpoint,
+			URL:      "https://" + route.Spec.Host,
+			External: true,
+		})
+	}
+
+	return bs, nil
+}
@@ -0,0 +1,100 @@
+//nolint:testpackage // Need to test unexported status functions
+package monitoring
+
+import (
+	"testing"
+
+	routev1 "github.com/openshift/api/route/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestUpdateBackendsStatus(t *testing.T) {
+	g := NewWithT(t)
+
+	tempo := newWorkload(gvk.TempoStack, "monitoring", "data-science-tempostack")
+	tempo.Object["status"] = map[string]any{
+		"tempoVersion": "2.8.1",
+		"conditions": []any{
+			map[string]any{"type": "Ready", "status": "True", "reason": "Ready", "message": "All components are operational"},
+		},
+	}
+
+	collector := newWorkload(gvk.OpenTelemetryCollector, "monitoring", "data-science-collector")
+	collector.Object["status"] = map[string]any{
+		"version": "0.129.1",
+		"scale":   map[string]any{"statusReplicas": "1/2"},
+	}
+
+	querier := newWorkload(gvk.ThanosQuerier, "monitoring", "data-science-thanos-querier")
+
+	route := routev1.Route{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "monitoring", Name: "data-science-thanos-querier-route"},
+		Spec:       routev1.RouteSpec{Host: "thanos.apps.example.com"},
+	}
+
+	cli := newExternalTypesTestClient(t, tempo, collector, querier, &route)
+
+	monitoring := serviceApi.Monitoring{
+		Spec: serviceApi.MonitoringSpec{
+			MonitoringCommonSpec: serviceApi.MonitoringCommonSpec{
+				Namespace: "monitoring",
+				Traces: &serviceApi.Traces{
+					Storage: serviceApi.TracesStorage{Backend: serviceApi.StorageBackendS3},
+				},
+			},
+		},
+	}
+
+	rr := odhtypes.ReconciliationRequest{
+		Client:   cli,
+		Instance: &monitoring,
+		Resources: []unstructured.Unstructured{
+			*newWorkload(gvk.OpenTelemetryCollector, "monitoring", "data-science-collector"),
+			*newWorkload(gvk.TempoStack, "monitoring", "data-science-tempostack"),
+			*newWorkload(gvk.ThanosQuerier, "monitoring", "data-science-thanos-querier"),
+			*newWorkload(gvk.MonitoringStack, "monitoring", "data-science-monitoringstack"),
+		},
+	}
+
+	g.Expect(updateBackendsStatus(t.Context(), &rr)).Should(Succeed())
+
+	backends := monitoring.Status.Backends
+	g.Expect(backends).Should(HaveLen(4))
+
+	// the backends are listed in a stable order, regardless of the order of the resources
+	g.Expect(backends[0].Kind).Should(Equal("MonitoringStack"))
+	g.Expect(backends[0].Ready).Should(Equal(metav1.ConditionUnknown))
+	g.Expect(backends[0].Reason).Should(Equal("NotFound"))
+
+	g.Expect(backends[1].Kind).Should(Equal("ThanosQuerier"))
+	g.Expect(backends[1].Endpoints).Should(ConsistOf(
+		serviceApi.MonitoringEndpoint{Name: "query", URL: "http://thanos-querier-data-science-thanos-querier.monitoring.svc.cluster.local:10902"},
+		serviceApi.MonitoringEndpoint{Name: "query", URL: "https://thanos.apps.example.com", External: true},
+	))
+
+	g.Expect(backends[2]).Should(Equal(serviceApi.MonitoringBackendStatus{
+		Kind:      "TempoStack",
+		Name:      "data-science-tempostack",
+		Namespace: "monitoring",
+		Version:   "2.8.1",
+		Ready:     metav1.ConditionTrue,
+		Reason:    "Ready",
+		Message:   "All components are operational",
+		Endpoints: []serviceApi.MonitoringEndpoint{
+			{Name: "otlp-grpc", URL: "tempo-data-science-tempostack-gateway.monitoring.svc.cluster.local:4317"},
+			{Name: "query", URL: "https://tempo-data-science-tempostack-gateway.monitoring.svc.cluster.local:8080"},
+		},
+	}))
+
+	g.Expect(backends[3].Kind).Should(Equal("OpenTelemetryCollector"))
+	g.Expect(backends[3].Version).Should(Equal("0.129.1"))
+	g.Expect(backends[3].Ready).Should(Equal(metav1.ConditionFalse))
+	g.Expect(backends[3].Message).Should(Equal("1/2 replicas ready"))
+}
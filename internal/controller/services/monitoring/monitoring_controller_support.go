@@ -117,7 +117,9 @@ func isReservedName(n string) bool {
 	return reservedNames[n]
 }
 
-func validateExporters(exporters map[string]runtime.RawExtension) (map[string]string, error) {
+// validateExporters validates the exporters of the pipeline of the given signal, and returns their
+// config as YAML, keyed by name.
+func validateExporters(signal string, exporters map[string]runtime.RawExtension) (map[string]string, error) {
 	validatedExporters := make(map[string]string)
 
 	// Validate total size of all exporters combined
@@ -191,7 +193,7 @@ func validateExporters(exporters map[string]runtime.RawExtension) (map[string]st
 		}
 
 		// Schema validation for known exporter types
-		if err := validateExporterSchema(name, config); err != nil {
+		if err := validateExporterSchema(name, signal, config); err != nil {
 			return nil, err
 		}
 
@@ -207,8 +209,31 @@ func validateExporters(exporters map[string]runtime.RawExtension) (map[string]st
 	return validatedExporters, nil
 }
 
+// collectorOtlpEndpoint returns the OTLP gRPC endpoint of the collector deployed in the monitoring namespace.
+func collectorOtlpEndpoint(namespace string) string {
+	return fmt.Sprintf("http://data-science-collector.%s.svc.cluster.local:4317", namespace)
+}
+
+// thanosQuerierURL returns the query endpoint of the ThanosQuerier deployed in the monitoring namespace.
+func thanosQuerierURL(namespace string) string {
+	return fmt.Sprintf("http://thanos-querier-data-science-thanos-querier.%s.svc.cluster.local:10902", namespace)
+}
+
+// tempoEndpoints returns the OTLP gRPC ingestion endpoint and the query endpoint of the Tempo
+// instance deployed for the given storage backend. The query endpoint goes through the gateway
+// (port 8080), which is HTTPS-only.
+func tempoEndpoints(backend string, namespace string) (string, string) {
+	if backend == serviceApi.StorageBackendPV {
+		return fmt.Sprintf("tempo-data-science-tempomonolithic.%s.svc.cluster.local:4317", namespace),
+			fmt.Sprintf("https://tempo-data-science-tempomonolithic-gateway.%s.svc.cluster.local:8080", namespace)
+	}
+
+	return fmt.Sprintf("tempo-data-science-tempostack-gateway.%s.svc.cluster.local:4317", namespace),
+		fmt.Sprintf("https://tempo-data-science-tempostack-gateway.%s.svc.cluster.local:8080", namespace)
+}
+
 func addTracesTemplateData(templateData map[string]any, traces *serviceApi.Traces, namespace string) error {
-	templateData["OtlpEndpoint"] = fmt.Sprintf("http://data-science-collector.%s.svc.cluster.local:4317", namespace)
+	templateData["OtlpEndpoint"] = collectorOtlpEndpoint(namespace)
 	templateData["SampleRatio"] = traces.SampleRatio
 	templateData["Backend"] = traces.Storage.Backend // backend has default "pv" set in API
 
@@ -234,14 +259,10 @@ func addTracesTemplateData(templateData map[string]any, traces *serviceApi.Trace
 	// Note: Gateway endpoints always use HTTPS (service-ca auto-provisions TLS)
 	switch traces.Storage.Backend {
 	case serviceApi.StorageBackendPV:
-		templateData["TempoEndpoint"] = fmt.Sprintf("tempo-data-science-tempomonolithic.%s.svc.cluster.local:4317", namespace)
-		// Perses datasource query endpoint via gateway (port 8080) - always uses HTTPS (gateway is HTTPS-only)
-		templateData["TempoQueryEndpoint"] = fmt.Sprintf("https://tempo-data-science-tempomonolithic-gateway.%s.svc.cluster.local:8080", namespace)
+		templateData["TempoEndpoint"], templateData["TempoQueryEndpoint"] = tempoEndpoints(traces.Storage.Backend, namespace)
 		templateData["Size"] = traces.Storage.Size
 	case serviceApi.StorageBackendS3, serviceApi.StorageBackendGCS:
-		templateData["TempoEndpoint"] = fmt.Sprintf("tempo-data-science-tempostack-gateway.%s.svc.cluster.local:4317", namespace)
-		// Perses datasource query endpoint via gateway (port 8080) - always uses HTTPS (gateway is HTTPS-only)
-		templateData["TempoQueryEndpoint"] = fmt.Sprintf("https://tempo-data-science-tempostack-gateway.%s.svc.cluster.local:8080", namespace)
+		templateData["TempoEndpoint"], templateData["TempoQueryEndpoint"] = tempoEndpoints(traces.Storage.Backend, namespace)
 		templateData["Secret"] = traces.Storage.Secret
 	}
 
@@ -251,7 +272,7 @@ func addTracesTemplateData(templateData map[string]any, traces *serviceApi.Trace
 	exporterNames := make([]string, 0)
 	if traces.Exporters != nil {
 		var err error
-		validatedExporters, err = validateExporters(traces.Exporters)
+		validatedExporters, err = validateExporters(signalTraces, traces.Exporters)
 		if err != nil {
 			return err
 		}
@@ -267,6 +288,48 @@ func addTracesTemplateData(templateData map[string]any, traces *serviceApi.Trace
 	return nil
 }
 
+// addLogsTemplateData adds the logs exporters data to the template data map. An exporter can be
+// shared with the metrics or traces pipelines, as long as its config is the same, as the collector
+// defines it once.
+func addLogsTemplateData(templateData map[string]any, logs *serviceApi.Logs) error {
+	validatedExporters, err := validateExporters(signalLogs, logs.Exporters)
+	if err != nil {
+		return err
+	}
+
+	exporterNames := make([]string, 0, len(validatedExporters))
+	logsOnlyExporterNames := make([]string, 0, len(validatedExporters))
+
+	for name, config := range validatedExporters {
+		exporterNames = append(exporterNames, name)
+
+		shared := false
+		for _, key := range []string{"MetricsExporters", "TracesExporters"} {
+			exporters, _ := templateData[key].(map[string]string)
+			if other, ok := exporters[name]; ok {
+				if other != config {
+					return fmt.Errorf("exporter '%s' is configured differently for logs and %s",
+						name, strings.ToLower(strings.TrimSuffix(key, "Exporters")))
+				}
+				shared = true
+			}
+		}
+
+		if !shared {
+			logsOnlyExporterNames = append(logsOnlyExporterNames, name)
+		}
+	}
+
+	sort.Strings(exporterNames)
+	sort.Strings(logsOnlyExporterNames)
+
+	templateData["LogsExporters"] = validatedExporters
+	templateData["LogsExporterNames"] = exporterNames
+	templateData["LogsOnlyExporterNames"] = logsOnlyExporterNames
+
+	return nil
+}
+
 // Images can be overridden via environment variables, with defaults based on platform.
 func addImageURLs(rr *odhtypes.ReconciliationRequest, templateData map[string]any) {
 	templateData["KubeRBACProxyImage"] = getImageURL(
@@ -320,15 +383,19 @@ func getTemplateData(ctx context.Context, rr *odhtypes.ReconciliationRequest) (m
 	}
 
 	templateData := map[string]any{
-		"Namespace":            monitoring.Spec.Namespace,
-		"Traces":               monitoring.Spec.Traces != nil,
-		"Metrics":              monitoring.Spec.Metrics != nil,
-		"AcceleratorMetrics":   monitoring.Spec.Metrics != nil,
-		"ApplicationNamespace": appNamespace,
-		"OperatorNamespace":    operatorNamespace,
-		"MetricsExporters":     make(map[string]string),
-		"MetricsExporterNames": []string{},
-		"PersesImage":          getPersesImage(),
+		"Namespace":             monitoring.Spec.Namespace,
+		"Traces":                monitoring.Spec.Traces != nil,
+		"Metrics":               monitoring.Spec.Metrics != nil,
+		"Logs":                  monitoring.Spec.Logs != nil,
+		"AcceleratorMetrics":    monitoring.Spec.Metrics != nil,
+		"ApplicationNamespace":  appNamespace,
+		"OperatorNamespace":     operatorNamespace,
+		"MetricsExporters":      make(map[string]string),
+		"MetricsExporterNames":  []string{},
+		"LogsExporters":         make(map[string]string),
+		"LogsExporterNames":     []string{},
+		"LogsOnlyExporterNames": []string{},
+		"PersesImage":           getPersesImage(),
 	}
 
 	// always add resource defaults
@@ -349,6 +416,13 @@ func getTemplateData(ctx context.Context, rr *odhtypes.ReconciliationRequest) (m
 		}
 	}
 
+	// Add logs-related data if logs are configured
+	if logs := monitoring.Spec.Logs; logs != nil {
+		if err := addLogsTemplateData(templateData, logs); err != nil {
+			return nil, err
+		}
+	}
+
 	templateData["CollectorReplicas"] = monitoring.Spec.CollectorReplicas
 
 	return templateData, nil
@@ -384,8 +458,8 @@ func checkMonitoringPreconditions(ctx context.Context, rr *odhtypes.Reconciliati
 	}
 	var allErrors *multierror.Error
 
-	// Check for opentelemetry-product operator if either metrics or traces are enabled
-	if monitoring.Spec.Metrics != nil || monitoring.Spec.Traces != nil {
+	// Check for opentelemetry-product operator if either metrics, traces or logs are enabled
+	if monitoring.Spec.Metrics != nil || monitoring.Spec.Traces != nil || monitoring.Spec.Logs != nil {
 		if openTelemetryInfo, err := cluster.OperatorExists(ctx, rr.Client, opentelemetryOperator); err != nil || openTelemetryInfo == nil {
 			if err != nil {
 				return odherrors.NewStopErrorW(err)
@@ -535,7 +609,7 @@ func addExportersData(metrics *serviceApi.Metrics, templateData map[string]any)
 
 	// Validate exporters using the same function as traces
 	var err error
-	validatedExporters, err = validateExporters(metrics.Exporters)
+	validatedExporters, err = validateExporters(signalMetrics, metrics.Exporters)
 	if err != nil {
 		return err
 	}
@@ -590,176 +664,6 @@ const (
 	maxTotalExporterSize = 51200 // Maximum total size for all exporters combined (50KB).
 )
 
-// ExporterSchema defines the validation schema for an exporter type.
-type ExporterSchema struct {
-	RequiredFields []string
-	AllowedFields  []string
-	FieldTypes     map[string]FieldType
-	FieldRules     map[string][]ValidationRule
-}
-
-// FieldType defines the expected type and constraints for a field.
-type FieldType struct {
-	Type          string
-	Pattern       *regexp.Regexp
-	MinLength     *int
-	MaxLength     *int
-	AllowedValues []string
-}
-
-// ValidationRule defines custom validation logic.
-type ValidationRule struct {
-	Name     string
-	Validate func(field string, value interface{}) error
-}
-
-// Schema definitions for metrics exporters.
-var metricsExporterSchemas = map[string]ExporterSchema{
-	"otlp": {
-		RequiredFields: []string{"endpoint"},
-		AllowedFields: []string{
-			"endpoint", "headers", "tls", "compression", "timeout",
-			"retry_on_failure", "sending_queue", "balancer_name",
-		},
-		FieldTypes: map[string]FieldType{
-			"endpoint": {
-				Type:      "string",
-				Pattern:   regexp.MustCompile(`^https?://[a-zA-Z0-9.-]+(:[0-9]+)?(/.*)?$`),
-				MinLength: intPtr(1),
-				MaxLength: intPtr(2048),
-			},
-			"headers": {
-				Type: "map[string]string",
-			},
-			"tls": {
-				Type: "object",
-			},
-			"compression": {
-				Type:          "string",
-				AllowedValues: []string{"gzip", "snappy", "zstd", "none"},
-			},
-			"timeout": {
-				Type:      "string",
-				Pattern:   regexp.MustCompile(`^\d+[smh]$`),
-				MaxLength: intPtr(10),
-			},
-		},
-		FieldRules: map[string][]ValidationRule{
-			"endpoint": {
-				{
-					Name: "secure_endpoint_check",
-					Validate: func(field string, value interface{}) error {
-						if str, ok := value.(string); ok {
-							if strings.HasPrefix(str, "http://") && !isLocalServiceEndpoint(str) {
-								return errors.New("insecure HTTP endpoints not allowed for external services")
-							}
-						}
-						return nil
-					},
-				},
-			},
-		},
-	},
-	"otlphttp": {
-		RequiredFields: []string{"endpoint"},
-		AllowedFields: []string{
-			"endpoint", "headers", "tls", "compression", "timeout",
-			"retry_on_failure", "sending_queue",
-		},
-		FieldTypes: map[string]FieldType{
-			"endpoint": {
-				Type:      "string",
-				Pattern:   regexp.MustCompile(`^https?://[a-zA-Z0-9.-]+(:[0-9]+)?(/.*)?$`),
-				MinLength: intPtr(1),
-				MaxLength: intPtr(2048),
-			},
-			"headers": {
-				Type: "map[string]string",
-			},
-			"compression": {
-				Type:          "string",
-				AllowedValues: []string{"gzip", "none"},
-			},
-			"timeout": {
-				Type:      "string",
-				Pattern:   regexp.MustCompile(`^\d+[smh]$`),
-				MaxLength: intPtr(10),
-			},
-		},
-		FieldRules: map[string][]ValidationRule{
-			"endpoint": {
-				{
-					Name: "secure_endpoint_check",
-					Validate: func(field string, value interface{}) error {
-						if str, ok := value.(string); ok {
-							if strings.HasPrefix(str, "http://") && !isLocalServiceEndpoint(str) {
-								return errors.New("insecure HTTP endpoints not allowed for external services")
-							}
-						}
-						return nil
-					},
-				},
-			},
-		},
-	},
-	"debug": {
-		AllowedFields: []string{"verbosity", "sampling_initial", "sampling_thereafter"},
-		FieldTypes: map[string]FieldType{
-			"verbosity": {
-				Type:          "string",
-				AllowedValues: []string{"basic", "normal", "detailed"},
-			},
-			"sampling_initial": {
-				Type: "int",
-			},
-			"sampling_thereafter": {
-				Type: "int",
-			},
-		},
-	},
-	"prometheusremotewrite": {
-		RequiredFields: []string{"endpoint"},
-		AllowedFields: []string{
-			"endpoint", "headers", "tls", "remote_timeout", "retry_on_failure",
-			"sending_queue", "write_relabel_configs", "resource_to_telemetry_conversion",
-		},
-		FieldTypes: map[string]FieldType{
-			"endpoint": {
-				Type:      "string",
-				Pattern:   regexp.MustCompile(`^https?://[a-zA-Z0-9.-]+(:[0-9]+)?(/.*)?$`),
-				MinLength: intPtr(1),
-				MaxLength: intPtr(2048),
-			},
-			"headers": {
-				Type: "map[string]string",
-			},
-			"tls": {
-				Type: "object",
-			},
-			"remote_timeout": {
-				Type:      "string",
-				Pattern:   regexp.MustCompile(`^\d+[smh]$`),
-				MaxLength: intPtr(10),
-			},
-		},
-		FieldRules: map[string][]ValidationRule{
-			"endpoint": {
-				{
-					Name: "secure_endpoint_check",
-					Validate: func(field string, value interface{}) error {
-						if str, ok := value.(string); ok {
-							if strings.HasPrefix(str, "http://") && !isLocalServiceEndpoint(str) {
-								return errors.New("insecure HTTP endpoints not allowed for external services")
-							}
-						}
-						return nil
-					},
-				},
-			},
-		},
-	},
-}
-
 // validateExporterConfigSecurity performs additional security validations on exporter configurations.
 func validateExporterConfigSecurity(name string, config map[string]interface{}) error {
 	// Check maximum number of fields
@@ -820,153 +724,6 @@ func validateConfigDepthAndTypes(obj interface{}, depth int, exporterName string
 	return nil
 }
 
-// validateExporterSchema validates an exporter config against its schema.
-func validateExporterSchema(exporterName string, config map[string]interface{}) error {
-	exporterType := getExporterType(exporterName)
-	schema, exists := metricsExporterSchemas[exporterType]
-
-	if !exists {
-		// For unknown exporters, schema validation is skipped
-		// Security validation already applied above
-		return nil
-	}
-
-	return schema.Validate(exporterName, config)
-}
-
-// getExporterType extracts the base exporter type from a name like "otlp/custom".
-func getExporterType(exporterName string) string {
-	if idx := strings.Index(exporterName, "/"); idx != -1 {
-		return exporterName[:idx]
-	}
-	return exporterName
-}
-
-// Validate validates an exporter config against the schema.
-func (s ExporterSchema) Validate(exporterName string, config map[string]interface{}) error {
-	// Check required fields
-	for _, required := range s.RequiredFields {
-		if _, exists := config[required]; !exists {
-			return fmt.Errorf("exporter '%s' missing required field: %s", exporterName, required)
-		}
-	}
-
-	// Check for disallowed fields
-	for field := range config {
-		if !contains(s.AllowedFields, field) {
-			return fmt.Errorf("exporter '%s' contains disallowed field: %s (allowed: %v)",
-				exporterName, field, s.AllowedFields)
-		}
-	}
-
-	// Validate field types and constraints
-	for field, value := range config {
-		if fieldType, exists := s.FieldTypes[field]; exists {
-			if err := validateFieldTypeAndConstraints(exporterName, field, value, fieldType); err != nil {
-				return err
-			}
-		}
-
-		// Apply custom validation rules
-		if rules, exists := s.FieldRules[field]; exists {
-			for _, rule := range rules {
-				if err := rule.Validate(field, value); err != nil {
-					return fmt.Errorf("exporter '%s' field '%s' failed rule '%s': %w",
-						exporterName, field, rule.Name, err)
-				}
-			}
-		}
-	}
-
-	return nil
-}
-
-// validateFieldTypeAndConstraints validates field type and applies constraints.
-func validateFieldTypeAndConstraints(exporterName, field string, value interface{}, fieldType FieldType) error {
-	// Type validation
-	if err := validateFieldTypeStrict(field, value, fieldType.Type); err != nil {
-		return fmt.Errorf("exporter '%s' field '%s': %w", exporterName, field, err)
-	}
-
-	// String-specific constraints
-	if str, ok := value.(string); ok && fieldType.Type == "string" {
-		if fieldType.MinLength != nil && len(str) < *fieldType.MinLength {
-			return fmt.Errorf("exporter '%s' field '%s': minimum length %d, got %d",
-				exporterName, field, *fieldType.MinLength, len(str))
-		}
-		if fieldType.MaxLength != nil && len(str) > *fieldType.MaxLength {
-			return fmt.Errorf("exporter '%s' field '%s': maximum length %d, got %d",
-				exporterName, field, *fieldType.MaxLength, len(str))
-		}
-		if fieldType.Pattern != nil && !fieldType.Pattern.MatchString(str) {
-			return fmt.Errorf("exporter '%s' field '%s': does not match required pattern",
-				exporterName, field)
-		}
-		if len(fieldType.AllowedValues) > 0 && !contains(fieldType.AllowedValues, str) {
-			return fmt.Errorf("exporter '%s' field '%s': must be one of %v, got '%s'",
-				exporterName, field, fieldType.AllowedValues, str)
-		}
-	}
-
-	return nil
-}
-
-// validateFieldTypeStrict validates field types with enhanced error messages.
-func validateFieldTypeStrict(_ string, value interface{}, expectedType string) error {
-	switch expectedType {
-	case "string":
-		if _, ok := value.(string); !ok {
-			return fmt.Errorf("expected string, got %T", value)
-		}
-	case "int":
-		switch value.(type) {
-		case int, int32, int64, float64: // JSON unmarshals numbers as float64
-			// Valid numeric types
-		default:
-			return fmt.Errorf("expected int, got %T", value)
-		}
-	case "bool":
-		if _, ok := value.(bool); !ok {
-			return fmt.Errorf("expected bool, got %T", value)
-		}
-	case "object":
-		if _, ok := value.(map[string]interface{}); !ok {
-			return fmt.Errorf("expected object, got %T", value)
-		}
-	case "array":
-		if _, ok := value.([]interface{}); !ok {
-			return fmt.Errorf("expected array, got %T", value)
-		}
-	case "map[string]string":
-		if m, ok := value.(map[string]interface{}); ok {
-			for _, v := range m {
-				if _, ok := v.(string); !ok {
-					return fmt.Errorf("map value must be string, got %T", v)
-				}
-			}
-		} else {
-			return fmt.Errorf("expected map[string]string, got %T", value)
-		}
-	default:
-		return fmt.Errorf("unsupported field type: %s", expectedType)
-	}
-	return nil
-}
-
-// Helper functions.
-func contains(slice []string, item string) bool {
-	for _, s := range slice {
-		if s == item {
-			return true
-		}
-	}
-	return false
-}
-
-func intPtr(i int) *int {
-	return &i
-}
-
 // syncPrometheusWebTLSCA watches the prometheus-web-tls-ca ConfigMap and syncs its CA to a Secret.
 // This action is a workaround until COO-1270 is complete, which will allow MonitoringStack
 // to consume CA directly from ConfigMap. The service-ca operator injects the CA into the ConfigMap,
@@ -466,7 +466,7 @@ func TestCustomMetricsExporters(t *testing.T) {
 				"otlp/test": stringToRawExtension("headers:\n  auth: token"), // Missing required 'endpoint'
 			},
 			expectError: true,
-			errorMsg:    "missing required field: endpoint",
+			errorMsg:    "config.endpoint in body is required",
 		},
 		{
 			name: "schema validation - disallowed field",
@@ -474,7 +474,7 @@ func TestCustomMetricsExporters(t *testing.T) {
 				"otlp/test": stringToRawExtension("endpoint: https://example.com\ninvalid_field: value"),
 			},
 			expectError: true,
-			errorMsg:    "contains disallowed field: invalid_field",
+			errorMsg:    "config.invalid_field in body is a forbidden property",
 		},
 		{
 			name: "schema validation - invalid compression",
@@ -482,7 +482,7 @@ func TestCustomMetricsExporters(t *testing.T) {
 				"otlp/test": stringToRawExtension("endpoint: https://example.com\ncompression: invalid"),
 			},
 			expectError: true,
-			errorMsg:    "must be one of [gzip snappy zstd none]",
+			errorMsg:    "config.compression in body should be one of [gzip snappy zstd none]",
 		},
 		{
 			name: "schema validation - invalid verbosity",
@@ -490,7 +490,7 @@ func TestCustomMetricsExporters(t *testing.T) {
 				"debug": stringToRawExtension("verbosity: invalid"),
 			},
 			expectError: true,
-			errorMsg:    "must be one of [basic normal detailed]",
+			errorMsg:    "config.verbosity in body should be one of [basic normal detailed]",
 		},
 		{
 			name: "schema validation - insecure endpoint blocked",
@@ -533,7 +533,7 @@ func TestCustomMetricsExporters(t *testing.T) {
 				"otlp/test": stringToRawExtension("endpoint: not-a-url"), // Invalid URL format
 			},
 			expectError: true,
-			errorMsg:    "does not match required pattern",
+			errorMsg:    "config.endpoint in body should match",
 		},
 		{
 			name: "field type mismatch - endpoint as number",
@@ -541,7 +541,7 @@ func TestCustomMetricsExporters(t *testing.T) {
 				"otlp/test": stringToRawExtension("endpoint: 12345"), // Should be string, not number
 			},
 			expectError: true,
-			errorMsg:    "expected string",
+			errorMsg:    "config.endpoint in body must be of type string",
 		},
 		{
 			name: "exporter size exceeds 10KB limit",
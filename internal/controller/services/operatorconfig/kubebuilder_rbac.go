@@ -0,0 +1,5 @@
+package operatorconfig
+
+// +kubebuilder:rbac:groups=services.platform.opendatahub.io,resources=operatorconfigs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=services.platform.opendatahub.io,resources=operatorconfigs/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=services.platform.opendatahub.io,resources=operatorconfigs/finalizers,verbs=update
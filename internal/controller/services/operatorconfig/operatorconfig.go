@@ -0,0 +1,54 @@
+package operatorconfig
+
+import (
+	"context"
+	"fmt"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	sr "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/registry"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/reconciler"
+)
+
+const (
+	ServiceName = serviceApi.OperatorConfigServiceName
+)
+
+//nolint:gochecknoinits
+func init() {
+	sr.Add(&ServiceHandler{})
+}
+
+type ServiceHandler struct {
+}
+
+func (h *ServiceHandler) Init(_ common.Platform) error {
+	return nil
+}
+
+func (h *ServiceHandler) GetName() string {
+	return ServiceName
+}
+
+func (h *ServiceHandler) GetManagementState(_ common.Platform, _ *dsciv2.DSCInitialization) operatorv1.ManagementState {
+	return operatorv1.Managed
+}
+
+func (h *ServiceHandler) NewReconciler(ctx context.Context, mgr ctrl.Manager) error {
+	_, err := reconciler.ReconcilerFor(mgr, &serviceApi.OperatorConfig{}).
+		// actions
+		WithAction(applyRuntimeConfig).
+		WithAction(updatePendingRestart).
+		WithFinalizer(resetRuntimeConfig).
+		Build(ctx)
+
+	if err != nil {
+		return fmt.Errorf("could not create the %s controller: %w", ServiceName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package operatorconfig
+
+import (
+	"context"
+	"fmt"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	odhtypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+)
+
+func applyRuntimeConfig(_ context.Context, rr *odhtypes.ReconciliationRequest) error {
+	oc, ok := rr.Instance.(*serviceApi.OperatorConfig)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a serviceApi.OperatorConfig)", rr.Instance)
+	}
+
+	return ApplyRuntimeConfig(Merge(Defaults(), oc.Spec))
+}
+
+func updatePendingRestart(_ context.Context, rr *odhtypes.ReconciliationRequest) error {
+	oc, ok := rr.Instance.(*serviceApi.OperatorConfig)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a serviceApi.OperatorConfig)", rr.Instance)
+	}
+
+	oc.Status.PendingRestart = PendingRestart(Running(), Merge(Defaults(), oc.Spec))
+
+	return nil
+}
+
+// resetRuntimeConfig restores the runtime settings from the flags and environment variables
+// when the OperatorConfig is deleted.
+func resetRuntimeConfig(_ context.Context, _ *odhtypes.ReconciliationRequest) error {
+	return ApplyRuntimeConfig(Defaults())
+}
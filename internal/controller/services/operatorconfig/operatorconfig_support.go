@@ -0,0 +1,193 @@
+package operatorconfig
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/setup"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/status/releases"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/reconciler"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/logger"
+)
+
+// Settings reported in the status of the OperatorConfig when they are applied on restart.
+const (
+	SettingLoggingMode     = "logging.mode"
+	SettingLeaderElection  = "leaderElection"
+	SettingComponents      = "components"
+	SettingServices        = "services"
+	SettingCacheNamespaces = "cache.namespaces"
+	SettingWebhooks        = "webhooks.enabled"
+)
+
+var (
+	// requiredServices can't be disabled by spec.services, the operator can't be configured or
+	// uninstalled without them.
+	requiredServices = []string{ServiceName, setup.ServiceName}
+
+	mu       sync.RWMutex
+	defaults serviceApi.OperatorConfigSpec
+	running  serviceApi.OperatorConfigSpec
+)
+
+// Load returns the OperatorConfig of the cluster, nil if it does not exist or its CRD is not
+// installed yet.
+func Load(ctx context.Context, cli client.Reader) (*serviceApi.OperatorConfig, error) {
+	oc := serviceApi.OperatorConfig{}
+
+	err := cli.Get(ctx, client.ObjectKey{Name: serviceApi.OperatorConfigInstanceName}, &oc)
+	switch {
+	case k8serr.IsNotFound(err) || meta.IsNoMatchError(err):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to get %s %s: %w", serviceApi.OperatorConfigKind, serviceApi.OperatorConfigInstanceName, err)
+	}
+
+	return &oc, nil
+}
+
+// Startup records the configuration the operator is started with: base is the configuration set
+// by the flags and environment variables, overridden by the spec of oc when not nil. It returns
+// the resulting configuration.
+func Startup(base serviceApi.OperatorConfigSpec, oc *serviceApi.OperatorConfig) serviceApi.OperatorConfigSpec {
+	effective := *base.DeepCopy()
+	if oc != nil {
+		effective = Merge(base, oc.Spec)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	defaults = *base.DeepCopy()
+	running = *effective.DeepCopy()
+
+	return effective
+}
+
+// Defaults returns the configuration set by the flags and environment variables.
+func Defaults() serviceApi.OperatorConfigSpec {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	return *defaults.DeepCopy()
+}
+
+// Running returns the configuration the operator has been started with.
+func Running() serviceApi.OperatorConfigSpec {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	return *running.DeepCopy()
+}
+
+// Merge returns base overridden by the settings of spec that are set.
+func Merge(base serviceApi.OperatorConfigSpec, spec serviceApi.OperatorConfigSpec) serviceApi.OperatorConfigSpec {
+	result := *base.DeepCopy()
+
+	if spec.Logging.Level != "" {
+		result.Logging.Level = spec.Logging.Level
+	}
+	if spec.Logging.Mode != "" {
+		result.Logging.Mode = spec.Logging.Mode
+	}
+	if spec.LeaderElection != nil {
+		result.LeaderElection = spec.LeaderElection.DeepCopy()
+	}
+	if len(spec.Components) > 0 {
+		result.Components = slices.Clone(spec.Components)
+	}
+	if len(spec.Services) > 0 {
+		result.Services = slices.Clone(spec.Services)
+	}
+	if len(spec.Cache.Namespaces) > 0 {
+		result.Cache.Namespaces = slices.Clone(spec.Cache.Namespaces)
+	}
+	if spec.Webhooks.Enabled != nil {
+		result.Webhooks.Enabled = ptr.To(*spec.Webhooks.Enabled)
+	}
+	if len(spec.DefaultManagementStates) > 0 {
+		result.DefaultManagementStates = spec.DeepCopy().DefaultManagementStates
+	}
+	if spec.Reconcile.MaxConcurrentReconciles > 0 {
+		result.Reconcile.MaxConcurrentReconciles = spec.Reconcile.MaxConcurrentReconciles
+	}
+	if spec.Reconcile.ReleaseStatus != "" {
+		result.Reconcile.ReleaseStatus = spec.Reconcile.ReleaseStatus
+	}
+
+	return result
+}
+
+// ApplyRuntimeConfig applies the settings of spec that can be changed without restarting the
+// operator.
+func ApplyRuntimeConfig(spec serviceApi.OperatorConfigSpec) error {
+	if err := logger.SetLevel(spec.Logging.Level); err != nil {
+		return fmt.Errorf("failed to set log level: %w", err)
+	}
+
+	if spec.Reconcile.MaxConcurrentReconciles > 0 {
+		reconciler.SetMaxConcurrentReconciles(int(spec.Reconcile.MaxConcurrentReconciles))
+	}
+
+	releases.SetImageRevisions(spec.Reconcile.ReleaseStatus == serviceApi.ReleaseStatusRevisions)
+
+	return nil
+}
+
+// PendingRestart returns the settings of desired that differ from running and are applied when
+// the operator restarts.
+func PendingRestart(running serviceApi.OperatorConfigSpec, desired serviceApi.OperatorConfigSpec) []string {
+	var pending []string
+
+	if running.Logging.Mode != desired.Logging.Mode {
+		pending = append(pending, SettingLoggingMode)
+	}
+	if leaderElectionEnabled(running) != leaderElectionEnabled(desired) {
+		pending = append(pending, SettingLeaderElection)
+	}
+	if !equalSets(running.Components, desired.Components) {
+		pending = append(pending, SettingComponents)
+	}
+	if !equalSets(running.Services, desired.Services) {
+		pending = append(pending, SettingServices)
+	}
+	if !equalSets(running.Cache.Namespaces, desired.Cache.Namespaces) {
+		pending = append(pending, SettingCacheNamespaces)
+	}
+	if running.WebhooksEnabled() != desired.WebhooksEnabled() {
+		pending = append(pending, SettingWebhooks)
+	}
+
+	return pending
+}
+
+// ComponentAllowed returns true if the component can be managed by the operator.
+func ComponentAllowed(spec serviceApi.OperatorConfigSpec, name string) bool {
+	return len(spec.Components) == 0 || slices.Contains(spec.Components, name)
+}
+
+// ServiceAllowed returns true if the service can be run by the operator.
+func ServiceAllowed(spec serviceApi.OperatorConfigSpec, name string) bool {
+	return len(spec.Services) == 0 || slices.Contains(spec.Services, name) || slices.Contains(requiredServices, name)
+}
+
+func leaderElectionEnabled(spec serviceApi.OperatorConfigSpec) bool {
+	return spec.LeaderElection != nil && spec.LeaderElection.Enabled
+}
+
+func equalSets(a []string, b []string) bool {
+	a = slices.Clone(a)
+	b = slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+
+	return slices.Equal(slices.Compact(a), slices.Compact(b))
+}
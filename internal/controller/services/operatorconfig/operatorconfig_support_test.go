@@ -0,0 +1,124 @@
+//nolint:testpackage
+package operatorconfig
+
+import (
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"k8s.io/utils/ptr"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/setup"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestMerge(t *testing.T) {
+	g := NewWithT(t)
+
+	base := serviceApi.OperatorConfigSpec{
+		Logging:        serviceApi.OperatorLoggingSpec{Level: "info", Mode: "production"},
+		LeaderElection: &serviceApi.OperatorLeaderElectionSpec{Enabled: true},
+		Reconcile:      serviceApi.OperatorReconcileSpec{MaxConcurrentReconciles: 1},
+	}
+
+	merged := Merge(base, serviceApi.OperatorConfigSpec{
+		Logging:                 serviceApi.OperatorLoggingSpec{Level: "debug"},
+		Components:              []string{"dashboard"},
+		Webhooks:                serviceApi.OperatorWebhooksSpec{Enabled: ptr.To(false)},
+		DefaultManagementStates: map[string]operatorv1.ManagementState{"ray": operatorv1.Removed},
+		Reconcile: serviceApi.OperatorReconcileSpec{
+			MaxConcurrentReconciles: 4,
+			ReleaseStatus:           serviceApi.ReleaseStatusRevisions,
+		},
+	})
+
+	g.Expect(merged.Logging.Level).Should(Equal("debug"))
+	g.Expect(merged.Logging.Mode).Should(Equal("production"))
+	g.Expect(merged.LeaderElection).Should(Equal(&serviceApi.OperatorLeaderElectionSpec{Enabled: true}))
+	g.Expect(merged.Components).Should(ConsistOf("dashboard"))
+	g.Expect(merged.Services).Should(BeEmpty())
+	g.Expect(merged.WebhooksEnabled()).Should(BeFalse())
+	g.Expect(merged.DefaultManagementStates).Should(HaveKeyWithValue("ray", operatorv1.Removed))
+	g.Expect(merged.Reconcile.MaxConcurrentReconciles).Should(BeEquivalentTo(4))
+	g.Expect(merged.Reconcile.ReleaseStatus).Should(Equal(serviceApi.ReleaseStatusRevisions))
+
+	// base is not modified
+	g.Expect(base.Logging.Level).Should(Equal("info"))
+	g.Expect(base.Webhooks.Enabled).Should(BeNil())
+}
+
+func TestPendingRestart(t *testing.T) {
+	running := serviceApi.OperatorConfigSpec{
+		Logging:    serviceApi.OperatorLoggingSpec{Level: "info", Mode: "production"},
+		Components: []string{"dashboard", "ray"},
+		Reconcile:  serviceApi.OperatorReconcileSpec{MaxConcurrentReconciles: 1},
+	}
+
+	tests := []struct {
+		name     string
+		desired  func(spec *serviceApi.OperatorConfigSpec)
+		expected []string
+	}{
+		{
+			name:     "no change",
+			desired:  func(spec *serviceApi.OperatorConfigSpec) {},
+			expected: nil,
+		},
+		{
+			name: "runtime settings only",
+			desired: func(spec *serviceApi.OperatorConfigSpec) {
+				spec.Logging.Level = "debug"
+				spec.Reconcile.MaxConcurrentReconciles = 8
+			},
+			expected: nil,
+		},
+		{
+			name: "same components in a different order",
+			desired: func(spec *serviceApi.OperatorConfigSpec) {
+				spec.Components = []string{"ray", "dashboard"}
+			},
+			expected: nil,
+		},
+		{
+			name: "restart settings",
+			desired: func(spec *serviceApi.OperatorConfigSpec) {
+				spec.Logging.Mode = "development"
+				spec.LeaderElection = &serviceApi.OperatorLeaderElectionSpec{Enabled: true}
+				spec.Components = []string{"dashboard"}
+				spec.Cache.Namespaces = []string{"my-namespace"}
+				spec.Webhooks.Enabled = ptr.To(false)
+			},
+			expected: []string{
+				SettingLoggingMode,
+				SettingLeaderElection,
+				SettingComponents,
+				SettingCacheNamespaces,
+				SettingWebhooks,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			desired := *running.DeepCopy()
+			tt.desired(&desired)
+
+			g.Expect(PendingRestart(running, desired)).Should(Equal(tt.expected))
+		})
+	}
+}
+
+func TestServiceAllowed(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(ServiceAllowed(serviceApi.OperatorConfigSpec{}, "monitoring")).Should(BeTrue())
+
+	spec := serviceApi.OperatorConfigSpec{Services: []string{"auth"}}
+	g.Expect(ServiceAllowed(spec, "auth")).Should(BeTrue())
+	g.Expect(ServiceAllowed(spec, "monitoring")).Should(BeFalse())
+	g.Expect(ServiceAllowed(spec, ServiceName)).Should(BeTrue())
+	g.Expect(ServiceAllowed(spec, setup.ServiceName)).Should(BeTrue())
+}
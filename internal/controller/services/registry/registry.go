@@ -23,6 +23,7 @@ type ServiceHandler interface {
 // Registry is a struct that maintains a list of registered ServiceHandlers.
 type Registry struct {
 	handlers []ServiceHandler
+	allowed  func(name string) bool
 }
 
 var r = &Registry{}
@@ -33,12 +34,36 @@ func (r *Registry) Add(ch ServiceHandler) {
 	r.handlers = append(r.handlers, ch)
 }
 
-// ForEach iterates over all registered ServiceHandlers and applies the given function.
+// SetAllowed restricts the handlers ForEach iterates over to the ones whose name is accepted
+// by allowed, nil allows all the handlers.
+// not thread safe, supposed to be called during init.
+func (r *Registry) SetAllowed(allowed func(name string) bool) {
+	r.allowed = allowed
+}
+
+// Names returns the names of all the registered ServiceHandlers, including the ones not allowed.
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.handlers))
+	for _, ch := range r.handlers {
+		names = append(names, ch.GetName())
+	}
+
+	return names
+}
+
+func (r *Registry) isAllowed(ch ServiceHandler) bool {
+	return r.allowed == nil || r.allowed(ch.GetName())
+}
+
+// ForEach iterates over all allowed registered ServiceHandlers and applies the given function.
 // If any handler returns an error, that error is collected and returned at the end.
 // With go1.23 probably https://go.dev/blog/range-functions can be used.
 func (r *Registry) ForEach(f func(ch ServiceHandler) error) error {
 	var errs *multierror.Error
 	for _, ch := range r.handlers {
+		if !r.isAllowed(ch) {
+			continue
+		}
 		errs = multierror.Append(errs, f(ch))
 	}
 
@@ -93,6 +93,8 @@ const (
 	ConditionPersesTempoDataSourceAvailable      = "PersesTempoDataSourceAvailable"
 	ConditionPersesPrometheusDataSourceAvailable = "PersesPrometheusDataSourceAvailable"
 	ConditionNodeMetricsEndpointAvailable        = "NodeMetricsEndpointAvailable"
+	ConditionFieldOwnershipConflict              = "FieldOwnershipConflict"
+	ConditionGatewayAvailable                    = "GatewayAvailable"
 )
 
 const (
@@ -104,6 +106,9 @@ const (
 	ArgoWorkflowExist         string = "ArgoWorkflowExist"
 	NoManagedComponentsReason        = "NoManagedComponents"
 
+	FieldManagerConflictReason = "FieldManagerConflict"
+	UnknownGatewayReason       = "UnknownGateway"
+
 	AvailableReason = "Available"
 	NotReadyReason  = "NotReady"
 	ReadyReason     = "Ready"
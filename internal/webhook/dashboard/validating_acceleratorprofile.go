@@ -3,13 +3,19 @@
 package dashboard
 
 import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/deprecation"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/upgrade"
 	webhookutils "github.com/opendatahub-io/opendatahub-operator/v2/pkg/webhook"
 )
 
@@ -21,7 +27,10 @@ const (
 //nolint:lll
 //+kubebuilder:webhook:path=/validate-dashboard-acceleratorprofile,mutating=false,failurePolicy=fail,sideEffects=None,groups=dashboard.opendatahub.io,resources=acceleratorprofiles,verbs=create;update,versions=v1,name=dashboard-acceleratorprofile-validator.opendatahub.io,admissionReviewVersions=v1
 
-func NewAcceleratorProfileWebhook(s *runtime.Scheme) *deprecation.TypeValidator {
+// NewAcceleratorProfileWebhook returns the AcceleratorProfile deprecation webhook. The migration
+// hints use the container sizes of the OdhDashboardConfig read with cli, the default ones when
+// cli is nil.
+func NewAcceleratorProfileWebhook(s *runtime.Scheme, cli client.Client) *deprecation.TypeValidator {
 	return &deprecation.TypeValidator{
 		Decoder:     admission.NewDecoder(s),
 		Name:        AcceleratorProfileValidateName,
@@ -30,11 +39,62 @@ func NewAcceleratorProfileWebhook(s *runtime.Scheme) *deprecation.TypeValidator
 			DeprecatedGVK:  gvk.DashboardAcceleratorProfile,
 			ReplacementGVK: gvk.HardwareProfile,
 		},
+		Phase:   deprecation.PhaseDenyAll,
+		Convert: acceleratorProfileConverter(cli),
 	}
 }
 
+// acceleratorProfileConverter returns the conversion of AcceleratorProfiles to the
+// HardwareProfiles the upgrade creates for them.
+func acceleratorProfileConverter(cli client.Client) deprecation.ConvertFunc {
+	return func(ctx context.Context, obj *unstructured.Unstructured) ([]client.Object, error) {
+		odhConfig, err := dashboardConfig(ctx, cli)
+		if err != nil {
+			return nil, err
+		}
+
+		hwps, err := upgrade.ConvertAcceleratorProfileToHardwareProfiles(ctx, *obj, odhConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		objs := make([]client.Object, 0, len(hwps))
+		for _, hwp := range hwps {
+			objs = append(objs, hwp)
+		}
+
+		return objs, nil
+	}
+}
+
+// dashboardConfig returns the OdhDashboardConfig the upgrade reads the container sizes from, an
+// empty one, resulting in the default sizes, when it is not available.
+func dashboardConfig(ctx context.Context, cli client.Client) (*unstructured.Unstructured, error) {
+	empty := &unstructured.Unstructured{Object: map[string]any{"spec": map[string]any{}}}
+	if cli == nil {
+		return empty, nil
+	}
+
+	appNamespace, err := cluster.ApplicationNamespace(ctx, cli)
+	if err != nil {
+		return nil, err
+	}
+
+	odhConfig, found, err := upgrade.GetOdhDashboardConfig(ctx, cli, appNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return empty, nil
+	}
+
+	return odhConfig, nil
+}
+
 func RegisterAcceleratorProfileWebhook(mgr ctrl.Manager) error {
-	validator := NewAcceleratorProfileWebhook(mgr.GetScheme())
+	validator := NewAcceleratorProfileWebhook(mgr.GetScheme(), mgr.GetClient())
+	validator.Policy = deprecation.OperatorConfigPolicy(mgr.GetClient())
 
 	mgr.GetWebhookServer().Register(
 		validator.WebhookPath,
@@ -30,10 +30,12 @@ func NewHardwareProfileWebhook(s *runtime.Scheme) *deprecation.TypeValidator {
 			DeprecatedGVK:  gvk.DashboardHardwareProfile,
 			ReplacementGVK: gvk.HardwareProfile,
 		},
+		Phase: deprecation.PhaseDenyAll,
 	}
 }
 func RegisterHardwareProfileWebhook(mgr ctrl.Manager) error {
 	validator := NewHardwareProfileWebhook(mgr.GetScheme())
+	validator.Policy = deprecation.OperatorConfigPolicy(mgr.GetClient())
 
 	mgr.GetWebhookServer().Register(
 		validator.WebhookPath,
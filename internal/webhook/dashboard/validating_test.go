@@ -16,6 +16,7 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -44,7 +45,7 @@ func TestValidator_Unit(t *testing.T) {
 	}{
 		{
 			name:      "AcceleratorProfile",
-			validator: dashboard.NewAcceleratorProfileWebhook(s),
+			validator: dashboard.NewAcceleratorProfileWebhook(s, nil),
 		},
 		{
 			name:      "HardwareProfile",
@@ -132,6 +133,43 @@ func TestValidator_Unit(t *testing.T) {
 	}
 }
 
+func TestAcceleratorProfileWebhook_MigrationHints(t *testing.T) {
+	g := NewWithT(t)
+
+	s, err := scheme.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	req := admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			Kind: metav1.GroupVersionKind{
+				Group:   gvk.DashboardAcceleratorProfile.Group,
+				Version: gvk.DashboardAcceleratorProfile.Version,
+				Kind:    gvk.DashboardAcceleratorProfile.Kind,
+			},
+			Object: runtime.RawExtension{
+				Raw: []byte(`{
+					"apiVersion": "dashboard.opendatahub.io/v1",
+					"kind": "AcceleratorProfile",
+					"metadata": {"name": "nvidia-gpu", "namespace": "opendatahub"},
+					"spec": {"identifier": "nvidia.com/gpu", "displayName": "NVIDIA GPU", "enabled": true}
+				}`),
+			},
+		},
+	}
+
+	resp := dashboard.NewAcceleratorProfileWebhook(s, nil).Handle(t.Context(), req)
+
+	g.Expect(resp.Allowed).Should(BeFalse())
+	g.Expect(resp.Warnings).Should(HaveExactElements(
+		And(
+			HavePrefix("migrate to infrastructure.opendatahub.io/HardwareProfile nvidia-gpu-notebooks: "),
+			ContainSubstring(`"identifier":"nvidia.com/gpu"`),
+		),
+		HavePrefix("migrate to infrastructure.opendatahub.io/HardwareProfile nvidia-gpu-serving: "),
+	))
+}
+
 func TestValidator_Integration(t *testing.T) {
 	g := NewWithT(t)
 
@@ -145,7 +183,7 @@ func TestValidator_Integration(t *testing.T) {
 
 				if err := registerWebhookTypeValidatorWithBypass(
 					mgr,
-					dashboard.NewAcceleratorProfileWebhook(mgr.GetScheme()),
+					dashboard.NewAcceleratorProfileWebhook(mgr.GetScheme(), mgr.GetClient()),
 					bypassFunc,
 				); err != nil {
 					return err
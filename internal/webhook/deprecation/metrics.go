@@ -0,0 +1,37 @@
+//go:build !nowebhook
+
+package deprecation
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	// RequestsTotal is a prometheus counter metrics which holds the total number of
+	// create and update requests on deprecated APIs. It has five labels.
+	// group, version and kind labels refer to the deprecated API.
+	// operation label refers to the admission operation.
+	// phase label refers to the deprecation phase of the API when the request was received.
+	RequestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "deprecated_api_requests_total",
+			Help: "Number of create and update requests on deprecated APIs",
+		},
+		[]string{
+			"group",
+			"version",
+			"kind",
+			"operation",
+			"phase",
+		},
+	)
+)
+
+// init register metrics to the global registry from controller-runtime/pkg/metrics.
+// see https://book.kubebuilder.io/reference/metrics#publishing-additional-metrics
+//
+//nolint:gochecknoinits
+func init() {
+	metrics.Registry.MustRegister(RequestsTotal)
+}
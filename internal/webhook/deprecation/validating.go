@@ -4,30 +4,114 @@ package deprecation
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
 	webhookutils "github.com/opendatahub-io/opendatahub-operator/v2/pkg/webhook"
 )
 
+// Phase is a phase of the deprecation of an API.
+type Phase string
+
+const (
+	// PhaseWarn allows the creation and the update of resources, with admission warnings.
+	PhaseWarn Phase = "Warn"
+	// PhaseDenyNew denies the creation of resources, and allows the update of the existing ones
+	// with admission warnings.
+	PhaseDenyNew Phase = "DenyNew"
+	// PhaseDenyAll denies the creation and the update of resources.
+	PhaseDenyAll Phase = "DenyAll"
+)
+
 type TypeMap struct {
 	DeprecatedGVK  schema.GroupVersionKind
 	ReplacementGVK schema.GroupVersionKind
 }
 
-// TypeValidator is a generic webhook that denies CREATE and UPDATE operations
-// on deprecated API resources and directs users to the replacement API.
+// PhaseTransition moves a deprecated API to a phase from the given date, e.g. to PhaseDenyAll at
+// its sunset date.
+type PhaseTransition struct {
+	Phase Phase
+	From  time.Time
+}
+
+// Policy is the phase and the schedule of the deprecation of an API.
+type Policy struct {
+	Phase    Phase
+	Schedule []PhaseTransition
+}
+
+// PolicyFunc returns the deprecation policy configured for an API, nil when not configured.
+type PolicyFunc func(ctx context.Context, deprecated schema.GroupVersionKind) (*Policy, error)
+
+// OperatorConfigPolicy returns the deprecation policies set in spec.webhooks.deprecations of the
+// OperatorConfig.
+func OperatorConfigPolicy(cli client.Reader) PolicyFunc {
+	return func(ctx context.Context, deprecated schema.GroupVersionKind) (*Policy, error) {
+		oc := serviceApi.OperatorConfig{}
+
+		err := cli.Get(ctx, client.ObjectKey{Name: serviceApi.OperatorConfigInstanceName}, &oc)
+		switch {
+		case k8serr.IsNotFound(err):
+			return nil, nil
+		case err != nil:
+			return nil, fmt.Errorf("failed to get OperatorConfig: %w", err)
+		}
+
+		for _, d := range oc.Spec.Webhooks.Deprecations {
+			if d.Group != deprecated.Group || d.Kind != deprecated.Kind {
+				continue
+			}
+
+			policy := Policy{Phase: Phase(d.Phase)}
+			for _, t := range d.Schedule {
+				policy.Schedule = append(policy.Schedule, PhaseTransition{Phase: Phase(t.Phase), From: t.From.Time})
+			}
+
+			return &policy, nil
+		}
+
+		return nil, nil
+	}
+}
+
+// ConvertFunc converts a resource of the deprecated API to the resources of the replacement API.
+type ConvertFunc func(ctx context.Context, obj *unstructured.Unstructured) ([]client.Object, error)
+
+// TypeValidator is a generic webhook that handles CREATE and UPDATE operations on deprecated API
+// resources according to the deprecation phase of the API, and directs users to the replacement
+// API. The submitted resources are converted to the replacement API, when a conversion is
+// available, and returned in the admission warnings so that users can apply them.
 type TypeValidator struct {
 	TypeMap
 
+	// Phase is the phase applied until the first transition of the schedule, PhaseDenyAll when
+	// not set.
+	Phase Phase
+	// Schedule moves the API to later phases at the given dates, in chronological order.
+	Schedule []PhaseTransition
+	// Policy overrides Phase and Schedule with the policy configured at runtime, optional.
+	Policy PolicyFunc
+	// Convert generates the migration hint of the submitted resources, optional.
+	Convert ConvertFunc
+	// Now returns the current time, time.Now when not set.
+	Now func() time.Time
+
 	Decoder     admission.Decoder
 	Name        string
 	WebhookPath string
@@ -58,13 +142,32 @@ func (v *TypeValidator) Handle(ctx context.Context, req admission.Request) admis
 
 	switch req.Operation {
 	case admissionv1.Create, admissionv1.Update:
-		msg := fmt.Sprintf("%s/%s is not supported, please use %s/%s",
+		v := v.withPolicy(ctx)
+		now := v.now()
+		phase := v.CurrentPhase(now)
+
+		RequestsTotal.WithLabelValues(
 			v.DeprecatedGVK.Group,
+			v.DeprecatedGVK.Version,
 			v.DeprecatedGVK.Kind,
-			v.ReplacementGVK.Group,
-			v.ReplacementGVK.Kind,
-		)
-		resp = admission.Denied(msg)
+			string(req.Operation),
+			string(phase),
+		).Inc()
+
+		if phase == PhaseDenyAll || (phase == PhaseDenyNew && req.Operation == admissionv1.Create) {
+			msg := fmt.Sprintf("%s/%s is not supported, please use %s/%s",
+				v.DeprecatedGVK.Group,
+				v.DeprecatedGVK.Kind,
+				v.ReplacementGVK.Group,
+				v.ReplacementGVK.Kind,
+			)
+			resp = admission.Denied(msg)
+		} else {
+			resp = admission.Allowed(fmt.Sprintf("Operation %s on deprecated %s allowed", req.Operation, req.Kind))
+			resp.Warnings = append(resp.Warnings, v.deprecationWarning(now))
+		}
+
+		resp.Warnings = append(resp.Warnings, v.migrationHints(ctx, req)...)
 	default:
 		resp = admission.Allowed(fmt.Sprintf("Operation %s on %s allowed", req.Operation, req.Kind))
 	}
@@ -72,6 +175,129 @@ func (v *TypeValidator) Handle(ctx context.Context, req admission.Request) admis
 	return resp
 }
 
+// CurrentPhase returns the deprecation phase of the API at the given time.
+func (v *TypeValidator) CurrentPhase(now time.Time) Phase {
+	phase := v.Phase
+	if phase == "" {
+		phase = PhaseDenyAll
+	}
+
+	for _, t := range v.Schedule {
+		if now.Before(t.From) {
+			break
+		}
+
+		phase = t.Phase
+	}
+
+	return phase
+}
+
+// withPolicy returns the validator with the phase and the schedule of the policy configured at
+// runtime, the validator itself when no policy is configured.
+func (v *TypeValidator) withPolicy(ctx context.Context) *TypeValidator {
+	if v.Policy == nil {
+		return v
+	}
+
+	policy, err := v.Policy(ctx, v.DeprecatedGVK)
+	if err != nil {
+		logf.FromContext(ctx).Error(err, "failed to get the deprecation policy, using the default one")
+		return v
+	}
+
+	if policy == nil {
+		return v
+	}
+
+	out := *v
+	out.Phase = policy.Phase
+	out.Schedule = policy.Schedule
+
+	return &out
+}
+
+func (v *TypeValidator) deprecationWarning(now time.Time) string {
+	msg := fmt.Sprintf("%s/%s is deprecated, please use %s/%s",
+		v.DeprecatedGVK.Group,
+		v.DeprecatedGVK.Kind,
+		v.ReplacementGVK.Group,
+		v.ReplacementGVK.Kind,
+	)
+
+	current := v.CurrentPhase(now)
+
+	for _, t := range v.Schedule {
+		if !now.Before(t.From) || t.Phase == current {
+			continue
+		}
+
+		switch t.Phase {
+		case PhaseDenyNew:
+			return fmt.Sprintf("%s, new resources will be denied from %s", msg, t.From.Format(time.DateOnly))
+		case PhaseDenyAll:
+			return fmt.Sprintf("%s, it will be denied from %s", msg, t.From.Format(time.DateOnly))
+		}
+	}
+
+	return msg
+}
+
+// migrationHints returns the conversion of the submitted resource to the replacement API, as
+// single line JSON documents that can be applied as they are. A failing conversion is logged and
+// does not affect the admission.
+func (v *TypeValidator) migrationHints(ctx context.Context, req admission.Request) []string {
+	if v.Convert == nil || len(req.Object.Raw) == 0 {
+		return nil
+	}
+
+	log := logf.FromContext(ctx)
+
+	obj := unstructured.Unstructured{}
+	if err := v.Decoder.DecodeRaw(req.Object, &obj); err != nil {
+		log.Error(err, "failed to decode the deprecated resource")
+		return nil
+	}
+
+	converted, err := v.Convert(ctx, &obj)
+	if err != nil {
+		log.Error(err, "failed to convert the deprecated resource", "name", obj.GetName())
+		return nil
+	}
+
+	hints := make([]string, 0, len(converted))
+
+	for _, c := range converted {
+		u, err := resources.ToUnstructured(c)
+		if err != nil {
+			log.Error(err, "failed to convert the replacement resource", "name", c.GetName())
+			return nil
+		}
+
+		unstructured.RemoveNestedField(u.Object, "metadata", "creationTimestamp")
+		unstructured.RemoveNestedField(u.Object, "status")
+
+		data, err := json.Marshal(u.Object)
+		if err != nil {
+			log.Error(err, "failed to marshal the replacement resource", "name", c.GetName())
+			return nil
+		}
+
+		hints = append(hints, fmt.Sprintf("migrate to %s/%s %s: %s",
+			v.ReplacementGVK.Group, v.ReplacementGVK.Kind, c.GetName(), data))
+	}
+
+	return hints
+}
+
+func (v *TypeValidator) now() time.Time {
+	if v.Now != nil {
+		return v.Now()
+	}
+
+	return time.Now()
+}
+
 func (v *TypeValidator) isExpectedKind(kind metav1.GroupVersionKind) bool {
 	return kind.Group == v.DeprecatedGVK.Group &&
 		kind.Version == v.DeprecatedGVK.Version &&
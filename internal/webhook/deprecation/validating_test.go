@@ -0,0 +1,203 @@
+//go:build !nowebhook
+
+package deprecation_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/deprecation"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/scheme"
+
+	. "github.com/onsi/gomega"
+)
+
+var (
+	warnFrom    = time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	denyNewFrom = time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+	sunset      = time.Date(2026, time.June, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func newValidator(t *testing.T, now time.Time) *deprecation.TypeValidator {
+	t.Helper()
+
+	s, err := scheme.New()
+	NewWithT(t).Expect(err).ShouldNot(HaveOccurred())
+
+	return &deprecation.TypeValidator{
+		TypeMap: deprecation.TypeMap{
+			DeprecatedGVK:  gvk.DashboardAcceleratorProfile,
+			ReplacementGVK: gvk.HardwareProfile,
+		},
+		Phase: deprecation.PhaseWarn,
+		Schedule: []deprecation.PhaseTransition{
+			{Phase: deprecation.PhaseDenyNew, From: denyNewFrom},
+			{Phase: deprecation.PhaseDenyAll, From: sunset},
+		},
+		Convert: func(_ context.Context, obj *unstructured.Unstructured) ([]client.Object, error) {
+			return []client.Object{
+				&corev1.ConfigMap{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+					ObjectMeta: metav1.ObjectMeta{Name: obj.GetName() + "-converted", Namespace: obj.GetNamespace()},
+				},
+			}, nil
+		},
+		Now:     func() time.Time { return now },
+		Decoder: admission.NewDecoder(s),
+	}
+}
+
+func newRequest(operation admissionv1.Operation) admission.Request {
+	return admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{
+			Operation: operation,
+			Kind: metav1.GroupVersionKind{
+				Group:   gvk.DashboardAcceleratorProfile.Group,
+				Version: gvk.DashboardAcceleratorProfile.Version,
+				Kind:    gvk.DashboardAcceleratorProfile.Kind,
+			},
+			Object: runtime.RawExtension{
+				Raw: []byte(`{"apiVersion":"dashboard.opendatahub.io/v1","kind":"AcceleratorProfile","metadata":{"name":"gpu","namespace":"apps"}}`),
+			},
+		},
+	}
+}
+
+func TestTypeValidator_CurrentPhase(t *testing.T) {
+	g := NewWithT(t)
+
+	v := newValidator(t, warnFrom)
+	g.Expect(v.CurrentPhase(warnFrom)).Should(Equal(deprecation.PhaseWarn))
+	g.Expect(v.CurrentPhase(denyNewFrom)).Should(Equal(deprecation.PhaseDenyNew))
+	g.Expect(v.CurrentPhase(sunset.Add(time.Hour))).Should(Equal(deprecation.PhaseDenyAll))
+
+	// the phase defaults to deny all
+	g.Expect((&deprecation.TypeValidator{}).CurrentPhase(warnFrom)).Should(Equal(deprecation.PhaseDenyAll))
+}
+
+func TestTypeValidator_Phases(t *testing.T) {
+	hint := `migrate to infrastructure.opendatahub.io/HardwareProfile gpu-converted: {"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"gpu-converted","namespace":"apps"}}`
+
+	testCases := []struct {
+		name      string
+		now       time.Time
+		operation admissionv1.Operation
+		allowed   bool
+		warnings  []string
+	}{
+		{
+			name:      "Warn - should allow create with warnings",
+			now:       warnFrom,
+			operation: admissionv1.Create,
+			allowed:   true,
+			warnings: []string{
+				"dashboard.opendatahub.io/AcceleratorProfile is deprecated, please use infrastructure.opendatahub.io/HardwareProfile, new resources will be denied from 2026-03-01",
+				hint,
+			},
+		},
+		{
+			name:      "DenyNew - should deny create",
+			now:       denyNewFrom,
+			operation: admissionv1.Create,
+			allowed:   false,
+			warnings:  []string{hint},
+		},
+		{
+			name:      "DenyNew - should allow update with warnings",
+			now:       denyNewFrom,
+			operation: admissionv1.Update,
+			allowed:   true,
+			warnings: []string{
+				"dashboard.opendatahub.io/AcceleratorProfile is deprecated, please use infrastructure.opendatahub.io/HardwareProfile, it will be denied from 2026-06-01",
+				hint,
+			},
+		},
+		{
+			name:      "DenyAll - should deny update",
+			now:       sunset,
+			operation: admissionv1.Update,
+			allowed:   false,
+			warnings:  []string{hint},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			resp := newValidator(t, tc.now).Handle(t.Context(), newRequest(tc.operation))
+
+			g.Expect(resp.Allowed).Should(Equal(tc.allowed))
+			g.Expect(resp.Warnings).Should(Equal(tc.warnings))
+
+			if !tc.allowed {
+				g.Expect(resp.Result.Code).Should(Equal(int32(http.StatusForbidden)))
+				g.Expect(resp.Result.Message).Should(ContainSubstring("is not supported"))
+			}
+		})
+	}
+}
+
+func TestTypeValidator_OperatorConfigPolicy(t *testing.T) {
+	g := NewWithT(t)
+
+	oc := &serviceApi.OperatorConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: serviceApi.OperatorConfigInstanceName},
+		Spec: serviceApi.OperatorConfigSpec{
+			Webhooks: serviceApi.OperatorWebhooksSpec{
+				Deprecations: []serviceApi.DeprecatedAPISpec{{
+					Group: gvk.DashboardAcceleratorProfile.Group,
+					Kind:  gvk.DashboardAcceleratorProfile.Kind,
+					Phase: serviceApi.DeprecationPhase(deprecation.PhaseWarn),
+					Schedule: []serviceApi.DeprecationPhaseTransition{
+						{Phase: serviceApi.DeprecationPhase(deprecation.PhaseDenyAll), From: metav1.NewTime(sunset)},
+					},
+				}},
+			},
+		},
+	}
+
+	cli, err := fakeclient.New(fakeclient.WithObjects(oc))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	policy, err := deprecation.OperatorConfigPolicy(cli)(t.Context(), gvk.DashboardAcceleratorProfile)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(policy.Phase).Should(Equal(deprecation.PhaseWarn))
+	g.Expect(policy.Schedule).Should(HaveLen(1))
+	g.Expect(policy.Schedule[0].From.Equal(sunset)).Should(BeTrue())
+
+	// the APIs that are not configured keep the policy of the validator
+	policy, err = deprecation.OperatorConfigPolicy(cli)(t.Context(), gvk.DashboardHardwareProfile)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(policy).Should(BeNil())
+
+	// the configured policy overrides the one of the validator, the create is allowed before the sunset
+	v := newValidator(t, denyNewFrom)
+	v.Policy = deprecation.OperatorConfigPolicy(cli)
+
+	resp := v.Handle(t.Context(), newRequest(admissionv1.Create))
+	g.Expect(resp.Allowed).Should(BeTrue())
+	g.Expect(resp.Warnings).Should(ContainElement(ContainSubstring("it will be denied from 2026-06-01")))
+
+	// without OperatorConfig, the policy of the validator applies
+	empty, err := fakeclient.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	v.Policy = deprecation.OperatorConfigPolicy(empty)
+
+	resp = v.Handle(t.Context(), newRequest(admissionv1.Create))
+	g.Expect(resp.Allowed).Should(BeFalse())
+}
@@ -0,0 +1,392 @@
+//go:build !nowebhook
+
+package kueue
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+// QueueValidationMode is the mode of the LocalQueue checks.
+type QueueValidationMode string
+
+const (
+	// QueueValidationEnforce denies the workloads failing the LocalQueue checks.
+	QueueValidationEnforce QueueValidationMode = "enforce"
+	// QueueValidationWarn admits the workloads failing the LocalQueue checks with admission
+	// warnings, to migrate existing namespaces.
+	QueueValidationWarn QueueValidationMode = "warn"
+
+	localQueueCRDName = "localqueues.kueue.x-k8s.io"
+)
+
+// podSet is a group of identical pods of a workload.
+type podSet struct {
+	count    int64
+	requests corev1.ResourceList
+}
+
+// queueValidationMode returns the mode of the LocalQueue checks for the given namespace, set
+// with the cluster.KueueQueueValidationAnnotation annotation, QueueValidationEnforce when not set.
+func queueValidationMode(ns client.Object) QueueValidationMode {
+	if mode := QueueValidationMode(resources.GetAnnotation(ns, cluster.KueueQueueValidationAnnotation)); mode == QueueValidationWarn {
+		return mode
+	}
+
+	return QueueValidationEnforce
+}
+
+// validateQueue checks that the LocalQueue a workload is submitted to exists, that it points to
+// an active ClusterQueue and that the resources requested by the workload could ever fit the
+// quota of the ClusterQueue. It returns the reason of the failing check, if any. The checks are
+// skipped when Kueue is not installed.
+func validateQueue(ctx context.Context, cli client.Reader, namespace string, queueName string, obj *unstructured.Unstructured) (string, error) {
+	crd := apiextensionsv1.CustomResourceDefinition{}
+
+	err := cli.Get(ctx, client.ObjectKey{Name: localQueueCRDName}, &crd)
+	switch {
+	case k8serr.IsNotFound(err):
+		return "", nil
+	case err != nil:
+		return "", fmt.Errorf("failed to check if %s CRD exists: %w", gvk.LocalQueue.Kind, err)
+	}
+
+	queues := unstructured.UnstructuredList{}
+	queues.SetGroupVersionKind(gvk.LocalQueue.GroupVersion().WithKind(gvk.LocalQueue.Kind + "List"))
+
+	if err := cli.List(ctx, &queues, client.InNamespace(namespace)); err != nil {
+		return "", fmt.Errorf("failed to list LocalQueues in namespace %q: %w", namespace, err)
+	}
+
+	var localQueue *unstructured.Unstructured
+
+	names := make([]string, 0, len(queues.Items))
+	for i := range queues.Items {
+		names = append(names, queues.Items[i].GetName())
+		if queues.Items[i].GetName() == queueName {
+			localQueue = &queues.Items[i]
+		}
+	}
+
+	if localQueue == nil {
+		if len(names) == 0 {
+			return fmt.Sprintf("LocalQueue %q not found in namespace %q, no LocalQueue is available in the namespace", queueName, namespace), nil
+		}
+
+		slices.Sort(names)
+
+		return fmt.Sprintf("LocalQueue %q not found in namespace %q, available LocalQueues: %s", queueName, namespace, strings.Join(names, ", ")), nil
+	}
+
+	clusterQueueName, _, _ := unstructured.NestedString(localQueue.Object, "spec", "clusterQueue")
+
+	clusterQueue := unstructured.Unstructured{}
+	clusterQueue.SetGroupVersionKind(gvk.ClusterQueue)
+
+	err = cli.Get(ctx, client.ObjectKey{Name: clusterQueueName}, &clusterQueue)
+	switch {
+	case k8serr.IsNotFound(err):
+		return fmt.Sprintf("ClusterQueue %q of LocalQueue %q does not exist", clusterQueueName, queueName), nil
+	case err != nil:
+		return "", fmt.Errorf("failed to get ClusterQueue %q: %w", clusterQueueName, err)
+	}
+
+	// a ClusterQueue without the Active condition has not been reconciled yet, it is not
+	// considered inactive
+	if active, message := clusterQueueActive(&clusterQueue); !active {
+		return fmt.Sprintf("ClusterQueue %q of LocalQueue %q is not active: %s", clusterQueueName, queueName, message), nil
+	}
+
+	podSets, err := workloadPodSets(obj)
+	if err != nil {
+		return "", err
+	}
+
+	if reason := exceedsQuota(&clusterQueue, podSets); reason != "" {
+		return fmt.Sprintf("workload can never be admitted by ClusterQueue %q of LocalQueue %q: %s", clusterQueueName, queueName, reason), nil
+	}
+
+	return "", nil
+}
+
+func clusterQueueActive(cq *unstructured.Unstructured) (bool, string) {
+	conditions, _, _ := unstructured.NestedSlice(cq.Object, "status", "conditions")
+
+	for _, raw := range conditions {
+		c, ok := raw.(map[string]any)
+		if !ok || c["type"] != "Active" {
+			continue
+		}
+
+		if c["status"] != "False" {
+			return true, ""
+		}
+
+		message, _ := c["message"].(string)
+		if message == "" {
+			message, _ = c["reason"].(string)
+		}
+
+		return false, message
+	}
+
+	return true, ""
+}
+
+// exceedsQuota returns the reason why the pod sets can never fit the quota of the ClusterQueue,
+// if any. The capacity of a resource is its nominal quota summed over the flavors, plus the
+// borrowing limit when the ClusterQueue belongs to a cohort. A resource not covered by the quota
+// is never admitted, the resources that can be borrowed without limit are not checked.
+func exceedsQuota(cq *unstructured.Unstructured, podSets []podSet) string {
+	requested := corev1.ResourceList{}
+	for _, ps := range podSets {
+		for name, q := range ps.requests {
+			total := requested[name]
+			total.Add(multiplyQuantity(q, ps.count))
+			requested[name] = total
+		}
+	}
+
+	capacity, unbounded := clusterQueueCapacity(cq)
+
+	names := make([]string, 0, len(requested))
+	for name := range requested {
+		names = append(names, string(name))
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		r := corev1.ResourceName(name)
+		q := requested[r]
+		if q.IsZero() {
+			continue
+		}
+
+		available, covered := capacity[r]
+		if !covered {
+			return fmt.Sprintf("requests %s of %s, not covered by the quota", q.String(), name)
+		}
+		if unbounded[r] {
+			continue
+		}
+
+		if q.Cmp(available) > 0 {
+			return fmt.Sprintf("requests %s of %s, more than the quota of %s", q.String(), name, available.String())
+		}
+	}
+
+	return ""
+}
+
+// multiplyQuantity returns q multiplied by count, computed in milli-units. A product overflowing
+// the milli-units saturates at the largest quantity, so that an absurd number of replicas is
+// reported as exceeding the quota.
+func multiplyQuantity(q resource.Quantity, count int64) resource.Quantity {
+	if count <= 0 || q.Sign() <= 0 {
+		return resource.Quantity{Format: q.Format}
+	}
+
+	if q.Cmp(*resource.NewMilliQuantity(math.MaxInt64/count, q.Format)) > 0 {
+		return *resource.NewQuantity(math.MaxInt64, q.Format)
+	}
+
+	return *resource.NewMilliQuantity(q.MilliValue()*count, q.Format)
+}
+
+// clusterQueueCapacity returns the capacity of each resource of the quota of the ClusterQueue,
+// and the resources that can be borrowed from the cohort without limit.
+func clusterQueueCapacity(cq *unstructured.Unstructured) (corev1.ResourceList, map[corev1.ResourceName]bool) {
+	capacity := corev1.ResourceList{}
+	unbounded := map[corev1.ResourceName]bool{}
+
+	cohort, _, _ := unstructured.NestedString(cq.Object, "spec", "cohort")
+	groups, _, _ := unstructured.NestedSlice(cq.Object, "spec", "resourceGroups")
+
+	for _, g := range groups {
+		group, ok := g.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		flavors, _, _ := unstructured.NestedSlice(group, "flavors")
+		for _, f := range flavors {
+			flavor, ok := f.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			quotas, _, _ := unstructured.NestedSlice(flavor, "resources")
+			for _, rq := range quotas {
+				quota, ok := rq.(map[string]any)
+				if !ok {
+					continue
+				}
+
+				name, _ := quota["name"].(string)
+				r := corev1.ResourceName(name)
+
+				total := capacity[r]
+				total.Add(parseQuantity(quota["nominalQuota"]))
+
+				if cohort != "" {
+					if limit, ok := quota["borrowingLimit"]; ok {
+						total.Add(parseQuantity(limit))
+					} else {
+						unbounded[r] = true
+					}
+				}
+
+				capacity[r] = total
+			}
+		}
+	}
+
+	return capacity, unbounded
+}
+
+func parseQuantity(v any) resource.Quantity {
+	q, err := resource.ParseQuantity(fmt.Sprint(v))
+	if err != nil {
+		return resource.Quantity{}
+	}
+
+	return q
+}
+
+// workloadPodSets returns the pod sets of the workloads with pod templates, the workloads of the
+// other kinds, e.g. InferenceServices, have no pod sets and are not checked against the quota.
+func workloadPodSets(obj *unstructured.Unstructured) ([]podSet, error) {
+	var podSets []podSet
+	var err error
+
+	switch obj.GetKind() {
+	case gvk.Notebook.Kind:
+		podSets, err = appendPodSet(podSets, obj.Object, 1, "spec", "template")
+	case gvk.PyTorchJob.Kind:
+		replicaSpecs, _, _ := unstructured.NestedMap(obj.Object, "spec", "pytorchReplicaSpecs")
+		for _, rs := range replicaSpecs {
+			spec, ok := rs.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			if podSets, err = appendPodSet(podSets, spec, replicas(spec), "template"); err != nil {
+				break
+			}
+		}
+	case gvk.RayClusterV1.Kind:
+		podSets, err = rayClusterPodSets(obj.Object, "spec")
+	case gvk.RayJobV1.Kind:
+		podSets, err = rayClusterPodSets(obj.Object, "spec", "rayClusterSpec")
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the pod templates of %s %q: %w", obj.GetKind(), obj.GetName(), err)
+	}
+
+	return podSets, nil
+}
+
+func rayClusterPodSets(obj map[string]any, fields ...string) ([]podSet, error) {
+	spec, found, _ := unstructured.NestedMap(obj, fields...)
+	if !found {
+		return nil, nil
+	}
+
+	podSets, err := appendPodSet(nil, spec, 1, "headGroupSpec", "template")
+	if err != nil {
+		return nil, err
+	}
+
+	workers, _, _ := unstructured.NestedSlice(spec, "workerGroupSpecs")
+	for _, w := range workers {
+		worker, ok := w.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		if podSets, err = appendPodSet(podSets, worker, replicas(worker), "template"); err != nil {
+			return nil, err
+		}
+	}
+
+	return podSets, nil
+}
+
+func replicas(spec map[string]any) int64 {
+	if r, found, _ := unstructured.NestedInt64(spec, "replicas"); found {
+		return r
+	}
+
+	return 1
+}
+
+// appendPodSet appends the pod set of the pod template at the given path, if any.
+func appendPodSet(podSets []podSet, obj map[string]any, count int64, fields ...string) ([]podSet, error) {
+	raw, found, _ := unstructured.NestedMap(obj, fields...)
+	if !found || count <= 0 {
+		return podSets, nil
+	}
+
+	template := corev1.PodTemplateSpec{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw, &template); err != nil {
+		return nil, err
+	}
+
+	return append(podSets, podSet{count: count, requests: podRequests(&template.Spec)}), nil
+}
+
+// podRequests returns the resources requested by a pod, the largest of the sum of the requests
+// of its containers and of the requests of each init container. The limits are used when the
+// requests are not set, as the API server does.
+func podRequests(spec *corev1.PodSpec) corev1.ResourceList {
+	requests := corev1.ResourceList{}
+
+	for i := range spec.Containers {
+		for name, q := range containerRequests(&spec.Containers[i]) {
+			total := requests[name]
+			total.Add(q)
+			requests[name] = total
+		}
+	}
+
+	for i := range spec.InitContainers {
+		for name, q := range containerRequests(&spec.InitContainers[i]) {
+			if current, ok := requests[name]; !ok || q.Cmp(current) > 0 {
+				requests[name] = q
+			}
+		}
+	}
+
+	return requests
+}
+
+func containerRequests(c *corev1.Container) corev1.ResourceList {
+	requests := c.Resources.Requests.DeepCopy()
+	if requests == nil {
+		requests = corev1.ResourceList{}
+	}
+
+	for name, q := range c.Resources.Limits {
+		if _, ok := requests[name]; !ok {
+			requests[name] = q.DeepCopy()
+		}
+	}
+
+	return requests
+}
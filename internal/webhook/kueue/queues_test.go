@@ -0,0 +1,377 @@
+package kueue_test
+
+import (
+	"math"
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/envtestutil"
+	kueuewebhook "github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/kueue"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/scheme"
+
+	. "github.com/onsi/gomega"
+)
+
+func newLocalQueue(name string, clusterQueue string) client.Object {
+	lq := resources.GvkToUnstructured(gvk.LocalQueue)
+	lq.SetName(name)
+	lq.SetNamespace(testNamespace)
+	lq.Object["spec"] = map[string]any{"clusterQueue": clusterQueue}
+
+	return lq
+}
+
+func newClusterQueue(name string, active bool, cohort string, borrowingLimit string) client.Object {
+	quota := map[string]any{"name": "cpu", "nominalQuota": "4"}
+	if borrowingLimit != "" {
+		quota["borrowingLimit"] = borrowingLimit
+	}
+
+	cq := resources.GvkToUnstructured(gvk.ClusterQueue)
+	cq.SetName(name)
+	cq.Object["spec"] = map[string]any{
+		"cohort": cohort,
+		"resourceGroups": []any{
+			map[string]any{
+				"coveredResources": []any{"cpu"},
+				"flavors": []any{
+					map[string]any{"name": "default", "resources": []any{quota}},
+				},
+			},
+		},
+	}
+
+	status := metav1.ConditionTrue
+	if !active {
+		status = metav1.ConditionFalse
+	}
+
+	cq.Object["status"] = map[string]any{
+		"conditions": []any{
+			map[string]any{"type": "Active", "status": string(status), "reason": "FlavorNotFound", "message": "Can't admit new workloads: references missing ResourceFlavor(s): default."},
+		},
+	}
+
+	return cq
+}
+
+// newMemoryClusterQueue returns an active ClusterQueue whose quota only covers the memory.
+func newMemoryClusterQueue(name string) client.Object {
+	cq := resources.GvkToUnstructured(gvk.ClusterQueue)
+	cq.SetName(name)
+	cq.Object["spec"] = map[string]any{
+		"resourceGroups": []any{
+			map[string]any{
+				"coveredResources": []any{"memory"},
+				"flavors": []any{
+					map[string]any{"name": "default", "resources": []any{map[string]any{"name": "memory", "nominalQuota": "16Gi"}}},
+				},
+			},
+		},
+	}
+
+	return cq
+}
+
+func withCPURequest(cpu string) envtestutil.ObjectOption {
+	return func(obj client.Object) {
+		u, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			return
+		}
+
+		containers := []any{
+			map[string]any{
+				"name":  "notebook",
+				"image": "jupyter/base-notebook:latest",
+				"resources": map[string]any{
+					"requests": map[string]any{string(corev1.ResourceCPU): cpu},
+				},
+			},
+		}
+		if err := unstructured.SetNestedSlice(u.Object, containers, "spec", "template", "spec", "containers"); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// TestKueueWebhook_QueueValidation verifies the checks of the LocalQueue the workloads are
+// submitted to, in both the enforce and the warn modes.
+func TestKueueWebhook_QueueValidation(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+	ctx := t.Context()
+	sch, err := scheme.New()
+	g.Expect(err).ToNot(HaveOccurred())
+
+	localQueueCRD := &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "localqueues.kueue.x-k8s.io"},
+	}
+
+	cases := []struct {
+		name         string
+		existingObjs []client.Object
+		nsAnnotation string
+		cpu          string
+		allowed      bool
+		message      string
+	}{
+		{
+			name:         "Kueue not installed, skip queue validation",
+			existingObjs: []client.Object{},
+			cpu:          "1",
+			allowed:      true,
+		},
+		{
+			name:         "LocalQueue not found lists the available LocalQueues",
+			existingObjs: []client.Object{localQueueCRD, newLocalQueue("team-b", "cq"), newLocalQueue("team-a", "cq")},
+			cpu:          "1",
+			allowed:      false,
+			message:      `LocalQueue "queue" not found in namespace "test-ns", available LocalQueues: team-a, team-b`,
+		},
+		{
+			name:         "No LocalQueue in the namespace",
+			existingObjs: []client.Object{localQueueCRD},
+			cpu:          "1",
+			allowed:      false,
+			message:      `LocalQueue "queue" not found in namespace "test-ns", no LocalQueue is available in the namespace`,
+		},
+		{
+			name:         "ClusterQueue not found",
+			existingObjs: []client.Object{localQueueCRD, newLocalQueue(validQueueName, "cq")},
+			cpu:          "1",
+			allowed:      false,
+			message:      `ClusterQueue "cq" of LocalQueue "queue" does not exist`,
+		},
+		{
+			name:         "Inactive ClusterQueue",
+			existingObjs: []client.Object{localQueueCRD, newLocalQueue(validQueueName, "cq"), newClusterQueue("cq", false, "", "")},
+			cpu:          "1",
+			allowed:      false,
+			message:      `ClusterQueue "cq" of LocalQueue "queue" is not active: Can't admit new workloads`,
+		},
+		{
+			name:         "Workload fitting the quota",
+			existingObjs: []client.Object{localQueueCRD, newLocalQueue(validQueueName, "cq"), newClusterQueue("cq", true, "", "")},
+			cpu:          "4",
+			allowed:      true,
+		},
+		{
+			name:         "Workload exceeding the quota",
+			existingObjs: []client.Object{localQueueCRD, newLocalQueue(validQueueName, "cq"), newClusterQueue("cq", true, "", "")},
+			cpu:          "5",
+			allowed:      false,
+			message:      `workload can never be admitted by ClusterQueue "cq" of LocalQueue "queue": requests 5 of cpu, more than the quota of 4`,
+		},
+		{
+			name:         "Workload requesting a resource not covered by the quota",
+			existingObjs: []client.Object{localQueueCRD, newLocalQueue(validQueueName, "cq"), newMemoryClusterQueue("cq")},
+			cpu:          "1",
+			allowed:      false,
+			message:      `workload can never be admitted by ClusterQueue "cq" of LocalQueue "queue": requests 1 of cpu, not covered by the quota`,
+		},
+		{
+			name:         "Workload fitting the quota with the borrowing limit",
+			existingObjs: []client.Object{localQueueCRD, newLocalQueue(validQueueName, "cq"), newClusterQueue("cq", true, "cohort", "2")},
+			cpu:          "6",
+			allowed:      true,
+		},
+		{
+			name:         "Workload exceeding the quota with the borrowing limit",
+			existingObjs: []client.Object{localQueueCRD, newLocalQueue(validQueueName, "cq"), newClusterQueue("cq", true, "cohort", "2")},
+			cpu:          "7",
+			allowed:      false,
+			message:      "requests 7 of cpu, more than the quota of 6",
+		},
+		{
+			name:         "Workload in a cohort without borrowing limit",
+			existingObjs: []client.Object{localQueueCRD, newLocalQueue(validQueueName, "cq"), newClusterQueue("cq", true, "cohort", "")},
+			cpu:          "100",
+			allowed:      true,
+		},
+		{
+			name:         "Warn mode from the namespace annotation",
+			existingObjs: []client.Object{localQueueCRD},
+			nsAnnotation: string(kueuewebhook.QueueValidationWarn),
+			cpu:          "1",
+			allowed:      true,
+			message:      `Kueue queue validation failed: LocalQueue "queue" not found in namespace "test-ns"`,
+		},
+		{
+			name:         "Enforce mode from the namespace annotation",
+			existingObjs: []client.Object{localQueueCRD},
+			nsAnnotation: string(kueuewebhook.QueueValidationEnforce),
+			cpu:          "1",
+			allowed:      false,
+			message:      `Kueue queue validation failed: LocalQueue "queue" not found in namespace "test-ns"`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			ns := envtestutil.NewNamespace(testNamespace, map[string]string{nsLabelManaged: "true"}, func(ns *corev1.Namespace) {
+				if tc.nsAnnotation != "" {
+					ns.SetAnnotations(map[string]string{cluster.KueueQueueValidationAnnotation: tc.nsAnnotation})
+				}
+			})
+
+			objs := append([]client.Object{ns, createDSCWithKueueState(operatorv1.Managed)}, tc.existingObjs...)
+			cli := fake.NewClientBuilder().WithScheme(sch).WithObjects(objs...).Build()
+
+			validator := &kueuewebhook.Validator{
+				Client:      cli,
+				QueueReader: cli,
+				Name:        "test",
+				Decoder:     admission.NewDecoder(sch),
+			}
+
+			req := envtestutil.NewAdmissionRequest(
+				t,
+				admissionv1.Create,
+				envtestutil.NewNotebook("test-notebook", testNamespace,
+					envtestutil.WithLabels(map[string]string{objLabelQueueName: validQueueName}),
+					withCPURequest(tc.cpu),
+				),
+				gvk.Notebook,
+				metav1.GroupVersionResource{
+					Group:    gvk.Notebook.Group,
+					Version:  gvk.Notebook.Version,
+					Resource: "notebooks",
+				},
+			)
+
+			resp := validator.Handle(ctx, req)
+			g.Expect(resp.Allowed).To(Equal(tc.allowed))
+
+			switch {
+			case tc.message == "":
+				g.Expect(resp.Warnings).To(BeEmpty())
+			case tc.allowed:
+				g.Expect(resp.Warnings).To(ContainElement(ContainSubstring(tc.message)))
+			default:
+				g.Expect(resp.Result.Message).To(ContainSubstring(tc.message))
+			}
+		})
+	}
+}
+
+func newPyTorchJob(workers int64, cpu string) *unstructured.Unstructured {
+	template := map[string]any{
+		"spec": map[string]any{
+			"containers": []any{
+				map[string]any{
+					"name":      "pytorch",
+					"image":     "pytorch:latest",
+					"resources": map[string]any{"requests": map[string]any{string(corev1.ResourceCPU): cpu}},
+				},
+			},
+		},
+	}
+
+	job := resources.GvkToUnstructured(gvk.PyTorchJob)
+	job.SetName("test-pytorchjob")
+	job.SetNamespace(testNamespace)
+	job.SetLabels(map[string]string{objLabelQueueName: validQueueName})
+	job.Object["spec"] = map[string]any{
+		"pytorchReplicaSpecs": map[string]any{
+			"Master": map[string]any{"replicas": int64(1), "template": template},
+			"Worker": map[string]any{"replicas": workers, "template": template},
+		},
+	}
+
+	return job
+}
+
+// TestKueueWebhook_QueueValidationReplicas verifies that the requests of the pods are multiplied
+// by their replicas when checked against the quota, without overflowing.
+func TestKueueWebhook_QueueValidationReplicas(t *testing.T) {
+	t.Parallel()
+
+	sch, err := scheme.New()
+	NewWithT(t).Expect(err).ToNot(HaveOccurred())
+
+	cases := []struct {
+		name    string
+		workers int64
+		cpu     string
+		allowed bool
+		message string
+	}{
+		{
+			name:    "Replicas fitting the quota",
+			workers: 3,
+			cpu:     "1",
+			allowed: true,
+		},
+		{
+			name:    "Replicas exceeding the quota",
+			workers: 2,
+			cpu:     "1500m",
+			allowed: false,
+			message: "requests 4500m of cpu, more than the quota of 4",
+		},
+		{
+			name:    "Replicas overflowing the quantities",
+			workers: math.MaxInt64 - 1,
+			cpu:     "1",
+			allowed: false,
+			message: "more than the quota of 4",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			cli := fake.NewClientBuilder().WithScheme(sch).WithObjects(
+				envtestutil.NewNamespace(testNamespace, map[string]string{nsLabelManaged: "true"}),
+				createDSCWithKueueState(operatorv1.Managed),
+				&apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: "localqueues.kueue.x-k8s.io"}},
+				newLocalQueue(validQueueName, "cq"),
+				newClusterQueue("cq", true, "", ""),
+			).Build()
+
+			validator := &kueuewebhook.Validator{
+				Client:      cli,
+				QueueReader: cli,
+				Name:        "test",
+				Decoder:     admission.NewDecoder(sch),
+			}
+
+			req := envtestutil.NewAdmissionRequest(
+				t,
+				admissionv1.Create,
+				newPyTorchJob(tc.workers, tc.cpu),
+				gvk.PyTorchJob,
+				metav1.GroupVersionResource{
+					Group:    gvk.PyTorchJob.Group,
+					Version:  gvk.PyTorchJob.Version,
+					Resource: "pytorchjobs",
+				},
+			)
+
+			resp := validator.Handle(t.Context(), req)
+			g.Expect(resp.Allowed).To(Equal(tc.allowed))
+
+			if tc.message != "" {
+				g.Expect(resp.Result.Message).To(ContainSubstring(tc.message))
+			}
+		})
+	}
+}
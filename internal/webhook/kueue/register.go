@@ -16,9 +16,10 @@ import (
 //   - error: Any error encountered during webhook registration.
 func RegisterWebhooks(mgr ctrl.Manager) error {
 	if err := (&Validator{
-		Client:  mgr.GetAPIReader(),
-		Decoder: admission.NewDecoder(mgr.GetScheme()),
-		Name:    "kueue-validating",
+		Client:      mgr.GetAPIReader(),
+		QueueReader: mgr.GetClient(),
+		Decoder:     admission.NewDecoder(mgr.GetScheme()),
+		Name:        "kueue-validating",
 	}).SetupWithManager(mgr); err != nil {
 		return err
 	}
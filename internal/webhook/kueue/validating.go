@@ -47,9 +47,10 @@ var (
 
 // Validator implements webhook.AdmissionHandler for Kueue validation webhooks.
 type Validator struct {
-	Client  client.Reader
-	Decoder admission.Decoder
-	Name    string
+	Client      client.Reader
+	QueueReader client.Reader // cached, used to read the Kueue LocalQueues and ClusterQueues
+	Decoder     admission.Decoder
+	Name        string
 }
 
 // Assert that Validator implements admission.Handler interface.
@@ -187,26 +188,25 @@ func validateNamespaceLabels(ns client.Object) bool {
 		resources.HasLabel(ns, cluster.KueueLegacyManagedLabelKey, "true")
 }
 
-// isNamespaceManagedByKueue checks if the given namespace is labeled for Kueue management.
+// getNamespace returns the metadata of the given namespace.
 //
 // Parameters:
 //   - ctx: Context for the API call
-//   - cli: The controller-runtime client to use for checking the namespace labels
-//   - namespace: The name of the namespace to check
+//   - cli: The controller-runtime client to use for getting the namespace
+//   - namespace: The name of the namespace to get
 //
 // Returns:
-//   - bool: true if the namespace is labeled for Kueue, false otherwise
-//   - error: Any error encountered while checking the namespace labels
-func isNamespaceManagedByKueue(ctx context.Context, cli client.Reader, namespace string) (bool, error) {
+//   - *metav1.PartialObjectMetadata: The metadata of the namespace
+//   - error: Any error encountered while getting the namespace
+func getNamespace(ctx context.Context, cli client.Reader, namespace string) (*metav1.PartialObjectMetadata, error) {
 	ns := &metav1.PartialObjectMetadata{}
 	ns.SetGroupVersionKind(gvk.Namespace)
 
 	if err := cli.Get(ctx, types.NamespacedName{Name: namespace}, ns); err != nil {
-		// Unable to get the namespace, return an error
-		return false, err
+		return nil, err
 	}
 
-	return validateNamespaceLabels(ns), nil
+	return ns, nil
 }
 
 // validateKueueLabels checks if the required Kueue labels are present and valid.
@@ -254,14 +254,14 @@ func (v *Validator) performLabelValidation(ctx context.Context, req *admission.R
 
 	// Check if the namespace is labeled for Kueue management
 	// TODO: to be removed: https://issues.redhat.com/browse/RHOAIENG-27558
-	kueueManagedNamespace, err := isNamespaceManagedByKueue(ctx, v.Client, namespace)
+	ns, err := getNamespace(ctx, v.Client, namespace)
 	if err != nil {
 		// Unable to determine if the namespace is labeled for Kueue, return an error response
 		log.Error(err, "failed to check namespace Kueue labels", "namespace", namespace)
 		return admission.Errored(http.StatusInternalServerError, fmt.Errorf("failed to check if namespace %q is labeled for Kueue: %w", namespace, err))
 	}
 
-	if !kueueManagedNamespace {
+	if !validateNamespaceLabels(ns) {
 		// Namespace is not labeled for Kueue
 		return admission.Allowed(fmt.Sprintf("Namespace %q is not labeled for Kueue (%q), skipping Kueue label validation", namespace, cluster.KueueManagedLabelKey))
 	}
@@ -288,6 +288,22 @@ func (v *Validator) performLabelValidation(ctx context.Context, req *admission.R
 		return admission.Denied(fmt.Sprintf("Kueue label validation failed: %v", err))
 	}
 
+	// Check that the workload could be admitted by its LocalQueue
+	reason, err := validateQueue(ctx, v.QueueReader, namespace, obj.GetLabels()[cluster.KueueQueueNameLabel], obj)
+	if err != nil {
+		log.Error(err, "failed to validate the Kueue LocalQueue", "namespace", namespace)
+		return admission.Errored(http.StatusInternalServerError, fmt.Errorf("failed to validate the Kueue LocalQueue: %w", err))
+	}
+
+	if reason != "" {
+		msg := "Kueue queue validation failed: " + reason
+		if queueValidationMode(ns) == QueueValidationWarn {
+			return admission.Allowed(fmt.Sprintf("Kueue label validation passed for %q in namespace %q", req.Kind.Kind, namespace)).WithWarnings(msg)
+		}
+
+		return admission.Denied(msg)
+	}
+
 	// Kueue is enabled, namespace is labeled for Kueue, and workload has Kueue labels
 	return admission.Allowed(fmt.Sprintf("Kueue label validation passed for %q in namespace %q", req.Kind.Kind, namespace))
 }
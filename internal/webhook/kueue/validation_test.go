@@ -90,9 +90,10 @@ func TestKueueWebhook_DeniesUnexpectedKind(t *testing.T) {
 	cli := fake.NewClientBuilder().WithScheme(sch).Build()
 	decoder := admission.NewDecoder(sch)
 	validator := &kueuewebhook.Validator{
-		Client:  cli,
-		Name:    "test-validator",
-		Decoder: decoder,
+		Client:      cli,
+		QueueReader: cli,
+		Name:        "test-validator",
+		Decoder:     decoder,
 	}
 
 	// Create a test object with an unexpected kind
@@ -134,9 +135,10 @@ func TestKueueWebhook_AcceptsExpectedKinds(t *testing.T) {
 	).Build()
 	decoder := admission.NewDecoder(sch)
 	validator := &kueuewebhook.Validator{
-		Client:  cli,
-		Name:    "test-validator",
-		Decoder: decoder,
+		Client:      cli,
+		QueueReader: cli,
+		Name:        "test-validator",
+		Decoder:     decoder,
 	}
 
 	// Test cases for all expected kinds
@@ -513,9 +515,10 @@ func TestKueueWebhook_ValidatingWebhook(t *testing.T) {
 			cli := fake.NewClientBuilder().WithScheme(sch).WithObjects(tc.existingObjs...).Build()
 			decoder := admission.NewDecoder(sch)
 			validator := &kueuewebhook.Validator{
-				Client:  cli,
-				Name:    "test",
-				Decoder: decoder,
+				Client:      cli,
+				QueueReader: cli,
+				Name:        "test",
+				Decoder:     decoder,
 			}
 			resp := validator.Handle(ctx, tc.req)
 			g.Expect(resp.Allowed).To(Equal(tc.allowed))
@@ -0,0 +1,163 @@
+//go:build !nowebhook
+
+package notebook
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+// cullerServiceAccount is the service account of the kf notebook controller, whose culler stops
+// the idle notebooks.
+const cullerServiceAccount = "notebook-controller-service-account"
+
+// performCullingGuard prevents the stop by the culler of the Notebooks exempted by the culling
+// policy of the Workbenches component, and counts the admitted stops as cull attempts. Since the
+// culler uses the shortest idle timeout among the policy and the namespace overrides, the stop of
+// a Notebook idle for less than the idle timeout of its namespace is prevented as well. It
+// returns whether the Notebook has been modified.
+func (w *NotebookWebhook) performCullingGuard(ctx context.Context, nb *unstructured.Unstructured, req *admission.Request) (bool, error) {
+	if req.Operation != admissionv1.Update || !isCuller(req.UserInfo.Username) {
+		return false, nil
+	}
+
+	old := &unstructured.Unstructured{}
+	if err := w.Decoder.DecodeRaw(req.OldObject, old); err != nil {
+		return false, fmt.Errorf("failed to decode old object: %w", err)
+	}
+
+	if resources.GetAnnotation(nb, annotations.NotebookStopped) == "" || resources.GetAnnotation(old, annotations.NotebookStopped) != "" {
+		return false, nil
+	}
+
+	wb, err := w.getWorkbenches(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	reason := ""
+	if wb != nil && wb.Spec.Culling != nil {
+		reason, err = w.cullingExemption(ctx, nb, req.Namespace, wb.Spec.Culling)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	if reason == "" {
+		if req.DryRun == nil || !*req.DryRun {
+			NotebookCullAttemptsTotal.WithLabelValues(req.Namespace).Inc()
+		}
+
+		return false, nil
+	}
+
+	logf.FromContext(ctx).V(1).Info("preventing the stop of the idle notebook", "name", nb.GetName(), "namespace", req.Namespace, "reason", reason)
+	resources.RemoveAnnotation(nb, annotations.NotebookStopped)
+
+	return true, nil
+}
+
+// cullingExemption returns why the culling policy exempts the Notebook from being stopped, an
+// empty string if it does not.
+func (w *NotebookWebhook) cullingExemption(
+	ctx context.Context,
+	nb *unstructured.Unstructured,
+	namespace string,
+	policy *componentApi.NotebookCullingPolicy,
+) (string, error) {
+	if slices.Contains(policy.ExemptNamespaces, namespace) {
+		return "the namespace is exempted", nil
+	}
+
+	if policy.ExemptSelector != nil {
+		matches, err := selectorMatches(policy.ExemptSelector, nb.GetLabels())
+		if err != nil {
+			return "", fmt.Errorf("invalid exempt selector of the culling policy: %w", err)
+		}
+		if matches {
+			return "the notebook is exempted", nil
+		}
+	}
+
+	if policy.GPUOnly && !requestsGPU(nb) {
+		return "the notebook does not request GPUs", nil
+	}
+
+	ns := &metav1.PartialObjectMetadata{}
+	ns.SetGroupVersionKind(gvk.Namespace)
+
+	if err := w.APIReader.Get(ctx, client.ObjectKey{Name: namespace}, ns); err != nil {
+		return "", fmt.Errorf("failed to get namespace %s: %w", namespace, err)
+	}
+
+	timeout := policy.IdleTimeout.Duration
+
+	if value := resources.GetAnnotation(ns, annotations.NotebookCullingIdleTimeout); value != "" {
+		d, err := time.ParseDuration(value)
+		switch {
+		case err != nil:
+			logf.FromContext(ctx).Info("ignoring invalid annotation", "namespace", namespace, "annotation", annotations.NotebookCullingIdleTimeout, "error", err.Error())
+		case d <= 0:
+			return "the culling is disabled in the namespace", nil
+		default:
+			timeout = d
+		}
+	}
+
+	lastActivity, err := time.Parse(time.RFC3339, resources.GetAnnotation(nb, annotations.NotebookLastActivity))
+	if err != nil {
+		// the culler decides on the activity it has observed
+		return "", nil //nolint:nilerr
+	}
+
+	if idle := time.Since(lastActivity); idle < timeout {
+		return fmt.Sprintf("the notebook has been idle for %s, less than the idle timeout %s", idle.Round(time.Second), timeout), nil
+	}
+
+	return "", nil
+}
+
+// isCuller returns whether a user is the service account of the notebook controller.
+func isCuller(username string) bool {
+	parts := strings.Split(username, ":")
+
+	return len(parts) == 4 && parts[0] == "system" && parts[1] == "serviceaccount" && parts[3] == cullerServiceAccount
+}
+
+// requestsGPU returns whether a container of the Notebook requests a GPU, e.g. nvidia.com/gpu.
+func requestsGPU(nb *unstructured.Unstructured) bool {
+	containers, _, _ := unstructured.NestedSlice(nb.Object, NotebookContainersPath...)
+
+	for _, c := range containers {
+		container, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		for _, field := range []string{"limits", "requests"} {
+			values, _, _ := unstructured.NestedMap(container, "resources", field)
+			for name := range values {
+				if strings.Contains(strings.ToLower(name), "gpu") {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
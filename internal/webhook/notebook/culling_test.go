@@ -0,0 +1,211 @@
+package notebook_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/notebook"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/scheme"
+
+	. "github.com/onsi/gomega"
+)
+
+const cullerUsername = "system:serviceaccount:opendatahub:notebook-controller-service-account"
+
+func withLabels(values map[string]string) func(*unstructured.Unstructured) {
+	return func(nb *unstructured.Unstructured) {
+		nb.SetLabels(values)
+	}
+}
+
+func withGPU(nb *unstructured.Unstructured) {
+	containers, _, _ := unstructured.NestedSlice(nb.Object, notebook.NotebookContainersPath...)
+	containers[0].(map[string]any)["resources"] = map[string]any{
+		"limits": map[string]any{"nvidia.com/gpu": "1"},
+	}
+	_ = unstructured.SetNestedSlice(nb.Object, containers, notebook.NotebookContainersPath...)
+}
+
+func TestNotebookWebhook_Handle_CullingPolicy(t *testing.T) {
+	t.Parallel()
+
+	idleFor := func(d time.Duration) func(*unstructured.Unstructured) {
+		return func(nb *unstructured.Unstructured) {
+			nb.SetAnnotations(map[string]string{
+				annotations.NotebookLastActivity: time.Now().Add(-d).UTC().Format(time.RFC3339),
+			})
+		}
+	}
+
+	policy := &componentApi.NotebookCullingPolicy{
+		IdleTimeout:      metav1.Duration{Duration: time.Hour},
+		ExemptNamespaces: []string{"exempt"},
+		ExemptSelector:   &metav1.LabelSelector{MatchLabels: map[string]string{"keep": "true"}},
+	}
+
+	tests := []struct {
+		name         string
+		namespace    string
+		username     string
+		policy       *componentApi.NotebookCullingPolicy
+		nsAnnotation string
+		options      []func(*unstructured.Unstructured)
+		culled       bool
+	}{
+		{
+			name:      "no culling policy",
+			namespace: "no-policy",
+			username:  cullerUsername,
+			options:   []func(*unstructured.Unstructured){idleFor(time.Minute)},
+			culled:    true,
+		},
+		{
+			name:      "idle notebook",
+			namespace: "idle",
+			username:  cullerUsername,
+			policy:    policy,
+			options:   []func(*unstructured.Unstructured){idleFor(2 * time.Hour)},
+			culled:    true,
+		},
+		{
+			name:      "stopped by a user",
+			namespace: "user",
+			username:  "user",
+			policy:    policy,
+			options:   []func(*unstructured.Unstructured){idleFor(time.Minute)},
+			culled:    true,
+		},
+		{
+			name:      "exempted namespace",
+			namespace: "exempt",
+			username:  cullerUsername,
+			policy:    policy,
+			options:   []func(*unstructured.Unstructured){idleFor(2 * time.Hour)},
+		},
+		{
+			name:      "exempted notebook",
+			namespace: "exempt-notebook",
+			username:  cullerUsername,
+			policy:    policy,
+			options: []func(*unstructured.Unstructured){
+				idleFor(2 * time.Hour),
+				withLabels(map[string]string{"keep": "true"}),
+			},
+		},
+		{
+			name:      "notebook without GPU in GPU only mode",
+			namespace: "gpu-only",
+			username:  cullerUsername,
+			policy: &componentApi.NotebookCullingPolicy{
+				IdleTimeout: metav1.Duration{Duration: time.Hour},
+				GPUOnly:     true,
+			},
+			options: []func(*unstructured.Unstructured){idleFor(2 * time.Hour)},
+		},
+		{
+			name:      "notebook with GPU in GPU only mode",
+			namespace: "gpu-only-gpu",
+			username:  cullerUsername,
+			policy: &componentApi.NotebookCullingPolicy{
+				IdleTimeout: metav1.Duration{Duration: time.Hour},
+				GPUOnly:     true,
+			},
+			options: []func(*unstructured.Unstructured){idleFor(2 * time.Hour), withGPU},
+			culled:  true,
+		},
+		{
+			name:         "culling disabled in the namespace",
+			namespace:    "disabled",
+			username:     cullerUsername,
+			policy:       policy,
+			nsAnnotation: "0",
+			options:      []func(*unstructured.Unstructured){idleFor(2 * time.Hour)},
+		},
+		{
+			name:         "longer idle timeout in the namespace",
+			namespace:    "longer",
+			username:     cullerUsername,
+			policy:       policy,
+			nsAnnotation: "8h",
+			options:      []func(*unstructured.Unstructured){idleFor(2 * time.Hour)},
+		},
+		{
+			name:         "shorter idle timeout in the namespace",
+			namespace:    "shorter",
+			username:     cullerUsername,
+			policy:       policy,
+			nsAnnotation: "10m",
+			options:      []func(*unstructured.Unstructured){idleFor(30 * time.Minute)},
+			culled:       true,
+		},
+		{
+			name:      "shorter idle timeout in another namespace",
+			namespace: "other",
+			username:  cullerUsername,
+			policy:    policy,
+			options:   []func(*unstructured.Unstructured){idleFor(30 * time.Minute)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			sch, err := scheme.New()
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: tt.namespace}}
+			if tt.nsAnnotation != "" {
+				ns.Annotations = map[string]string{annotations.NotebookCullingIdleTimeout: tt.nsAnnotation}
+			}
+
+			objs := []client.Object{ns}
+			if tt.policy != nil {
+				wb := newWorkbenches()
+				wb.Spec.Culling = tt.policy
+				objs = append(objs, wb)
+			}
+
+			webhook := createTestWebhook(t, fake.NewClientBuilder().WithScheme(sch).WithObjects(objs...).Build())
+
+			old := createNotebook(tt.options...)
+			old.SetNamespace(tt.namespace)
+
+			stopped := old.DeepCopy()
+			values := stopped.GetAnnotations()
+			values[annotations.NotebookStopped] = time.Now().UTC().Format(time.RFC3339)
+			stopped.SetAnnotations(values)
+
+			req := createAdmissionRequest(t, admissionv1.Update, stopped, old)
+			req.Namespace = tt.namespace
+			req.UserInfo.Username = tt.username
+
+			resp := webhook.Handle(t.Context(), req)
+			g.Expect(resp.Allowed).Should(BeTrue())
+
+			result := applyPatches(t, stopped, resp)
+			if tt.culled {
+				g.Expect(result.GetAnnotations()).Should(HaveKey(annotations.NotebookStopped))
+			} else {
+				g.Expect(result.GetAnnotations()).ShouldNot(HaveKey(annotations.NotebookStopped))
+			}
+
+			expected := 0.0
+			if tt.culled && tt.username == cullerUsername {
+				expected = 1
+			}
+			g.Expect(testutil.ToFloat64(notebook.NotebookCullAttemptsTotal.WithLabelValues(tt.namespace))).Should(Equal(expected))
+		})
+	}
+}
@@ -0,0 +1,492 @@
+//go:build !nowebhook
+
+package notebook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+
+	routev1 "github.com/openshift/api/route/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/certconfigmapgenerator"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+const (
+	trustedCAVolumeName = "odh-trusted-ca-bundle"
+	trustedCAMountPath  = "/etc/pki/tls/custom-certs"
+	// trustedCAClusterKey is the key of the cluster-wide trusted CA bundle, injected by the
+	// Cluster Network Operator.
+	trustedCAClusterKey = "ca-bundle.crt"
+
+	pipelinesTokenVolumeName = "kf-pipelines-token"
+	pipelinesTokenMountPath  = "/var/run/secrets/kubeflow/pipelines"
+	pipelinesTokenPath       = "token"
+	pipelinesTokenExpiration = int64(3600)
+	pipelinesRoutePrefix     = "ds-pipeline-"
+
+	clusterProxyName = "cluster"
+)
+
+var (
+	NotebookPodSpecPath          = []string{"spec", "template", "spec"}
+	NotebookVolumesPath          = []string{"spec", "template", "spec", "volumes"}
+	NotebookImagePullSecretsPath = []string{"spec", "template", "spec", "imagePullSecrets"}
+)
+
+// notebookInjections are the resources injected into a Notebook by the injection profiles, as
+// recorded in the annotations.NotebookInjections annotation. The volumes are mounted into the
+// notebook container with mounts of the same name.
+type notebookInjections struct {
+	Env              []string `json:"env,omitempty"`
+	Volumes          []string `json:"volumes,omitempty"`
+	ImagePullSecrets []string `json:"imagePullSecrets,omitempty"`
+}
+
+// injectionPlan is the content injected into a Notebook by the profiles applying to it.
+type injectionPlan struct {
+	env              []any
+	volumes          []any
+	volumeMounts     []any
+	imagePullSecrets []string
+}
+
+// performProfileInjection injects into the Notebook the resources of the injection profiles of
+// the Workbenches component applying to it. The resources injected by a previous admission are
+// removed first, so that the injection is idempotent and reverted once a profile no longer
+// applies. The environment variables, volumes and image pull secrets already set by the user
+// are left untouched. A Notebook without previous injection is admitted unchanged when the
+// profiles cannot be resolved. It returns whether the Notebook has been modified.
+func (w *NotebookWebhook) performProfileInjection(ctx context.Context, nb *unstructured.Unstructured, namespace string) (bool, error) {
+	original := nb.DeepCopy()
+
+	plan, profiles, err := w.resolveInjectionProfiles(ctx, nb, namespace)
+	if err != nil {
+		// Without a previous injection to keep up to date the Notebook is admitted unchanged,
+		// the profiles are applied by its next admission.
+		if resources.GetAnnotation(nb, annotations.NotebookInjections) == "" {
+			logf.FromContext(ctx).Error(err, "unable to resolve the injection profiles, admitting the notebook unchanged")
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	containers, found, err := unstructured.NestedSlice(nb.Object, NotebookContainersPath...)
+	if err != nil {
+		return false, fmt.Errorf("failed to get containers array: %w", err)
+	}
+	if !found || len(containers) == 0 {
+		if len(profiles) == 0 {
+			return false, nil
+		}
+
+		return false, errors.New("no containers found in notebook")
+	}
+
+	// The notebook only has one container, so the resources are injected into the first one
+	container, ok := containers[0].(map[string]any)
+	if !ok {
+		return false, errors.New("first container is not a map[string]interface{}")
+	}
+
+	volumes, _, _ := unstructured.NestedSlice(nb.Object, NotebookVolumesPath...)
+	pullSecrets, _, _ := unstructured.NestedSlice(nb.Object, NotebookImagePullSecretsPath...)
+	env, _ := container["env"].([]any)
+	mounts, _ := container["volumeMounts"].([]any)
+
+	previous := previousInjections(ctx, nb)
+	env = removeNamed(env, "name", previous.Env)
+	volumes = removeNamed(volumes, "name", previous.Volumes)
+	mounts = removeNamed(mounts, "name", previous.Volumes)
+	pullSecrets = removeNamed(pullSecrets, "name", previous.ImagePullSecrets)
+
+	injected := notebookInjections{}
+	env, injected.Env = appendNamed(env, "name", plan.env)
+	volumes, injected.Volumes = appendNamed(volumes, "name", plan.volumes)
+	mounts, _ = appendNamed(mounts, "name", plan.volumeMounts)
+
+	secrets := make([]any, 0, len(plan.imagePullSecrets))
+	for _, s := range plan.imagePullSecrets {
+		secrets = append(secrets, map[string]any{"name": s})
+	}
+	pullSecrets, injected.ImagePullSecrets = appendNamed(pullSecrets, "name", secrets)
+
+	setOrDelete(container, "env", env)
+	setOrDelete(container, "volumeMounts", mounts)
+	containers[0] = container
+
+	if err := unstructured.SetNestedSlice(nb.Object, containers, NotebookContainersPath...); err != nil {
+		return false, fmt.Errorf("failed to set containers array: %w", err)
+	}
+
+	podSpec, _, _ := unstructured.NestedMap(nb.Object, NotebookPodSpecPath...)
+	setOrDelete(podSpec, "volumes", volumes)
+	setOrDelete(podSpec, "imagePullSecrets", pullSecrets)
+
+	if err := unstructured.SetNestedMap(nb.Object, podSpec, NotebookPodSpecPath...); err != nil {
+		return false, fmt.Errorf("failed to set pod spec: %w", err)
+	}
+
+	if reflect.DeepEqual(injected, notebookInjections{}) {
+		resources.RemoveAnnotation(nb, annotations.NotebookInjections)
+	} else {
+		data, err := json.Marshal(injected)
+		if err != nil {
+			return false, fmt.Errorf("failed to marshal the injected resources: %w", err)
+		}
+
+		resources.SetAnnotation(nb, annotations.NotebookInjections, string(data))
+	}
+
+	return !reflect.DeepEqual(original.Object, nb.Object), nil
+}
+
+// resolveInjectionProfiles returns the injection profiles applying to the Notebook and the
+// content they inject.
+func (w *NotebookWebhook) resolveInjectionProfiles(
+	ctx context.Context,
+	nb *unstructured.Unstructured,
+	namespace string,
+) (*injectionPlan, []componentApi.NotebookInjectionProfile, error) {
+	profiles, err := w.matchingInjectionProfiles(ctx, nb, namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	plan, err := w.newInjectionPlan(ctx, namespace, profiles)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return plan, profiles, nil
+}
+
+// matchingInjectionProfiles returns the injection profiles of the Workbenches component applying
+// to the Notebook, none when the Workbenches component is not deployed.
+func (w *NotebookWebhook) matchingInjectionProfiles(
+	ctx context.Context,
+	nb *unstructured.Unstructured,
+	namespace string,
+) ([]componentApi.NotebookInjectionProfile, error) {
+	wb, err := w.getWorkbenches(ctx)
+	if err != nil || wb == nil {
+		return nil, err
+	}
+
+	var ns *metav1.PartialObjectMetadata
+	profiles := make([]componentApi.NotebookInjectionProfile, 0, len(wb.Spec.InjectionProfiles))
+
+	for _, p := range wb.Spec.InjectionProfiles {
+		matches, err := selectorMatches(p.Selector, nb.GetLabels())
+		if err != nil {
+			return nil, fmt.Errorf("invalid selector of injection profile %s: %w", p.Name, err)
+		}
+		if !matches {
+			continue
+		}
+
+		if p.NamespaceSelector != nil && ns == nil {
+			ns = &metav1.PartialObjectMetadata{}
+			ns.SetGroupVersionKind(gvk.Namespace)
+
+			if err := w.APIReader.Get(ctx, client.ObjectKey{Name: namespace}, ns); err != nil {
+				return nil, fmt.Errorf("failed to get namespace %s: %w", namespace, err)
+			}
+		}
+
+		if p.NamespaceSelector != nil {
+			matches, err = selectorMatches(p.NamespaceSelector, ns.GetLabels())
+			if err != nil {
+				return nil, fmt.Errorf("invalid namespace selector of injection profile %s: %w", p.Name, err)
+			}
+			if !matches {
+				continue
+			}
+		}
+
+		profiles = append(profiles, p)
+	}
+
+	return profiles, nil
+}
+
+// getWorkbenches returns the Workbenches component, nil when it is not deployed.
+func (w *NotebookWebhook) getWorkbenches(ctx context.Context) (*componentApi.Workbenches, error) {
+	wb := componentApi.Workbenches{}
+
+	err := w.Client.Get(ctx, client.ObjectKey{Name: componentApi.WorkbenchesInstanceName}, &wb)
+	switch {
+	case k8serr.IsNotFound(err), meta.IsNoMatchError(err):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to get %s: %w", componentApi.WorkbenchesInstanceName, err)
+	}
+
+	return &wb, nil
+}
+
+// newInjectionPlan returns the content injected by the given profiles. The parts whose source is
+// not available, e.g. a namespace without pipeline server, are skipped.
+func (w *NotebookWebhook) newInjectionPlan(ctx context.Context, namespace string, profiles []componentApi.NotebookInjectionProfile) (*injectionPlan, error) {
+	plan := injectionPlan{}
+
+	var trustedCA, proxy, pipelines bool
+	for _, p := range profiles {
+		trustedCA = trustedCA || p.TrustedCABundle
+		proxy = proxy || p.Proxy
+		pipelines = pipelines || p.Pipelines
+
+		for _, s := range p.ImagePullSecrets {
+			if !slices.Contains(plan.imagePullSecrets, s) {
+				plan.imagePullSecrets = append(plan.imagePullSecrets, s)
+			}
+		}
+	}
+
+	if trustedCA {
+		if err := w.planTrustedCABundle(ctx, namespace, &plan); err != nil {
+			return nil, err
+		}
+	}
+
+	if proxy {
+		if err := w.planProxy(ctx, &plan); err != nil {
+			return nil, err
+		}
+	}
+
+	if pipelines {
+		if err := w.planPipelines(ctx, namespace, &plan); err != nil {
+			return nil, err
+		}
+	}
+
+	return &plan, nil
+}
+
+// planTrustedCABundle mounts the keys of the odh-trusted-ca-bundle ConfigMap of the namespace, and
+// points the TLS clients to the cluster-wide bundle, or to the custom one when it is the only one.
+func (w *NotebookWebhook) planTrustedCABundle(ctx context.Context, namespace string, plan *injectionPlan) error {
+	cm := corev1.ConfigMap{}
+
+	err := w.APIReader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: certconfigmapgenerator.CAConfigMapName}, &cm)
+	switch {
+	case k8serr.IsNotFound(err):
+		logf.FromContext(ctx).V(1).Info("trusted CA bundle not found, skipping injection", "namespace", namespace)
+		return nil
+	case err != nil:
+		return fmt.Errorf("failed to get ConfigMap %s: %w", certconfigmapgenerator.CAConfigMapName, err)
+	}
+
+	items := make([]any, 0, 2)
+	bundle := ""
+
+	for _, key := range []string{trustedCAClusterKey, certconfigmapgenerator.CADataFieldName} {
+		if _, ok := cm.Data[key]; !ok {
+			continue
+		}
+
+		items = append(items, map[string]any{"key": key, "path": key})
+		if bundle == "" {
+			bundle = trustedCAMountPath + "/" + key
+		}
+	}
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	plan.volumes = append(plan.volumes, map[string]any{
+		"name": trustedCAVolumeName,
+		"configMap": map[string]any{
+			"name":  certconfigmapgenerator.CAConfigMapName,
+			"items": items,
+		},
+	})
+	plan.volumeMounts = append(plan.volumeMounts, map[string]any{
+		"name":      trustedCAVolumeName,
+		"mountPath": trustedCAMountPath,
+		"readOnly":  true,
+	})
+
+	for _, name := range []string{"SSL_CERT_FILE", "REQUESTS_CA_BUNDLE", "PIP_CERT"} {
+		plan.env = append(plan.env, map[string]any{"name": name, "value": bundle})
+	}
+
+	return nil
+}
+
+// planProxy sets the proxy environment variables from the status of the cluster-wide Proxy, which
+// includes the computed NO_PROXY list.
+func (w *NotebookWebhook) planProxy(ctx context.Context, plan *injectionPlan) error {
+	proxy := resources.GvkToUnstructured(gvk.OpenshiftProxy)
+
+	err := w.APIReader.Get(ctx, client.ObjectKey{Name: clusterProxyName}, proxy)
+	switch {
+	case k8serr.IsNotFound(err), meta.IsNoMatchError(err):
+		return nil
+	case err != nil:
+		return fmt.Errorf("failed to get the cluster Proxy: %w", err)
+	}
+
+	for _, v := range []struct{ name, field string }{
+		{"HTTP_PROXY", "httpProxy"},
+		{"HTTPS_PROXY", "httpsProxy"},
+		{"NO_PROXY", "noProxy"},
+	} {
+		if value, _, _ := unstructured.NestedString(proxy.Object, "status", v.field); value != "" {
+			plan.env = append(plan.env, map[string]any{"name": v.name, "value": value})
+		}
+	}
+
+	return nil
+}
+
+// planPipelines points the Kubeflow Pipelines SDK to the route of the pipeline server of the
+// namespace, the first one by name when there are several, and mounts a service account token
+// to authenticate to it.
+func (w *NotebookWebhook) planPipelines(ctx context.Context, namespace string, plan *injectionPlan) error {
+	servers := unstructured.UnstructuredList{}
+	servers.SetGroupVersionKind(gvk.DataSciencePipelinesApplication.GroupVersion().WithKind(gvk.DataSciencePipelinesApplication.Kind + "List"))
+
+	err := w.APIReader.List(ctx, &servers, client.InNamespace(namespace))
+	switch {
+	case meta.IsNoMatchError(err):
+		return nil
+	case err != nil:
+		return fmt.Errorf("failed to list the pipeline servers: %w", err)
+	case len(servers.Items) == 0:
+		return nil
+	}
+
+	names := make([]string, 0, len(servers.Items))
+	for _, s := range servers.Items {
+		names = append(names, s.GetName())
+	}
+	slices.Sort(names)
+
+	route := routev1.Route{}
+
+	err = w.APIReader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: pipelinesRoutePrefix + names[0]}, &route)
+	switch {
+	case k8serr.IsNotFound(err), meta.IsNoMatchError(err):
+		return nil
+	case err != nil:
+		return fmt.Errorf("failed to get the route of pipeline server %s: %w", names[0], err)
+	case route.Spec.Host == "":
+		return nil
+	}
+
+	plan.volumes = append(plan.volumes, map[string]any{
+		"name": pipelinesTokenVolumeName,
+		"projected": map[string]any{
+			"sources": []any{
+				map[string]any{
+					"serviceAccountToken": map[string]any{
+						"path":              pipelinesTokenPath,
+						"expirationSeconds": pipelinesTokenExpiration,
+					},
+				},
+			},
+		},
+	})
+	plan.volumeMounts = append(plan.volumeMounts, map[string]any{
+		"name":      pipelinesTokenVolumeName,
+		"mountPath": pipelinesTokenMountPath,
+		"readOnly":  true,
+	})
+	plan.env = append(plan.env,
+		map[string]any{"name": "KF_PIPELINES_ENDPOINT", "value": "https://" + route.Spec.Host},
+		map[string]any{"name": "KF_PIPELINES_SA_TOKEN_PATH", "value": pipelinesTokenMountPath + "/" + pipelinesTokenPath},
+	)
+
+	return nil
+}
+
+// previousInjections returns the resources injected by a previous admission of the Notebook.
+func previousInjections(ctx context.Context, nb *unstructured.Unstructured) notebookInjections {
+	previous := notebookInjections{}
+
+	if value := resources.GetAnnotation(nb, annotations.NotebookInjections); value != "" {
+		if err := json.Unmarshal([]byte(value), &previous); err != nil {
+			logf.FromContext(ctx).Info("ignoring invalid annotation", "annotation", annotations.NotebookInjections, "error", err.Error())
+		}
+	}
+
+	return previous
+}
+
+func selectorMatches(selector *metav1.LabelSelector, values map[string]string) (bool, error) {
+	if selector == nil {
+		return true, nil
+	}
+
+	s, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return false, err
+	}
+
+	return s.Matches(labels.Set(values)), nil
+}
+
+// removeNamed removes the entries with the given names from a list of objects.
+func removeNamed(items []any, key string, names []string) []any {
+	return slices.DeleteFunc(items, func(item any) bool {
+		m, ok := item.(map[string]any)
+		if !ok {
+			return false
+		}
+
+		name, _ := m[key].(string)
+
+		return slices.Contains(names, name)
+	})
+}
+
+// appendNamed appends to a list of objects the entries whose name is not already used, and
+// returns the names of the appended entries.
+func appendNamed(items []any, key string, entries []any) ([]any, []string) {
+	var appended []string
+
+	for _, e := range entries {
+		name, _ := e.(map[string]any)[key].(string)
+
+		exists := slices.ContainsFunc(items, func(item any) bool {
+			m, ok := item.(map[string]any)
+			return ok && m[key] == name
+		})
+		if exists {
+			continue
+		}
+
+		items = append(items, e)
+		appended = append(appended, name)
+	}
+
+	return items, appended
+}
+
+func setOrDelete(obj map[string]any, key string, items []any) {
+	if len(items) == 0 {
+		delete(obj, key)
+		return
+	}
+
+	obj[key] = items
+}
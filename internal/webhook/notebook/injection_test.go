@@ -0,0 +1,264 @@
+package notebook_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	routev1 "github.com/openshift/api/route/v1"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/notebook"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/scheme"
+
+	. "github.com/onsi/gomega"
+)
+
+func newWorkbenches(profiles ...componentApi.NotebookInjectionProfile) *componentApi.Workbenches {
+	wb := &componentApi.Workbenches{
+		ObjectMeta: metav1.ObjectMeta{Name: componentApi.WorkbenchesInstanceName},
+	}
+	wb.Spec.InjectionProfiles = profiles
+
+	return wb
+}
+
+func newInjectionClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+	g := NewWithT(t)
+
+	sch, err := scheme.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	objs = append(objs,
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: testNamespace, Labels: map[string]string{"team": "data"}}},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "odh-trusted-ca-bundle", Namespace: testNamespace},
+			Data:       map[string]string{"ca-bundle.crt": "cluster", "odh-ca-bundle.crt": "custom"},
+		},
+		&routev1.Route{
+			ObjectMeta: metav1.ObjectMeta{Name: "ds-pipeline-dspa", Namespace: testNamespace},
+			Spec:       routev1.RouteSpec{Host: "pipelines.example.com"},
+		},
+	)
+
+	return fake.NewClientBuilder().WithScheme(sch).WithObjects(objs...).Build()
+}
+
+// admit runs the webhook on the notebook and returns the notebook with the patches applied.
+func admit(t *testing.T, webhook *notebook.NotebookWebhook, nb *unstructured.Unstructured) *unstructured.Unstructured {
+	t.Helper()
+	g := NewWithT(t)
+
+	resp := webhook.Handle(t.Context(), createAdmissionRequest(t, admissionv1.Update, nb, nb))
+	g.Expect(resp.Allowed).Should(BeTrue())
+
+	return applyPatches(t, nb, resp)
+}
+
+func applyPatches(t *testing.T, nb *unstructured.Unstructured, resp admission.Response) *unstructured.Unstructured {
+	t.Helper()
+	g := NewWithT(t)
+
+	if len(resp.Patches) == 0 {
+		return nb.DeepCopy()
+	}
+
+	ops, err := json.Marshal(resp.Patches)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	patch, err := jsonpatch.DecodePatch(ops)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	data, err := nb.MarshalJSON()
+	g.Expect(err).ShouldNot(HaveOccurred())
+	data, err = patch.Apply(data)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	result := &unstructured.Unstructured{}
+	g.Expect(result.UnmarshalJSON(data)).Should(Succeed())
+
+	return result
+}
+
+func containerEnv(nb *unstructured.Unstructured) map[string]string {
+	containers, _, _ := unstructured.NestedSlice(nb.Object, notebook.NotebookContainersPath...)
+	env, _ := containers[0].(map[string]any)["env"].([]any)
+
+	values := map[string]string{}
+	for _, e := range env {
+		m, _ := e.(map[string]any)
+		name, _ := m["name"].(string)
+		value, _ := m["value"].(string)
+		values[name] = value
+	}
+
+	return values
+}
+
+func names(nb *unstructured.Unstructured, path ...string) []string {
+	items, _, _ := unstructured.NestedSlice(nb.Object, path...)
+
+	result := make([]string, 0, len(items))
+	for _, i := range items {
+		name, _ := i.(map[string]any)["name"].(string)
+		result = append(result, name)
+	}
+
+	return result
+}
+
+func TestNotebookWebhook_Handle_InjectionProfiles(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	dspa := &unstructured.Unstructured{}
+	dspa.SetAPIVersion("datasciencepipelinesapplications.opendatahub.io/v1")
+	dspa.SetKind("DataSciencePipelinesApplication")
+	dspa.SetName("dspa")
+	dspa.SetNamespace(testNamespace)
+
+	cli := newInjectionClient(t,
+		newWorkbenches(
+			componentApi.NotebookInjectionProfile{
+				Name:              "platform",
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "data"}},
+				TrustedCABundle:   true,
+				Pipelines:         true,
+				ImagePullSecrets:  []string{"registry"},
+			},
+			componentApi.NotebookInjectionProfile{
+				Name:             "other-team",
+				Selector:         &metav1.LabelSelector{MatchLabels: map[string]string{"team": "other"}},
+				ImagePullSecrets: []string{"other-registry"},
+			},
+		),
+	)
+	g.Expect(cli.Create(t.Context(), dspa)).Should(Succeed())
+
+	webhook := createTestWebhook(t, cli)
+
+	nb := createNotebook(func(nb *unstructured.Unstructured) {
+		containers, _, _ := unstructured.NestedSlice(nb.Object, notebook.NotebookContainersPath...)
+		containers[0].(map[string]any)["env"] = []any{map[string]any{"name": "PIP_CERT", "value": "/user/ca.crt"}}
+		_ = unstructured.SetNestedSlice(nb.Object, containers, notebook.NotebookContainersPath...)
+	})
+
+	injected := admit(t, webhook, nb)
+
+	g.Expect(containerEnv(injected)).Should(Equal(map[string]string{
+		"PIP_CERT":                   "/user/ca.crt",
+		"SSL_CERT_FILE":              "/etc/pki/tls/custom-certs/ca-bundle.crt",
+		"REQUESTS_CA_BUNDLE":         "/etc/pki/tls/custom-certs/ca-bundle.crt",
+		"KF_PIPELINES_ENDPOINT":      "https://pipelines.example.com",
+		"KF_PIPELINES_SA_TOKEN_PATH": "/var/run/secrets/kubeflow/pipelines/token",
+	}))
+	g.Expect(names(injected, notebook.NotebookVolumesPath...)).Should(ConsistOf("odh-trusted-ca-bundle", "kf-pipelines-token"))
+	g.Expect(names(injected, notebook.NotebookImagePullSecretsPath...)).Should(ConsistOf("registry"))
+	g.Expect(injected.GetAnnotations()).Should(HaveKeyWithValue(annotations.NotebookInjections,
+		`{"env":["SSL_CERT_FILE","REQUESTS_CA_BUNDLE","KF_PIPELINES_ENDPOINT","KF_PIPELINES_SA_TOKEN_PATH"],`+
+			`"volumes":["odh-trusted-ca-bundle","kf-pipelines-token"],"imagePullSecrets":["registry"]}`))
+
+	// the injection is idempotent
+	resp := webhook.Handle(t.Context(), createAdmissionRequest(t, admissionv1.Update, injected, injected))
+	g.Expect(resp.Allowed).Should(BeTrue())
+	g.Expect(resp.Patches).Should(BeEmpty())
+
+	// the injected resources are removed once no profile applies, the user ones are kept
+	wb := &componentApi.Workbenches{}
+	g.Expect(cli.Get(t.Context(), client.ObjectKey{Name: componentApi.WorkbenchesInstanceName}, wb)).Should(Succeed())
+	wb.Spec.InjectionProfiles = nil
+	g.Expect(cli.Update(t.Context(), wb)).Should(Succeed())
+
+	reverted := admit(t, webhook, injected)
+
+	g.Expect(reverted.GetAnnotations()).ShouldNot(HaveKey(annotations.NotebookInjections))
+	g.Expect(reverted.Object["spec"]).Should(Equal(nb.Object["spec"]))
+}
+
+func TestNotebookWebhook_Handle_InjectionProfilesNotMatching(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	cli := newInjectionClient(t,
+		newWorkbenches(
+			componentApi.NotebookInjectionProfile{
+				Name:              "other-namespaces",
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "other"}},
+				TrustedCABundle:   true,
+			},
+			componentApi.NotebookInjectionProfile{
+				Name:     "other-notebooks",
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "other"}},
+				Proxy:    true,
+			},
+		),
+	)
+	webhook := createTestWebhook(t, cli)
+
+	resp := webhook.Handle(t.Context(), createAdmissionRequest(t, admissionv1.Create, createNotebook(), nil))
+
+	g.Expect(resp.Allowed).Should(BeTrue())
+	g.Expect(resp.Patches).Should(BeEmpty())
+	g.Expect(resp.Result.Message).Should(ContainSubstring("no injection needed"))
+}
+
+func TestNotebookWebhook_Handle_InjectionProfilesUnavailableSources(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	sch, err := scheme.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	// no trusted CA bundle, cluster proxy, or pipeline server
+	cli := fake.NewClientBuilder().WithScheme(sch).WithObjects(
+		newWorkbenches(componentApi.NotebookInjectionProfile{
+			Name:             "all",
+			TrustedCABundle:  true,
+			Proxy:            true,
+			Pipelines:        true,
+			ImagePullSecrets: []string{"registry"},
+		}),
+	).Build()
+	webhook := createTestWebhook(t, cli)
+
+	injected := admit(t, webhook, createNotebook())
+
+	g.Expect(containerEnv(injected)).Should(BeEmpty())
+	g.Expect(names(injected, notebook.NotebookVolumesPath...)).Should(BeEmpty())
+	g.Expect(names(injected, notebook.NotebookImagePullSecretsPath...)).Should(ConsistOf("registry"))
+	g.Expect(injected.GetAnnotations()).Should(HaveKeyWithValue(annotations.NotebookInjections, `{"imagePullSecrets":["registry"]}`))
+}
+
+func TestNotebookWebhook_Handle_InjectionProfilesUnresolved(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	webhook := createTestWebhook(t, newInjectionClient(t,
+		newWorkbenches(componentApi.NotebookInjectionProfile{
+			Name:            "invalid",
+			TrustedCABundle: true,
+			Selector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "team", Operator: "Unknown"}},
+			},
+		}),
+	))
+
+	// without previous injection the notebook is admitted unchanged
+	nb := createNotebook()
+	resp := webhook.Handle(t.Context(), createAdmissionRequest(t, admissionv1.Update, nb, nb))
+	g.Expect(resp.Allowed).Should(BeTrue())
+	g.Expect(resp.Patches).Should(BeEmpty())
+
+	// the previous injection can't be kept up to date
+	nb.SetAnnotations(map[string]string{annotations.NotebookInjections: `{"env":["SSL_CERT_FILE"]}`})
+	resp = webhook.Handle(t.Context(), createAdmissionRequest(t, admissionv1.Update, nb, nb))
+	g.Expect(resp.Allowed).Should(BeFalse())
+}
@@ -0,0 +1,33 @@
+//go:build !nowebhook
+
+package notebook
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	// NotebookCullAttemptsTotal is a prometheus counter metrics which holds the total number of
+	// stops of notebooks by the culler of the notebook controller admitted by the webhook. Since
+	// it is counted at admission, a stop rejected afterwards, e.g. by another admission webhook or
+	// a conflict, is counted as well: it counts the attempts, not the culled notebooks. It has one
+	// label. namespace label refers to the namespace of the notebook.
+	NotebookCullAttemptsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "workbenches_notebook_cull_attempts_total",
+			Help: "Number of stops of idle notebooks by the culler of the notebook controller admitted by the webhook",
+		},
+		[]string{
+			"namespace",
+		},
+	)
+)
+
+// init register metrics to the global registry from controller-runtime/pkg/metrics.
+// see https://book.kubebuilder.io/reference/metrics#publishing-additional-metrics
+//
+//nolint:gochecknoinits
+func init() {
+	metrics.Registry.MustRegister(NotebookCullAttemptsTotal)
+}
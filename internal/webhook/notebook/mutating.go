@@ -92,26 +92,41 @@ func (w *NotebookWebhook) Handle(ctx context.Context, req admission.Request) adm
 			return validationResp
 		}
 
-		// Skip proceeding to injection if shouldInject is false or the secretRefs nil
-		if !shouldInject || notebookSecretRefs == nil {
-			return admission.Allowed(fmt.Sprintf("Connection annotation validation passed in namespace %s for %s, no injection needed", req.Namespace, req.Kind.Kind))
+		// Perform connection injection, only if shouldInject is true and the secretRefs not nil
+		connectionsInjected := false
+		if shouldInject && notebookSecretRefs != nil {
+			injectionPerformed, _, err := w.performConnectionInjection(notebook, notebookSecretRefs)
+			if err != nil {
+				log.Error(err, "Failed to perform connection injection")
+				return admission.Errored(http.StatusInternalServerError, err)
+			}
+			connectionsInjected = injectionPerformed
 		}
 
-		// Perform connection injection
-		injectionPerformed, obj, err := w.performConnectionInjection(notebook, notebookSecretRefs)
+		// Perform the injection of the Workbenches injection profiles
+		profilesInjected, err := w.performProfileInjection(ctx, notebook, req.Namespace)
 		if err != nil {
-			log.Error(err, "Failed to perform connection injection")
+			log.Error(err, "Failed to perform injection profiles")
 			return admission.Errored(http.StatusInternalServerError, err)
 		}
 
-		if injectionPerformed {
-			marshaledObj, err := json.Marshal(obj)
-			if err != nil {
-				log.Error(err, "Failed to marshal modified object")
-				return admission.Errored(http.StatusInternalServerError, err)
-			}
-			return admission.PatchResponseFromRaw(req.Object.Raw, marshaledObj)
+		// Prevent the stop by the culler of the notebooks exempted by the culling policy
+		cullingPrevented, err := w.performCullingGuard(ctx, notebook, &req)
+		if err != nil {
+			log.Error(err, "Failed to apply the culling policy")
+			return admission.Errored(http.StatusInternalServerError, err)
+		}
+
+		if !connectionsInjected && !profilesInjected && !cullingPrevented {
+			return admission.Allowed(fmt.Sprintf("Connection annotation validation passed in namespace %s for %s, no injection needed", req.Namespace, req.Kind.Kind))
+		}
+
+		marshaledObj, err := json.Marshal(notebook)
+		if err != nil {
+			log.Error(err, "Failed to marshal modified object")
+			return admission.Errored(http.StatusInternalServerError, err)
 		}
+		return admission.PatchResponseFromRaw(req.Object.Raw, marshaledObj)
 
 	default:
 		resp = admission.Allowed(fmt.Sprintf("Operation %s on %s allowed", req.Operation, req.Kind.Kind))
@@ -0,0 +1,31 @@
+//go:build !nowebhook
+
+package operatorconfig
+
+import (
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	cr "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/components/registry"
+	sr "github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/services/registry"
+)
+
+// RegisterWebhooks registers the webhooks for OperatorConfig validation.
+//
+// Parameters:
+//   - mgr: The controller-runtime manager to register webhooks with.
+//
+// Returns:
+//   - error: Any error encountered during webhook registration.
+func RegisterWebhooks(mgr ctrl.Manager) error {
+	if err := (&Validator{
+		Decoder:    admission.NewDecoder(mgr.GetScheme()),
+		Name:       "operatorconfig-validating",
+		Components: cr.DefaultRegistry().Names(),
+		Services:   sr.DefaultRegistry().Names(),
+	}).SetupWithManager(mgr); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+//go:build !nowebhook
+
+package operatorconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v2"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/initialinstall"
+	webhookutils "github.com/opendatahub-io/opendatahub-operator/v2/pkg/webhook"
+)
+
+//+kubebuilder:webhook:path=/validate-operatorconfig,mutating=false,failurePolicy=fail,sideEffects=None,groups=services.platform.opendatahub.io,resources=operatorconfigs,verbs=create;update,versions=v1alpha1,name=operatorconfig-validator.opendatahub.io,admissionReviewVersions=v1
+//nolint:lll
+
+// Validator implements webhook.AdmissionHandler for OperatorConfig validation webhooks.
+// It rejects the components, services and default management states the operator does not know.
+type Validator struct {
+	Decoder admission.Decoder
+	Name    string
+	// Components and Services are the names of all the components and services of the operator,
+	// including the ones not allowed by the running configuration.
+	Components []string
+	Services   []string
+}
+
+// Assert that Validator implements admission.Handler interface.
+var _ admission.Handler = &Validator{}
+
+// SetupWithManager registers the validating webhook with the provided controller-runtime manager.
+//
+// Parameters:
+//   - mgr: The controller-runtime manager to register the webhook with.
+//
+// Returns:
+//   - error: Always nil (for future extensibility).
+func (v *Validator) SetupWithManager(mgr ctrl.Manager) error {
+	hookServer := mgr.GetWebhookServer()
+	hookServer.Register("/validate-operatorconfig", &webhook.Admission{
+		Handler:        v,
+		LogConstructor: webhookutils.NewWebhookLogConstructor(v.Name),
+	})
+	return nil
+}
+
+// Handle processes admission requests for create and update operations on OperatorConfig resources.
+//
+// Parameters:
+//   - ctx: Context for the admission request (logger is extracted from here).
+//   - req: The admission.Request containing the operation and object details.
+//
+// Returns:
+//   - admission.Response: The result of the admission check, indicating whether the operation is allowed or denied.
+func (v *Validator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	log := logf.FromContext(ctx)
+
+	if v.Decoder == nil {
+		log.Error(nil, "Decoder is nil - webhook not properly initialized")
+		return admission.Errored(http.StatusInternalServerError, errors.New("webhook decoder not initialized"))
+	}
+
+	switch req.Operation {
+	case admissionv1.Create, admissionv1.Update:
+		oc := &serviceApi.OperatorConfig{}
+		if err := v.Decoder.Decode(req, oc); err != nil {
+			log.Error(err, "failed to decode object")
+			return admission.Errored(http.StatusBadRequest, fmt.Errorf("failed to decode object: %w", err))
+		}
+
+		if errs := v.Validate(&oc.Spec); len(errs) > 0 {
+			return admission.Denied(errs.ToAggregate().Error())
+		}
+	}
+
+	return admission.Allowed(fmt.Sprintf("Operation %s on %s allowed", req.Operation, req.Kind.Kind))
+}
+
+// Validate returns the errors of the settings of spec that can't be checked by the CRD schema.
+func (v *Validator) Validate(spec *serviceApi.OperatorConfigSpec) field.ErrorList {
+	var errs field.ErrorList
+
+	specPath := field.NewPath("spec")
+
+	for i, name := range spec.Components {
+		if !slices.Contains(v.Components, name) {
+			errs = append(errs, field.NotSupported(specPath.Child("components").Index(i), name, v.Components))
+		}
+	}
+
+	for i, name := range spec.Services {
+		if !slices.Contains(v.Services, name) {
+			errs = append(errs, field.NotSupported(specPath.Child("services").Index(i), name, v.Services))
+		}
+	}
+
+	for i, ns := range spec.Cache.Namespaces {
+		if msgs := validation.IsDNS1123Label(ns); len(msgs) > 0 {
+			errs = append(errs, field.Invalid(specPath.Child("cache", "namespaces").Index(i), ns, strings.Join(msgs, ", ")))
+		}
+	}
+
+	statesPath := specPath.Child("defaultManagementStates")
+	for _, name := range slices.Sorted(maps.Keys(spec.DefaultManagementStates)) {
+		state := spec.DefaultManagementStates[name]
+		if state != operatorv1.Managed && state != operatorv1.Removed {
+			errs = append(errs, field.NotSupported(statesPath.Key(name), state, []operatorv1.ManagementState{operatorv1.Managed, operatorv1.Removed}))
+			continue
+		}
+
+		err := initialinstall.SetManagementStates(&dscv2.DataScienceCluster{}, map[string]operatorv1.ManagementState{name: state})
+		if err != nil {
+			errs = append(errs, field.Invalid(statesPath.Key(name), name, "not a component of the DataScienceCluster"))
+		}
+	}
+
+	for i, d := range spec.Webhooks.Deprecations {
+		schedulePath := specPath.Child("webhooks", "deprecations").Index(i).Child("schedule")
+		for j := 1; j < len(d.Schedule); j++ {
+			if !d.Schedule[j-1].From.Before(&d.Schedule[j].From) {
+				errs = append(errs, field.Invalid(schedulePath.Index(j).Child("from"), d.Schedule[j].From, "transitions must be in chronological order"))
+			}
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,152 @@
+package operatorconfig_test
+
+import (
+	"testing"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/envtestutil"
+	operatorconfigwebhook "github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/operatorconfig"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/scheme"
+
+	. "github.com/onsi/gomega"
+)
+
+func newOperatorConfig(spec serviceApi.OperatorConfigSpec) *serviceApi.OperatorConfig {
+	return &serviceApi.OperatorConfig{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gvk.OperatorConfig.GroupVersion().String(),
+			Kind:       gvk.OperatorConfig.Kind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: serviceApi.OperatorConfigInstanceName,
+		},
+		Spec: spec,
+	}
+}
+
+// TestOperatorConfigWebhook exercises the validating webhook logic for OperatorConfig resources.
+func TestOperatorConfigWebhook(t *testing.T) {
+	t.Parallel()
+
+	sch, err := scheme.New()
+	NewWithT(t).Expect(err).ShouldNot(HaveOccurred())
+
+	validator := &operatorconfigwebhook.Validator{
+		Decoder:    admission.NewDecoder(sch),
+		Name:       "test-validator",
+		Components: []string{"dashboard", "ray"},
+		Services:   []string{"auth", "monitoring"},
+	}
+
+	cases := []struct {
+		name    string
+		op      admissionv1.Operation
+		spec    serviceApi.OperatorConfigSpec
+		allowed bool
+		message string
+	}{
+		{
+			name:    "Allows empty spec",
+			op:      admissionv1.Create,
+			allowed: true,
+		},
+		{
+			name: "Allows known components, services and management states",
+			op:   admissionv1.Update,
+			spec: serviceApi.OperatorConfigSpec{
+				Components: []string{"dashboard"},
+				Services:   []string{"monitoring"},
+				Cache:      serviceApi.OperatorCacheSpec{Namespaces: []string{"my-namespace"}},
+				DefaultManagementStates: map[string]operatorv1.ManagementState{
+					"dashboard":   operatorv1.Managed,
+					"aipipelines": operatorv1.Removed,
+				},
+			},
+			allowed: true,
+		},
+		{
+			name:    "Denies unknown component",
+			op:      admissionv1.Create,
+			spec:    serviceApi.OperatorConfigSpec{Components: []string{"unknown"}},
+			message: "spec.components[0]",
+		},
+		{
+			name:    "Denies unknown service",
+			op:      admissionv1.Update,
+			spec:    serviceApi.OperatorConfigSpec{Services: []string{"auth", "unknown"}},
+			message: "spec.services[1]",
+		},
+		{
+			name:    "Denies invalid cache namespace",
+			op:      admissionv1.Create,
+			spec:    serviceApi.OperatorConfigSpec{Cache: serviceApi.OperatorCacheSpec{Namespaces: []string{"Invalid_NS"}}},
+			message: "spec.cache.namespaces[0]",
+		},
+		{
+			name: "Denies unknown default management state component",
+			op:   admissionv1.Create,
+			spec: serviceApi.OperatorConfigSpec{
+				DefaultManagementStates: map[string]operatorv1.ManagementState{"unknown": operatorv1.Managed},
+			},
+			message: "spec.defaultManagementStates[unknown]",
+		},
+		{
+			name: "Denies unmanaged default management state",
+			op:   admissionv1.Create,
+			spec: serviceApi.OperatorConfigSpec{
+				DefaultManagementStates: map[string]operatorv1.ManagementState{"dashboard": operatorv1.Unmanaged},
+			},
+			message: "spec.defaultManagementStates[dashboard]",
+		},
+		{
+			name: "Denies deprecation schedule out of order",
+			op:   admissionv1.Update,
+			spec: serviceApi.OperatorConfigSpec{
+				Webhooks: serviceApi.OperatorWebhooksSpec{
+					Deprecations: []serviceApi.DeprecatedAPISpec{{
+						Group: gvk.DashboardAcceleratorProfile.Group,
+						Kind:  gvk.DashboardAcceleratorProfile.Kind,
+						Phase: "Warn",
+						Schedule: []serviceApi.DeprecationPhaseTransition{
+							{Phase: "DenyAll", From: metav1.NewTime(time.Date(2026, time.June, 1, 0, 0, 0, 0, time.UTC))},
+							{Phase: "DenyNew", From: metav1.NewTime(time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC))},
+						},
+					}},
+				},
+			},
+			message: "spec.webhooks.deprecations[0].schedule[1].from",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			req := envtestutil.NewAdmissionRequest(
+				t,
+				tc.op,
+				newOperatorConfig(tc.spec),
+				gvk.OperatorConfig,
+				metav1.GroupVersionResource{
+					Group:    gvk.OperatorConfig.Group,
+					Version:  gvk.OperatorConfig.Version,
+					Resource: "operatorconfigs",
+				},
+			)
+
+			resp := validator.Handle(t.Context(), req)
+			g.Expect(resp.Allowed).To(Equal(tc.allowed))
+			if tc.message != "" {
+				g.Expect(resp.Result.Message).To(ContainSubstring(tc.message))
+			}
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 	hardwareprofilewebhook "github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/hardwareprofile"
 	kueuewebhook "github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/kueue"
 	notebookwebhook "github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/notebook"
+	operatorconfigwebhook "github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/operatorconfig"
 	serving "github.com/opendatahub-io/opendatahub-operator/v2/internal/webhook/serving"
 )
 
@@ -29,6 +30,7 @@ func RegisterAllWebhooks(mgr ctrl.Manager) error {
 		serving.RegisterWebhooks,
 		notebookwebhook.RegisterWebhooks,
 		dashboard.RegisterWebhooks,
+		operatorconfigwebhook.RegisterWebhooks,
 	}
 	for _, reg := range webhookRegistrations {
 		if err := reg(mgr); err != nil {
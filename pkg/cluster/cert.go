@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"math/big"
 	"net"
+	"slices"
 	"strings"
 	"time"
 
@@ -30,6 +31,9 @@ import (
 const (
 	CertFieldOwner   = resources.PlatformFieldOwner + "/cert"
 	IngressNamespace = "openshift-ingress"
+
+	// DefaultCertificateValidity is the lifetime of generated self-signed certificates.
+	DefaultCertificateValidity = 365 * 24 * time.Hour
 )
 
 var IngressControllerName = types.NamespacedName{
@@ -59,8 +63,86 @@ func CreateSelfSignedCertificate(ctx context.Context, c client.Client, secretNam
 	return nil
 }
 
+// EnsureSelfSignedCertificate creates a self-signed certificate secret, or replaces the existing
+// one when it is not issued for domain or expires within renewBefore. A still valid certificate
+// is left untouched so consumers do not reload it on every reconcile. It returns true when a new
+// certificate has been written.
+func EnsureSelfSignedCertificate(
+	ctx context.Context,
+	c client.Client,
+	secretName, domain, namespace string,
+	validity, renewBefore time.Duration,
+	metaOptions ...MetaOptions,
+) (bool, error) {
+	existing, err := GetSecret(ctx, c, namespace, secretName)
+	if err != nil && !k8serr.IsNotFound(err) {
+		return false, fmt.Errorf("failed to get certificate secret %s/%s: %w", namespace, secretName, err)
+	}
+	if err == nil && !CertificateNeedsRenewal(existing, domain, renewBefore, time.Now()) {
+		return false, nil
+	}
+
+	certSecret, err := generateSelfSignedCertificateAsSecret(secretName, domain, namespace, validity)
+	if err != nil {
+		return false, fmt.Errorf("failed generating self-signed certificate: %w", err)
+	}
+
+	if errApply := ApplyMetaOptions(certSecret, metaOptions...); errApply != nil {
+		return false, errApply
+	}
+
+	err = resources.Apply(ctx, c, certSecret, client.ForceOwnership, client.FieldOwner(CertFieldOwner))
+	if err != nil && !k8serr.IsAlreadyExists(err) {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// CertificateNeedsRenewal returns true when the certificate stored in secret cannot be parsed,
+// is not issued for domain, or expires before now+renewBefore.
+func CertificateNeedsRenewal(secret *corev1.Secret, domain string, renewBefore time.Duration, now time.Time) bool {
+	cert, err := CertificateFromSecret(secret)
+	if err != nil {
+		return true
+	}
+
+	if !now.Add(renewBefore).Before(cert.NotAfter) {
+		return true
+	}
+
+	return cert.Subject.CommonName != domain && !slices.Contains(cert.DNSNames, domain)
+}
+
+// CertificateFromSecret parses the first PEM encoded certificate found in the tls.crt key of secret.
+func CertificateFromSecret(secret *corev1.Secret) (*x509.Certificate, error) {
+	data, ok := secret.Data[corev1.TLSCertKey]
+	if !ok {
+		return nil, fmt.Errorf("secret %s/%s has no %s key", secret.Namespace, secret.Name, corev1.TLSCertKey)
+	}
+
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing certificate: %w", err)
+		}
+
+		return cert, nil
+	}
+
+	return nil, fmt.Errorf("no certificate found in secret %s/%s", secret.Namespace, secret.Name)
+}
+
 func GenerateSelfSignedCertificateAsSecret(name, addr, namespace string) (*corev1.Secret, error) {
-	cert, key, err := generateCertificate(addr)
+	return generateSelfSignedCertificateAsSecret(name, addr, namespace, DefaultCertificateValidity)
+}
+
+func generateSelfSignedCertificateAsSecret(name, addr, namespace string, validity time.Duration) (*corev1.Secret, error) {
+	cert, key, err := generateCertificate(addr, validity)
 	if err != nil {
 		return nil, fmt.Errorf("error generating certificate: %w", err)
 	}
@@ -82,7 +164,7 @@ func GenerateSelfSignedCertificateAsSecret(name, addr, namespace string) (*corev
 	}, nil
 }
 
-func generateCertificate(addr string) ([]byte, []byte, error) {
+func generateCertificate(addr string, validity time.Duration) ([]byte, []byte, error) {
 	key, err := rsa.GenerateKey(rand.Reader, 2048)
 	if err != nil {
 		return nil, nil, fmt.Errorf("error generating key: %w", err)
@@ -101,7 +183,7 @@ func generateCertificate(addr string) ([]byte, []byte, error) {
 			Organization: []string{"opendatahub-self-signed"},
 		},
 		NotBefore:             now.UTC(),
-		NotAfter:              now.Add(time.Second * 60 * 60 * 24 * 365).UTC(),
+		NotAfter:              now.Add(validity).UTC(),
 		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
 		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
 		BasicConstraintsValid: true,
@@ -190,8 +272,10 @@ func GetDefaultIngressCertSecretName(ingressCtrl *operatorv1.IngressController)
 	return "router-certs-" + ingressCtrl.Name
 }
 
-// IsGatewayCertificateSecret returns true if obj is a certificate secret used by the GatewayConfig.
-// It checks for both OpenShift default ingress certificates and provided certificates.
+// IsGatewayCertificateSecret returns true if obj is a certificate secret used by the GatewayConfig,
+// either by the default gateway or by one of the additional gateways.
+// It checks for OpenShift default ingress certificates, provided certificates and certificates
+// issued by cert-manager.
 func IsGatewayCertificateSecret(ctx context.Context, cli client.Client, obj client.Object, gatewayNamespace string) bool {
 	if obj.GetNamespace() != gatewayNamespace {
 		return false
@@ -202,14 +286,51 @@ func IsGatewayCertificateSecret(ctx context.Context, cli client.Client, obj clie
 		return false
 	}
 
-	if gatewayConfig.Spec.Certificate == nil {
-		return false
+	if isGatewayCertificateSecret(ctx, cli, obj,
+		gatewayConfig.Spec.Certificate,
+		gatewayConfig.Spec.CertificateLifecycle,
+		fmt.Sprintf("%s-tls", gatewayConfig.Name),
+	) {
+		return true
 	}
 
-	certConfig := *gatewayConfig.Spec.Certificate
-	certType := certConfig.Type
+	for _, gw := range gatewayConfig.Spec.AdditionalGateways {
+		if isGatewayCertificateSecret(ctx, cli, obj,
+			gw.Certificate,
+			gw.CertificateLifecycle,
+			fmt.Sprintf("%s-%s-tls", gatewayConfig.Name, gw.Name),
+		) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isGatewayCertificateSecret(
+	ctx context.Context,
+	cli client.Client,
+	obj client.Object,
+	certificate *infrav1.CertificateSpec,
+	lifecycle *serviceApi.CertificateLifecycleConfig,
+	defaultSecretName string,
+) bool {
+	// secrets issued by cert-manager are not owned by the GatewayConfig, renewals must be watched.
+	if lifecycle != nil && lifecycle.IssuerRef != nil {
+		expectedName := defaultSecretName
+		if certificate != nil && certificate.SecretName != "" {
+			expectedName = certificate.SecretName
+		}
+		if obj.GetName() == expectedName {
+			return true
+		}
+	}
+
+	if certificate == nil {
+		return false
+	}
 
-	switch certType {
+	switch certificate.Type {
 	case infrav1.OpenshiftDefaultIngress, "":
 		ingressCtrl, err := FindAvailableIngressController(ctx, cli)
 		if err != nil {
@@ -220,9 +341,9 @@ func IsGatewayCertificateSecret(ctx context.Context, cli client.Client, obj clie
 		return obj.GetName() == ingressCertName
 
 	case infrav1.Provided:
-		expectedName := certConfig.SecretName
+		expectedName := certificate.SecretName
 		if expectedName == "" {
-			expectedName = fmt.Sprintf("%s-tls", gatewayConfig.Name)
+			expectedName = defaultSecretName
 		}
 		return obj.GetName() == expectedName
 
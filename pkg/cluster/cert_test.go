@@ -0,0 +1,88 @@
+package cluster_test
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+
+	. "github.com/onsi/gomega"
+)
+
+// TestCertificateFromSecret tests parsing of a generated self-signed certificate.
+func TestCertificateFromSecret(t *testing.T) {
+	g := NewWithT(t)
+
+	secret, err := cluster.GenerateSelfSignedCertificateAsSecret("test-cert", "gw.example.com", "test-ns")
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	cert, err := cluster.CertificateFromSecret(secret)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(cert.DNSNames).Should(ContainElement("gw.example.com"))
+	g.Expect(cert.NotAfter).Should(BeTemporally("~", time.Now().Add(cluster.DefaultCertificateValidity), time.Minute))
+
+	_, err = cluster.CertificateFromSecret(&corev1.Secret{})
+	g.Expect(err).Should(HaveOccurred())
+
+	_, err = cluster.CertificateFromSecret(&corev1.Secret{
+		Data: map[string][]byte{corev1.TLSCertKey: []byte("not a certificate")},
+	})
+	g.Expect(err).Should(HaveOccurred())
+}
+
+// TestCertificateNeedsRenewal tests the renewal decision for self-signed certificates.
+func TestCertificateNeedsRenewal(t *testing.T) {
+	g := NewWithT(t)
+
+	secret, err := cluster.GenerateSelfSignedCertificateAsSecret("test-cert", "gw.example.com", "test-ns")
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	now := time.Now()
+	renewBefore := 30 * 24 * time.Hour
+
+	testCases := []struct {
+		name     string
+		secret   *corev1.Secret
+		domain   string
+		now      time.Time
+		expected bool
+	}{
+		{
+			name:     "valid certificate is kept",
+			secret:   secret,
+			domain:   "gw.example.com",
+			now:      now,
+			expected: false,
+		},
+		{
+			name:     "certificate for another domain is renewed",
+			secret:   secret,
+			domain:   "other.example.com",
+			now:      now,
+			expected: true,
+		},
+		{
+			name:     "certificate inside the renewal window is renewed",
+			secret:   secret,
+			domain:   "gw.example.com",
+			now:      now.Add(cluster.DefaultCertificateValidity - renewBefore + time.Hour),
+			expected: true,
+		},
+		{
+			name:     "secret without certificate is renewed",
+			secret:   &corev1.Secret{},
+			domain:   "gw.example.com",
+			now:      now,
+			expected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(cluster.CertificateNeedsRenewal(tc.secret, tc.domain, renewBefore, tc.now)).Should(Equal(tc.expected))
+		})
+	}
+}
@@ -2,6 +2,9 @@ package cluster
 
 import "github.com/opendatahub-io/opendatahub-operator/v2/api/common"
 
+// Platforms lists the platforms the operator can be built for.
+var Platforms = []common.Platform{OpenDataHub, SelfManagedRhoai, ManagedRhoai}
+
 const (
 	// ManagedRhoai defines expected addon catalogsource.
 	ManagedRhoai common.Platform = "OpenShift AI Cloud Service"
@@ -38,4 +41,8 @@ const (
 
 	// KueueLegacyManagedLabelKey is the legacy label key used to indicate a namespace is managed by Kueue.
 	KueueLegacyManagedLabelKey = "kueue-managed"
+
+	// KueueQueueValidationAnnotation sets the mode, enforce or warn, of the LocalQueue checks of
+	// the Kueue webhook for the workloads of a namespace.
+	KueueQueueValidationAnnotation = "opendatahub.io/kueue-queue-validation"
 )
@@ -194,6 +194,12 @@ var (
 		Kind:    "Ingress",
 	}
 
+	OpenshiftProxy = schema.GroupVersionKind{
+		Group:   "config.openshift.io",
+		Version: "v1",
+		Kind:    "Proxy",
+	}
+
 	OdhApplication = schema.GroupVersionKind{
 		Group:   "dashboard.opendatahub.io",
 		Version: "v1",
@@ -260,6 +266,12 @@ var (
 		Kind:    componentApi.DataSciencePipelinesKind,
 	}
 
+	DataSciencePipelinesApplication = schema.GroupVersionKind{
+		Group:   "datasciencepipelinesapplications.opendatahub.io",
+		Version: "v1",
+		Kind:    "DataSciencePipelinesApplication",
+	}
+
 	Kserve = schema.GroupVersionKind{
 		Group:   componentApi.GroupVersion.Group,
 		Version: componentApi.GroupVersion.Version,
@@ -356,6 +368,12 @@ var (
 		Kind:    "EnvoyFilter",
 	}
 
+	CertManagerCertificate = schema.GroupVersionKind{
+		Group:   "cert-manager.io",
+		Version: "v1",
+		Kind:    "Certificate",
+	}
+
 	AuthorizationPolicy = schema.GroupVersionKind{
 		Group:   "security.istio.io",
 		Version: "v1",
@@ -404,6 +422,12 @@ var (
 		Kind:    serviceApi.AuthKind,
 	}
 
+	OperatorConfig = schema.GroupVersionKind{
+		Group:   serviceApi.GroupVersion.Group,
+		Version: serviceApi.GroupVersion.Version,
+		Kind:    serviceApi.OperatorConfigKind,
+	}
+
 	MultiKueueConfigV1Alpha1 = schema.GroupVersionKind{
 		Group:   "kueue.x-k8s.io",
 		Version: "v1alpha1",
@@ -554,6 +578,12 @@ var (
 		Kind:    "RayCluster",
 	}
 
+	ArgoWorkflow = schema.GroupVersionKind{
+		Group:   "argoproj.io",
+		Version: "v1alpha1",
+		Kind:    "Workflow",
+	}
+
 	TempoMonolithic = schema.GroupVersionKind{
 		Group:   "tempo.grafana.com",
 		Version: "v1alpha1",
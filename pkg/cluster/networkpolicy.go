@@ -0,0 +1,278 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+)
+
+const (
+	// DNSNamespace is the namespace of the cluster DNS pods.
+	DNSNamespace = "openshift-dns"
+
+	apiServerService = "kubernetes"
+	apiServerPort    = 6443
+	apiServerSvcPort = 443
+	dnsPort          = 53
+	dnsTargetPort    = 5353
+)
+
+// NetworkPolicyModeOf returns the mode NetworkPolicies are generated with, Enforce by default.
+func NetworkPolicyModeOf(config *serviceApi.NetworkPolicyConfig) serviceApi.NetworkPolicyMode {
+	if config == nil || config.Mode == "" {
+		return serviceApi.NetworkPolicyModeEnforce
+	}
+	return config.Mode
+}
+
+// NamespacePeer returns a peer selecting all the pods of the namespace.
+func NamespacePeer(namespace string) networkingv1.NetworkPolicyPeer {
+	return networkingv1.NetworkPolicyPeer{
+		NamespaceSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{"kubernetes.io/metadata.name": namespace},
+		},
+	}
+}
+
+// ODHNamespacePeers returns the peers selecting the namespaces generated by the operator and the
+// customized applications namespace.
+func ODHNamespacePeers() []networkingv1.NetworkPolicyPeer {
+	return []networkingv1.NetworkPolicyPeer{
+		{
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{labels.ODH.OwnedNamespace: labels.True},
+			},
+		},
+		{
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{labels.CustomizedAppNamespace: labels.True},
+			},
+		},
+	}
+}
+
+// NewIngressNetworkPolicy returns a NetworkPolicy allowing traffic from peers to the pods
+// selected by podSelector, or nil when there is no peer.
+func NewIngressNetworkPolicy(name, namespace string, podSelector metav1.LabelSelector, peers []serviceApi.NetworkPolicyPeer) *networkingv1.NetworkPolicy {
+	if len(peers) == 0 {
+		return nil
+	}
+
+	np := newNetworkPolicy(name, namespace, podSelector, networkingv1.PolicyTypeIngress)
+	for _, peer := range peers {
+		from, ports := toNetworkPolicyPeer(peer)
+		np.Spec.Ingress = append(np.Spec.Ingress, networkingv1.NetworkPolicyIngressRule{
+			From:  from,
+			Ports: ports,
+		})
+	}
+
+	return np
+}
+
+// APIServerPeers returns the peers selecting the Kubernetes API server. The API server runs on the
+// host network, so it can't be selected by namespace: the peers are the cluster IPs of the
+// kubernetes Service and the addresses of its endpoints, as the NetworkPolicy implementations
+// match the traffic either before or after the Service translation.
+func APIServerPeers(ctx context.Context, cli client.Reader) ([]networkingv1.NetworkPolicyPeer, error) {
+	key := client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: apiServerService}
+
+	svc := corev1.Service{}
+	if err := cli.Get(ctx, key, &svc); err != nil {
+		return nil, fmt.Errorf("failed to get the API server Service: %w", err)
+	}
+
+	endpoints := corev1.Endpoints{}
+	if err := cli.Get(ctx, key, &endpoints); err != nil {
+		return nil, fmt.Errorf("failed to get the API server Endpoints: %w", err)
+	}
+
+	ips := append([]string{}, svc.Spec.ClusterIPs...)
+	for _, subset := range endpoints.Subsets {
+		for _, address := range subset.Addresses {
+			ips = append(ips, address.IP)
+		}
+	}
+
+	peers := make([]networkingv1.NetworkPolicyPeer, 0, len(ips))
+	for _, ip := range ips {
+		parsed := net.ParseIP(ip)
+		if parsed == nil {
+			continue
+		}
+
+		cidr := ip + "/32"
+		if parsed.To4() == nil {
+			cidr = ip + "/128"
+		}
+
+		peers = append(peers, networkingv1.NetworkPolicyPeer{IPBlock: &networkingv1.IPBlock{CIDR: cidr}})
+	}
+
+	return peers, nil
+}
+
+// EgressAPIServerPeers returns the API server peers of the egress NetworkPolicies generated with
+// config, none when egress is not enabled or the API server is not allowed.
+func EgressAPIServerPeers(ctx context.Context, cli client.Reader, config *serviceApi.EgressPolicyConfig) ([]networkingv1.NetworkPolicyPeer, error) {
+	if config == nil || !config.Enabled || !ptr.Deref(config.AllowAPIServer, true) {
+		return nil, nil
+	}
+
+	return APIServerPeers(ctx, cli)
+}
+
+// NewEgressNetworkPolicy returns a NetworkPolicy denying egress traffic of the pods selected by
+// podSelector, except to the apiServerPeers, DNS and monitoring namespaces unless disabled in
+// config, to basePeers and to the additional peers of config. It returns nil when egress is not
+// enabled.
+func NewEgressNetworkPolicy(
+	name string,
+	namespace string,
+	podSelector metav1.LabelSelector,
+	config *serviceApi.EgressPolicyConfig,
+	monitoringNamespaces []string,
+	apiServerPeers []networkingv1.NetworkPolicyPeer,
+	basePeers ...networkingv1.NetworkPolicyPeer,
+) *networkingv1.NetworkPolicy {
+	if config == nil || !config.Enabled {
+		return nil
+	}
+
+	np := newNetworkPolicy(name, namespace, podSelector, networkingv1.PolicyTypeEgress)
+
+	// a rule without peer would allow the ports to any destination
+	if ptr.Deref(config.AllowAPIServer, true) && len(apiServerPeers) > 0 {
+		np.Spec.Egress = append(np.Spec.Egress, networkingv1.NetworkPolicyEgressRule{
+			To: apiServerPeers,
+			Ports: []networkingv1.NetworkPolicyPort{
+				networkPolicyPort(corev1.ProtocolTCP, apiServerPort),
+				networkPolicyPort(corev1.ProtocolTCP, apiServerSvcPort),
+			},
+		})
+	}
+
+	if ptr.Deref(config.AllowDNS, true) {
+		np.Spec.Egress = append(np.Spec.Egress, networkingv1.NetworkPolicyEgressRule{
+			To: []networkingv1.NetworkPolicyPeer{NamespacePeer(DNSNamespace)},
+			Ports: []networkingv1.NetworkPolicyPort{
+				networkPolicyPort(corev1.ProtocolUDP, dnsPort),
+				networkPolicyPort(corev1.ProtocolTCP, dnsPort),
+				networkPolicyPort(corev1.ProtocolUDP, dnsTargetPort),
+				networkPolicyPort(corev1.ProtocolTCP, dnsTargetPort),
+			},
+		})
+	}
+
+	if ptr.Deref(config.AllowMonitoring, true) && len(monitoringNamespaces) > 0 {
+		rule := networkingv1.NetworkPolicyEgressRule{}
+		for _, ns := range monitoringNamespaces {
+			rule.To = append(rule.To, NamespacePeer(ns))
+		}
+		np.Spec.Egress = append(np.Spec.Egress, rule)
+	}
+
+	if len(basePeers) > 0 {
+		np.Spec.Egress = append(np.Spec.Egress, networkingv1.NetworkPolicyEgressRule{
+			To: basePeers,
+		})
+	}
+
+	for _, peer := range config.AdditionalPeers {
+		to, ports := toNetworkPolicyPeer(peer)
+		np.Spec.Egress = append(np.Spec.Egress, networkingv1.NetworkPolicyEgressRule{
+			To:    to,
+			Ports: ports,
+		})
+	}
+
+	return np
+}
+
+// ApplyNetworkPolicyMode labels the NetworkPolicy with the mode it is generated with. In Audit
+// mode the policy only selects the pods that opted in with the audit label, so it is not
+// enforced on the other pods.
+func ApplyNetworkPolicyMode(np *networkingv1.NetworkPolicy, mode serviceApi.NetworkPolicyMode) {
+	if mode == "" {
+		mode = serviceApi.NetworkPolicyModeEnforce
+	}
+
+	if np.Labels == nil {
+		np.Labels = map[string]string{}
+	}
+	np.Labels[labels.NetworkPolicy.Mode] = string(mode)
+
+	if mode != serviceApi.NetworkPolicyModeAudit {
+		return
+	}
+
+	if np.Spec.PodSelector.MatchLabels == nil {
+		np.Spec.PodSelector.MatchLabels = map[string]string{}
+	}
+	np.Spec.PodSelector.MatchLabels[labels.NetworkPolicy.Audit] = labels.True
+}
+
+// GeneratedNetworkPolicyStatus returns the status entry of a generated NetworkPolicy.
+func GeneratedNetworkPolicyStatus(np *networkingv1.NetworkPolicy) serviceApi.GeneratedNetworkPolicy {
+	return serviceApi.GeneratedNetworkPolicy{
+		Name:        np.Name,
+		Namespace:   np.Namespace,
+		PolicyTypes: np.Spec.PolicyTypes,
+		Mode:        serviceApi.NetworkPolicyMode(np.Labels[labels.NetworkPolicy.Mode]),
+	}
+}
+
+func newNetworkPolicy(name, namespace string, podSelector metav1.LabelSelector, policyType networkingv1.PolicyType) *networkingv1.NetworkPolicy {
+	return &networkingv1.NetworkPolicy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: networkingv1.SchemeGroupVersion.String(),
+			Kind:       "NetworkPolicy",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: *podSelector.DeepCopy(),
+			PolicyTypes: []networkingv1.PolicyType{policyType},
+		},
+	}
+}
+
+func toNetworkPolicyPeer(peer serviceApi.NetworkPolicyPeer) ([]networkingv1.NetworkPolicyPeer, []networkingv1.NetworkPolicyPort) {
+	result := networkingv1.NetworkPolicyPeer{}
+
+	if peer.CIDR != "" {
+		result.IPBlock = &networkingv1.IPBlock{
+			CIDR:   peer.CIDR,
+			Except: peer.Except,
+		}
+	} else {
+		result.NamespaceSelector = peer.NamespaceSelector.DeepCopy()
+		result.PodSelector = peer.PodSelector.DeepCopy()
+	}
+
+	var ports []networkingv1.NetworkPolicyPort
+	for i := range peer.Ports {
+		ports = append(ports, *peer.Ports[i].DeepCopy())
+	}
+
+	return []networkingv1.NetworkPolicyPeer{result}, ports
+}
+
+func networkPolicyPort(protocol corev1.Protocol, port int32) networkingv1.NetworkPolicyPort {
+	return networkingv1.NetworkPolicyPort{
+		Protocol: ptr.To(protocol),
+		Port:     ptr.To(intstr.FromInt32(port)),
+	}
+}
@@ -0,0 +1,139 @@
+package cluster_test
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestNewIngressNetworkPolicy(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(cluster.NewIngressNetworkPolicy("np", "ns", metav1.LabelSelector{}, nil)).To(BeNil())
+
+	np := cluster.NewIngressNetworkPolicy("np", "ns", metav1.LabelSelector{}, []serviceApi.NetworkPolicyPeer{
+		{CIDR: "10.0.0.0/8", Except: []string{"10.1.0.0/16"}},
+		{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}}},
+	})
+	g.Expect(np).NotTo(BeNil())
+	g.Expect(np.Spec.PolicyTypes).To(Equal([]networkingv1.PolicyType{networkingv1.PolicyTypeIngress}))
+	g.Expect(np.Spec.Ingress).To(HaveLen(2))
+	g.Expect(np.Spec.Ingress[0].From[0].IPBlock.CIDR).To(Equal("10.0.0.0/8"))
+	g.Expect(np.Spec.Ingress[0].From[0].IPBlock.Except).To(ConsistOf("10.1.0.0/16"))
+	g.Expect(np.Spec.Ingress[1].From[0].NamespaceSelector.MatchLabels).To(HaveKeyWithValue("team", "a"))
+}
+
+func TestNewEgressNetworkPolicy(t *testing.T) {
+	g := NewWithT(t)
+
+	apiServerPeers := []networkingv1.NetworkPolicyPeer{{IPBlock: &networkingv1.IPBlock{CIDR: "10.0.0.1/32"}}}
+
+	g.Expect(cluster.NewEgressNetworkPolicy("np", "ns", metav1.LabelSelector{}, nil, nil, nil)).To(BeNil())
+	g.Expect(cluster.NewEgressNetworkPolicy("np", "ns", metav1.LabelSelector{}, &serviceApi.EgressPolicyConfig{}, nil, nil)).To(BeNil())
+
+	np := cluster.NewEgressNetworkPolicy(
+		"np",
+		"ns",
+		metav1.LabelSelector{},
+		&serviceApi.EgressPolicyConfig{Enabled: true},
+		[]string{"openshift-monitoring"},
+		apiServerPeers,
+		cluster.NamespacePeer("other"),
+	)
+	g.Expect(np).NotTo(BeNil())
+	g.Expect(np.Spec.PolicyTypes).To(Equal([]networkingv1.PolicyType{networkingv1.PolicyTypeEgress}))
+	// API server, DNS, monitoring and base peers
+	g.Expect(np.Spec.Egress).To(HaveLen(4))
+	g.Expect(np.Spec.Egress[0].To).To(Equal(apiServerPeers))
+	g.Expect(np.Spec.Egress[0].Ports).To(HaveLen(2))
+	g.Expect(np.Spec.Egress[1].To).To(ConsistOf(cluster.NamespacePeer(cluster.DNSNamespace)))
+	g.Expect(np.Spec.Egress[2].To).To(ConsistOf(cluster.NamespacePeer("openshift-monitoring")))
+	g.Expect(np.Spec.Egress[3].To).To(ConsistOf(cluster.NamespacePeer("other")))
+
+	np = cluster.NewEgressNetworkPolicy(
+		"np",
+		"ns",
+		metav1.LabelSelector{},
+		&serviceApi.EgressPolicyConfig{
+			Enabled:         true,
+			AllowAPIServer:  ptr.To(false),
+			AllowDNS:        ptr.To(false),
+			AllowMonitoring: ptr.To(false),
+			AdditionalPeers: []serviceApi.NetworkPolicyPeer{{CIDR: "192.168.0.0/16"}},
+		},
+		[]string{"openshift-monitoring"},
+		apiServerPeers,
+	)
+	g.Expect(np.Spec.Egress).To(HaveLen(1))
+	g.Expect(np.Spec.Egress[0].To[0].IPBlock.CIDR).To(Equal("192.168.0.0/16"))
+
+	// without API server peers, no rule opens the API server ports to any destination
+	np = cluster.NewEgressNetworkPolicy("np", "ns", metav1.LabelSelector{}, &serviceApi.EgressPolicyConfig{Enabled: true}, nil, nil)
+	for _, rule := range np.Spec.Egress {
+		g.Expect(rule.To).NotTo(BeEmpty())
+	}
+}
+
+func TestAPIServerPeers(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	apiServer := metav1.ObjectMeta{Name: "kubernetes", Namespace: metav1.NamespaceDefault}
+
+	cli, err := fakeclient.New(fakeclient.WithObjects(
+		&corev1.Service{ObjectMeta: apiServer, Spec: corev1.ServiceSpec{ClusterIPs: []string{"172.30.0.1", "fd02::1"}}},
+		&corev1.Endpoints{ObjectMeta: apiServer, Subsets: []corev1.EndpointSubset{{
+			Addresses: []corev1.EndpointAddress{{IP: "10.0.0.1"}, {IP: "10.0.0.2"}},
+		}}},
+	))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	peers, err := cluster.APIServerPeers(ctx, cli)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	cidrs := make([]string, 0, len(peers))
+	for _, p := range peers {
+		cidrs = append(cidrs, p.IPBlock.CIDR)
+	}
+	g.Expect(cidrs).To(ConsistOf("172.30.0.1/32", "fd02::1/128", "10.0.0.1/32", "10.0.0.2/32"))
+
+	peers, err = cluster.EgressAPIServerPeers(ctx, cli, &serviceApi.EgressPolicyConfig{Enabled: true, AllowAPIServer: ptr.To(false)})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(peers).To(BeEmpty())
+
+	empty, err := fakeclient.New()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	_, err = cluster.APIServerPeers(ctx, empty)
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestApplyNetworkPolicyMode(t *testing.T) {
+	g := NewWithT(t)
+
+	np := cluster.NewIngressNetworkPolicy("np", "ns", metav1.LabelSelector{}, []serviceApi.NetworkPolicyPeer{{CIDR: "10.0.0.0/8"}})
+
+	cluster.ApplyNetworkPolicyMode(np, "")
+	g.Expect(np.Labels).To(HaveKeyWithValue(labels.NetworkPolicy.Mode, string(serviceApi.NetworkPolicyModeEnforce)))
+	g.Expect(np.Spec.PodSelector.MatchLabels).To(BeEmpty())
+
+	cluster.ApplyNetworkPolicyMode(np, serviceApi.NetworkPolicyModeAudit)
+	g.Expect(np.Labels).To(HaveKeyWithValue(labels.NetworkPolicy.Mode, string(serviceApi.NetworkPolicyModeAudit)))
+	g.Expect(np.Spec.PodSelector.MatchLabels).To(HaveKeyWithValue(labels.NetworkPolicy.Audit, labels.True))
+
+	status := cluster.GeneratedNetworkPolicyStatus(np)
+	g.Expect(status.Name).To(Equal("np"))
+	g.Expect(status.Namespace).To(Equal("ns"))
+	g.Expect(status.Mode).To(Equal(serviceApi.NetworkPolicyModeAudit))
+	g.Expect(status.PolicyTypes).To(ConsistOf(networkingv1.PolicyTypeIngress))
+}
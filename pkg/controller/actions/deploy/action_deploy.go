@@ -9,6 +9,7 @@ import (
 
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -32,11 +33,15 @@ const (
 // Action deploys the resources that are included in the ReconciliationRequest using
 // the same create or patch machinery implemented as part of deploy.DeployManifestsFromPath.
 type Action struct {
-	fieldOwner  string
-	deployMode  Mode
-	labels      map[string]string
-	annotations map[string]string
-	cache       *Cache
+	fieldOwner        string
+	deployMode        Mode
+	labels            map[string]string
+	annotations       map[string]string
+	cache             *Cache
+	conflictPolicy    ConflictPolicy
+	conflictPolicies  map[schema.GroupVersionKind]ConflictPolicy
+	legacyFieldOwners []string
+	conflicts         conflictTracker
 }
 
 type ActionOpts func(*Action)
@@ -103,6 +108,36 @@ func WithCache(opts ...CacheOpt) ActionOpts {
 	}
 }
 
+// WithDefaultConflictPolicy sets how conflicts with fields owned by other field managers
+// are handled, it defaults to ConflictPolicyForce.
+func WithDefaultConflictPolicy(value ConflictPolicy) ActionOpts {
+	return func(action *Action) {
+		action.conflictPolicy = value
+	}
+}
+
+// WithConflictPolicy overrides the conflict policy for the given kinds.
+func WithConflictPolicy(value ConflictPolicy, kinds ...schema.GroupVersionKind) ActionOpts {
+	return func(action *Action) {
+		if action.conflictPolicies == nil {
+			action.conflictPolicies = map[schema.GroupVersionKind]ConflictPolicy{}
+		}
+
+		for _, k := range kinds {
+			action.conflictPolicies[k] = value
+		}
+	}
+}
+
+// WithLegacyFieldOwners sets the field managers whose fields are transferred to the field
+// owner of the action, it defaults to the names of the DataScienceCluster and
+// DSCInitialization instances.
+func WithLegacyFieldOwners(values ...string) ActionOpts {
+	return func(action *Action) {
+		action.legacyFieldOwners = values
+	}
+}
+
 func (a *Action) run(ctx context.Context, rr *odhTypes.ReconciliationRequest) error {
 	// cleanup old entries if needed
 	if a.cache != nil {
@@ -117,6 +152,11 @@ func (a *Action) run(ctx context.Context, rr *odhTypes.ReconciliationRequest) er
 	controllerName := strings.ToLower(kind)
 	igvk := rr.Instance.GetObjectKind().GroupVersionKind()
 
+	legacyOwners, err := a.resolveLegacyFieldOwners(ctx, rr.Client)
+	if err != nil {
+		return err
+	}
+
 	for i := range rr.Resources {
 		res := rr.Resources[i]
 		current := resources.GvkToUnstructured(res.GroupVersionKind())
@@ -153,12 +193,14 @@ func (a *Action) run(ctx context.Context, rr *odhTypes.ReconciliationRequest) er
 
 		switch rr.Resources[i].GroupVersionKind() {
 		case gvk.CustomResourceDefinition:
-			ok, err = a.deployCRD(ctx, rr, res, current)
+			ok, err = a.deployCRD(ctx, rr, res, current, legacyOwners)
 		default:
-			ok, err = a.deploy(ctx, rr, res, current)
+			ok, err = a.deploy(ctx, rr, res, current, legacyOwners)
 		}
 
 		if err != nil {
+			// report the conflicts failing the deployment under ConflictPolicyFail
+			a.conflicts.report(rr)
 			return fmt.Errorf("failure deploying resource %s: %w", res, err)
 		}
 
@@ -167,6 +209,8 @@ func (a *Action) run(ctx context.Context, rr *odhTypes.ReconciliationRequest) er
 		}
 	}
 
+	a.conflicts.report(rr)
+
 	return nil
 }
 
@@ -205,6 +249,7 @@ func (a *Action) deployCRD(
 	rr *odhTypes.ReconciliationRequest,
 	obj unstructured.Unstructured,
 	current *unstructured.Unstructured,
+	legacyOwners []string,
 ) (bool, error) {
 	resources.SetLabels(&obj, a.labels)
 	resources.SetAnnotations(&obj, a.annotations)
@@ -221,24 +266,9 @@ func (a *Action) deployCRD(
 	// backup copy for caching
 	origObj := obj.DeepCopy()
 
-	var deployedObj *unstructured.Unstructured
-
-	ops := []client.PatchOption{
-		client.ForceOwnership,
-		// Since CRDs are not bound to a component, set the field
-		// owner to the platform itself
-		client.FieldOwner(resources.PlatformFieldOwner),
-	}
-
-	switch a.deployMode {
-	case ModePatch:
-		deployedObj, err = a.patch(ctx, rr.Client, &obj, current, ops...)
-	case ModeSSA:
-		deployedObj, err = a.apply(ctx, rr.Client, &obj, current, ops...)
-	default:
-		err = fmt.Errorf("unsupported deploy mode %s", a.deployMode)
-	}
-
+	// Since CRDs are not bound to a component, set the field
+	// owner to the platform itself
+	deployedObj, err := a.write(ctx, rr.Client, &obj, current, resources.PlatformFieldOwner, legacyOwners)
 	if err != nil {
 		return false, client.IgnoreNotFound(err)
 	}
@@ -258,6 +288,7 @@ func (a *Action) deploy(
 	rr *odhTypes.ReconciliationRequest,
 	obj unstructured.Unstructured,
 	current *unstructured.Unstructured,
+	legacyOwners []string,
 ) (bool, error) {
 	fo := a.fieldOwner
 	if fo == "" {
@@ -315,20 +346,7 @@ func (a *Action) deploy(
 			}
 		}
 
-		ops := []client.PatchOption{
-			client.ForceOwnership,
-			client.FieldOwner(fo),
-		}
-
-		switch a.deployMode {
-		case ModePatch:
-			deployedObj, err = a.patch(ctx, rr.Client, &obj, current, ops...)
-		case ModeSSA:
-			deployedObj, err = a.apply(ctx, rr.Client, &obj, current, ops...)
-		default:
-			err = fmt.Errorf("unsupported deploy mode %s", a.deployMode)
-		}
-
+		deployedObj, err = a.write(ctx, rr.Client, &obj, current, fo, legacyOwners)
 		if err != nil {
 			return false, err
 		}
@@ -344,6 +362,74 @@ func (a *Action) deploy(
 	return true, nil
 }
 
+// write applies obj using the configured deploy mode. The apply is first attempted without
+// forcing the ownership of the fields, conflicts with other field managers are recorded and
+// then resolved according to the conflict policy of the kind.
+func (a *Action) write(
+	ctx context.Context,
+	cli client.Client,
+	obj *unstructured.Unstructured,
+	current *unstructured.Unstructured,
+	owner string,
+	legacyOwners []string,
+) (*unstructured.Unstructured, error) {
+	if current != nil {
+		if err := a.migrateFieldOwners(ctx, cli, current, owner, legacyOwners); err != nil {
+			return nil, err
+		}
+	}
+
+	deployedObj, err := a.writeWith(ctx, cli, obj, current, client.FieldOwner(owner))
+
+	conflicts := conflictsFromError(err)
+	a.conflicts.set(obj, conflicts)
+
+	if len(conflicts) == 0 {
+		return deployedObj, err
+	}
+
+	policy := a.policyFor(obj.GroupVersionKind())
+
+	logf.FromContext(ctx).V(1).Info("field ownership conflict",
+		"gvk", obj.GroupVersionKind(),
+		"name", client.ObjectKeyFromObject(obj),
+		"conflicts", conflicts,
+		"policy", policy,
+	)
+
+	switch policy {
+	case ConflictPolicyForce:
+		return a.writeWith(ctx, cli, obj, current, client.ForceOwnership, client.FieldOwner(owner))
+	case ConflictPolicyYield:
+		if err := yieldFields(obj, conflicts); err != nil {
+			return nil, err
+		}
+
+		return a.writeWith(ctx, cli, obj, current, client.FieldOwner(owner))
+	case ConflictPolicyFail:
+		return nil, err
+	default:
+		return nil, fmt.Errorf("unsupported conflict policy %s", policy)
+	}
+}
+
+func (a *Action) writeWith(
+	ctx context.Context,
+	cli client.Client,
+	obj *unstructured.Unstructured,
+	current *unstructured.Unstructured,
+	opts ...client.PatchOption,
+) (*unstructured.Unstructured, error) {
+	switch a.deployMode {
+	case ModePatch:
+		return a.patch(ctx, cli, obj, current, opts...)
+	case ModeSSA:
+		return a.apply(ctx, cli, obj, current, opts...)
+	default:
+		return nil, fmt.Errorf("unsupported deploy mode %s", a.deployMode)
+	}
+}
+
 func (a *Action) create(
 	ctx context.Context,
 	cli client.Client,
@@ -492,7 +578,8 @@ func (a *Action) apply(
 
 func NewAction(opts ...ActionOpts) actions.Fn {
 	action := Action{
-		deployMode: ModeSSA,
+		deployMode:     ModeSSA,
+		conflictPolicy: ConflictPolicyForce,
 	}
 
 	for _, opt := range opts {
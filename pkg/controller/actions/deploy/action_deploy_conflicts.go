@@ -0,0 +1,370 @@
+package deploy
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+	odhTypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+// ConflictPolicy defines how the action reacts when a server-side apply request conflicts
+// with fields owned by other field managers.
+type ConflictPolicy string
+
+const (
+	// ConflictPolicyForce takes the ownership of the conflicting fields.
+	ConflictPolicyForce ConflictPolicy = "force"
+	// ConflictPolicyYield leaves the conflicting fields to their current owners and applies
+	// the rest of the object.
+	ConflictPolicyYield ConflictPolicy = "yield"
+	// ConflictPolicyFail fails the reconciliation.
+	ConflictPolicyFail ConflictPolicy = "fail"
+)
+
+// Conflict is a field of an object owned by a field manager other than the operator.
+type Conflict struct {
+	Manager string
+	Field   string
+}
+
+// conflictManagerRe extracts the name of the field manager from the message of the
+// causes of an apply conflict, e.g. conflict with "kubectl-edit" using apps/v1.
+var conflictManagerRe = regexp.MustCompile(`^conflict with ("(?:[^"\\]|\\.)*")`)
+
+// conflictsFromError returns the fields reported in a server-side apply conflict error, or
+// nil if err is not an apply conflict.
+func conflictsFromError(err error) []Conflict {
+	if !k8serr.IsConflict(err) {
+		return nil
+	}
+
+	var apiStatus k8serr.APIStatus
+	if !errors.As(err, &apiStatus) || apiStatus.Status().Details == nil {
+		return nil
+	}
+
+	var conflicts []Conflict
+
+	for _, cause := range apiStatus.Status().Details.Causes {
+		if cause.Type != metav1.CauseTypeFieldManagerConflict {
+			continue
+		}
+
+		c := Conflict{Field: cause.Field}
+
+		if m := conflictManagerRe.FindStringSubmatch(cause.Message); m != nil {
+			if name, err := strconv.Unquote(m[1]); err == nil {
+				c.Manager = name
+			}
+		}
+
+		conflicts = append(conflicts, c)
+	}
+
+	return conflicts
+}
+
+// conflictTracker keeps the conflicts detected for each object, so they are still reported
+// when the object is not applied again because of the deploy cache.
+type conflictTracker struct {
+	mu        sync.Mutex
+	conflicts map[string][]Conflict
+}
+
+func (t *conflictTracker) set(obj *unstructured.Unstructured, conflicts []Conflict) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key := resources.FormatObjectReference(obj)
+
+	if len(conflicts) == 0 {
+		delete(t.conflicts, key)
+		return
+	}
+
+	if t.conflicts == nil {
+		t.conflicts = map[string][]Conflict{}
+	}
+
+	t.conflicts[key] = conflicts
+}
+
+// report raises the FieldOwnershipConflict condition if any of the resources of the request
+// has fields owned by foreign field managers, and clears it once the conflicts are resolved.
+// The conflicts of the objects no longer part of the request are dropped.
+func (t *conflictTracker) report(rr *odhTypes.ReconciliationRequest) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	keys := sets.New[string]()
+	for i := range rr.Resources {
+		keys.Insert(resources.FormatObjectReference(&rr.Resources[i]))
+	}
+
+	maps.DeleteFunc(t.conflicts, func(key string, _ []Conflict) bool {
+		return !keys.Has(key)
+	})
+
+	if rr.Conditions == nil {
+		return
+	}
+
+	objects := 0
+	managers := make([]string, 0)
+
+	for i := range rr.Resources {
+		conflicts, ok := t.conflicts[resources.FormatObjectReference(&rr.Resources[i])]
+		if !ok {
+			continue
+		}
+
+		objects++
+
+		for _, c := range conflicts {
+			if !slices.Contains(managers, c.Manager) {
+				managers = append(managers, c.Manager)
+			}
+		}
+	}
+
+	if objects == 0 {
+		_ = rr.Conditions.ClearCondition(status.ConditionFieldOwnershipConflict)
+		return
+	}
+
+	slices.Sort(managers)
+
+	rr.Conditions.MarkTrue(
+		status.ConditionFieldOwnershipConflict,
+		conditions.WithSeverity(common.ConditionSeverityInfo),
+		conditions.WithReason(status.FieldManagerConflictReason),
+		conditions.WithMessage("%d resources have fields owned by other field managers: %s", objects, strings.Join(managers, ", ")),
+	)
+}
+
+// policyFor returns the conflict policy configured for the given kind.
+func (a *Action) policyFor(kind schema.GroupVersionKind) ConflictPolicy {
+	if p, ok := a.conflictPolicies[kind]; ok {
+		return p
+	}
+
+	return a.conflictPolicy
+}
+
+// yieldFields removes the conflicting fields from obj, returning an error if any of them
+// cannot be located.
+func yieldFields(obj *unstructured.Unstructured, conflicts []Conflict) error {
+	for _, c := range conflicts {
+		if _, ok := removeField(obj.Object, c.Field); !ok {
+			return fmt.Errorf("unable to yield field %s to %q", c.Field, c.Manager)
+		}
+	}
+
+	return nil
+}
+
+// removeField removes the field identified by path from node and returns the updated node.
+// The path is expressed in the format used by the API server to report apply conflicts,
+// e.g. .spec.template.spec.containers[name="manager"].image. As field names may contain
+// dots (i.e. labels and annotations), they are matched against the keys of the object.
+func removeField(node any, path string) (any, bool) {
+	switch {
+	case strings.HasPrefix(path, "."):
+		m, ok := node.(map[string]any)
+		if !ok {
+			return node, false
+		}
+
+		key, rest, ok := matchField(m, path[1:])
+		if !ok {
+			return node, false
+		}
+
+		if rest == "" {
+			delete(m, key)
+			return m, true
+		}
+
+		v, ok := removeField(m[key], rest)
+		if ok {
+			m[key] = v
+		}
+
+		return m, ok
+
+	case strings.HasPrefix(path, "["):
+		l, ok := node.([]any)
+		if !ok {
+			return node, false
+		}
+
+		end := closingBracket(path)
+		if end < 0 {
+			return node, false
+		}
+
+		idx := matchElement(l, path[1:end])
+		if idx < 0 {
+			return node, false
+		}
+
+		rest := path[end+1:]
+		if rest == "" {
+			return slices.Delete(l, idx, idx+1), true
+		}
+
+		v, ok := removeField(l[idx], rest)
+		if ok {
+			l[idx] = v
+		}
+
+		return l, ok
+
+	default:
+		return node, false
+	}
+}
+
+// matchField returns the longest key of m the path starts with and the remaining path.
+func matchField(m map[string]any, path string) (string, string, bool) {
+	key := ""
+	found := false
+
+	for k := range m {
+		if !strings.HasPrefix(path, k) || (found && len(k) <= len(key)) {
+			continue
+		}
+
+		if rest := path[len(k):]; rest == "" || rest[0] == '.' || rest[0] == '[' {
+			key = k
+			found = true
+		}
+	}
+
+	if !found {
+		return "", "", false
+	}
+
+	return key, path[len(key):], true
+}
+
+// closingBracket returns the index of the bracket closing the selector path starts with,
+// skipping brackets in quoted values.
+func closingBracket(path string) int {
+	quoted := false
+
+	for i := 1; i < len(path); i++ {
+		switch {
+		case quoted && path[i] == '\\':
+			i++
+		case path[i] == '"':
+			quoted = !quoted
+		case !quoted && path[i] == ']':
+			return i
+		}
+	}
+
+	return -1
+}
+
+// matchElement returns the index of the element of l matching the selector, which can be an
+// index ([0]), a value of a set ([="foo"]) or the keys of an associative list
+// ([containerPort=8080,protocol="TCP"]).
+func matchElement(l []any, selector string) int {
+	if value, ok := strings.CutPrefix(selector, "="); ok {
+		return slices.IndexFunc(l, func(e any) bool {
+			return scalar(e) == normalize(value)
+		})
+	}
+
+	if idx, err := strconv.Atoi(selector); err == nil {
+		if idx < 0 || idx >= len(l) {
+			return -1
+		}
+
+		return idx
+	}
+
+	keys := map[string]string{}
+
+	for _, part := range splitKeys(selector) {
+		name, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return -1
+		}
+
+		keys[name] = normalize(value)
+	}
+
+	return slices.IndexFunc(l, func(e any) bool {
+		m, ok := e.(map[string]any)
+		if !ok {
+			return false
+		}
+
+		for name, value := range keys {
+			if scalar(m[name]) != value {
+				return false
+			}
+		}
+
+		return true
+	})
+}
+
+// splitKeys splits the keys of an associative list selector, ignoring commas in quoted values.
+func splitKeys(selector string) []string {
+	var parts []string
+
+	quoted := false
+	start := 0
+
+	for i := 0; i < len(selector); i++ {
+		switch {
+		case quoted && selector[i] == '\\':
+			i++
+		case selector[i] == '"':
+			quoted = !quoted
+		case !quoted && selector[i] == ',':
+			parts = append(parts, selector[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(parts, selector[start:])
+}
+
+func scalar(v any) string {
+	switch t := v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return strconv.Quote(t)
+	default:
+		return fmt.Sprint(t)
+	}
+}
+
+func normalize(value string) string {
+	if s, err := strconv.Unquote(value); err == nil {
+		return strconv.Quote(s)
+	}
+
+	return value
+}
@@ -0,0 +1,61 @@
+package deploy
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+	odhTypes "github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestConflictTrackerReportPrunesRemovedResources(t *testing.T) {
+	g := NewWithT(t)
+
+	configMap := func(name string) unstructured.Unstructured {
+		u := resources.GvkToUnstructured(gvk.ConfigMap)
+		u.SetNamespace("ns")
+		u.SetName(name)
+
+		return *u
+	}
+
+	kept := configMap("kept")
+	removed := configMap("removed")
+
+	tracker := conflictTracker{}
+	tracker.set(&kept, []Conflict{{Manager: "kubectl-edit", Field: ".data.foo"}})
+	tracker.set(&removed, []Conflict{{Manager: "kubectl-patch", Field: ".data.bar"}})
+
+	instance := &componentApi.Dashboard{ObjectMeta: metav1.ObjectMeta{Name: componentApi.DashboardInstanceName}}
+	rr := odhTypes.ReconciliationRequest{
+		Instance:   instance,
+		Conditions: conditions.NewManager(instance, status.ConditionTypeReady),
+		Resources:  []unstructured.Unstructured{kept},
+	}
+
+	tracker.report(&rr)
+
+	g.Expect(tracker.conflicts).Should(HaveLen(1))
+	g.Expect(tracker.conflicts).Should(HaveKey(resources.FormatObjectReference(&kept)))
+
+	c := rr.Conditions.GetCondition(status.ConditionFieldOwnershipConflict)
+	g.Expect(c).ShouldNot(BeNil())
+	g.Expect(c.Status).Should(Equal(metav1.ConditionTrue))
+	g.Expect(c.Message).Should(Equal("1 resources have fields owned by other field managers: kubectl-edit"))
+
+	// the tracked conflicts are dropped even without conditions to report
+	rr.Conditions = nil
+	rr.Resources = []unstructured.Unstructured{configMap("other")}
+
+	tracker.report(&rr)
+
+	g.Expect(tracker.conflicts).Should(BeEmpty())
+}
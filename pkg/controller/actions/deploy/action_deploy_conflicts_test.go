@@ -0,0 +1,358 @@
+package deploy_test
+
+import (
+	"context"
+	"testing"
+
+	gomegaTypes "github.com/onsi/gomega/types"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/datasciencecluster/v2"
+	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/conditions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/mocks"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	conflictingLabel = `.metadata.labels.app.kubernetes.io/name`
+	conflictingPort  = `.spec.ports[port=8080,protocol="TCP"].targetPort`
+)
+
+func conflictingService(ns string, name string) *corev1.Service {
+	return &corev1.Service{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "Service",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: ns,
+			Labels: map[string]string{
+				"app.kubernetes.io/name": "dashboard",
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{Name: "http", Port: 8080, Protocol: corev1.ProtocolTCP, TargetPort: intstr.FromInt32(8080)},
+				{Name: "https", Port: 8443, Protocol: corev1.ProtocolTCP, TargetPort: intstr.FromInt32(8443)},
+			},
+		},
+	}
+}
+
+// applyConflicts simulates the API server rejecting non forced apply requests for objects
+// still setting the conflicting fields, and records the successful ones.
+func applyConflicts(applied *[]*unstructured.Unstructured) interceptor.Funcs {
+	return interceptor.Funcs{
+		Patch: func(ctx context.Context, cl client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			if patch.Type() != client.Apply.Type() {
+				return cl.Patch(ctx, obj, patch, opts...)
+			}
+
+			po := client.PatchOptions{}
+			po.ApplyOptions(opts)
+
+			u, ok := obj.(*unstructured.Unstructured)
+			if !ok {
+				return cl.Patch(ctx, obj, patch, opts...)
+			}
+
+			_, hasLabel := u.GetLabels()["app.kubernetes.io/name"]
+			hasTargetPort := false
+			if ports, _, _ := unstructured.NestedSlice(u.Object, "spec", "ports"); len(ports) != 0 {
+				_, hasTargetPort = ports[0].(map[string]any)["targetPort"]
+			}
+
+			if (hasLabel || hasTargetPort) && (po.Force == nil || !*po.Force) {
+				return k8serr.NewApplyConflict(
+					[]metav1.StatusCause{
+						{Type: metav1.CauseTypeFieldManagerConflict, Message: `conflict with "kubectl-edit" using v1`, Field: conflictingLabel},
+						{Type: metav1.CauseTypeFieldManagerConflict, Message: `conflict with "kubectl-patch" using v1`, Field: conflictingPort},
+					},
+					"Apply failed with 2 conflicts",
+				)
+			}
+
+			*applied = append(*applied, u.DeepCopy())
+
+			// the object is not actually patched, return the stored version
+			// as the API server would do
+			stored := u.DeepCopy()
+			if err := cl.Get(ctx, client.ObjectKeyFromObject(u), stored); err != nil {
+				return err
+			}
+
+			u.SetResourceVersion(stored.GetResourceVersion())
+
+			return nil
+		},
+	}
+}
+
+func conflictsRequest(cl client.Client, resources ...unstructured.Unstructured) (*types.ReconciliationRequest, *componentApi.Dashboard) {
+	instance := &componentApi.Dashboard{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       componentApi.DashboardInstanceName,
+			Generation: 1,
+		},
+	}
+
+	return &types.ReconciliationRequest{
+		Client:     cl,
+		Instance:   instance,
+		Conditions: conditions.NewManager(instance, status.ConditionTypeReady),
+		Release:    common.Release{Name: cluster.OpenDataHub},
+		Resources:  resources,
+		Controller: mocks.NewMockController(func(m *mocks.MockController) {
+			m.On("Owns", mock.Anything).Return(false)
+		}),
+	}, instance
+}
+
+func TestDeployConflictPolicies(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []deploy.ActionOpts
+		err     string
+		matcher []gomegaTypes.GomegaMatcher
+	}{
+		{
+			name: "force",
+			matcher: []gomegaTypes.GomegaMatcher{
+				jq.Match(`.metadata.labels."app.kubernetes.io/name" == "dashboard"`),
+				jq.Match(`.spec.ports[0].targetPort == 8080`),
+			},
+		},
+		{
+			name: "yield",
+			opts: []deploy.ActionOpts{deploy.WithConflictPolicy(deploy.ConflictPolicyYield, gvk.Service)},
+			matcher: []gomegaTypes.GomegaMatcher{
+				jq.Match(`.metadata.labels | has("app.kubernetes.io/name") | not`),
+				jq.Match(`.spec.ports[0] | has("targetPort") | not`),
+				jq.Match(`.spec.ports[1].targetPort == 8443`),
+			},
+		},
+		{
+			name: "fail",
+			opts: []deploy.ActionOpts{deploy.WithDefaultConflictPolicy(deploy.ConflictPolicyFail)},
+			err:  "Apply failed with 2 conflicts",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			ctx := t.Context()
+			svc := conflictingService(xid.New().String(), xid.New().String())
+
+			applied := make([]*unstructured.Unstructured, 0)
+
+			cl, err := fakeclient.New(
+				fakeclient.WithObjects(svc.DeepCopy()),
+				fakeclient.WithInterceptorFuncs(applyConflicts(&applied)),
+			)
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			obj, err := resources.ToUnstructured(svc)
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			rr, instance := conflictsRequest(cl, *obj)
+
+			err = deploy.NewAction(tt.opts...)(ctx, rr)
+			if tt.err != "" {
+				g.Expect(err).Should(MatchError(ContainSubstring(tt.err)))
+				g.Expect(applied).Should(BeEmpty())
+				g.Expect(instance).Should(WithTransform(resources.ToUnstructured, And(
+					jq.Match(`.status.conditions[] | select(.type == "%s") | .status == "True"`, status.ConditionFieldOwnershipConflict),
+					jq.Match(`.status.conditions[] | select(.type == "%s") | .message | contains("kubectl-edit, kubectl-patch")`, status.ConditionFieldOwnershipConflict),
+				)))
+				return
+			}
+
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(applied).Should(And(HaveLen(1), HaveEach(And(tt.matcher...))))
+
+			g.Expect(instance).Should(WithTransform(resources.ToUnstructured, And(
+				jq.Match(`.status.conditions[] | select(.type == "%s") | .status == "True"`, status.ConditionFieldOwnershipConflict),
+				jq.Match(`.status.conditions[] | select(.type == "%s") | .severity == "%s"`, status.ConditionFieldOwnershipConflict, common.ConditionSeverityInfo),
+				jq.Match(`.status.conditions[] | select(.type == "%s") | .message | contains("kubectl-edit, kubectl-patch")`, status.ConditionFieldOwnershipConflict),
+			)))
+		})
+	}
+}
+
+func TestDeployConflictsCached(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx := t.Context()
+	svc := conflictingService(xid.New().String(), xid.New().String())
+
+	applied := make([]*unstructured.Unstructured, 0)
+
+	cl, err := fakeclient.New(
+		fakeclient.WithObjects(svc.DeepCopy()),
+		fakeclient.WithInterceptorFuncs(applyConflicts(&applied)),
+	)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	obj, err := resources.ToUnstructured(svc)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	action := deploy.NewAction(
+		deploy.WithCache(),
+		deploy.WithConflictPolicy(deploy.ConflictPolicyYield, gvk.Service),
+	)
+
+	for range 2 {
+		rr, instance := conflictsRequest(cl, *obj.DeepCopy())
+
+		err = action(ctx, rr)
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		g.Expect(instance).Should(WithTransform(resources.ToUnstructured,
+			jq.Match(`.status.conditions[] | select(.type == "%s") | .status == "True"`, status.ConditionFieldOwnershipConflict),
+		))
+	}
+
+	g.Expect(applied).Should(HaveLen(1))
+}
+
+func TestDeployConflictsCleared(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx := t.Context()
+	svc := conflictingService(xid.New().String(), xid.New().String())
+
+	applied := make([]*unstructured.Unstructured, 0)
+
+	cl, err := fakeclient.New(
+		fakeclient.WithObjects(svc.DeepCopy()),
+		fakeclient.WithInterceptorFuncs(applyConflicts(&applied)),
+	)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	obj, err := resources.ToUnstructured(svc)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	action := deploy.NewAction(deploy.WithConflictPolicy(deploy.ConflictPolicyYield, gvk.Service))
+
+	rr, instance := conflictsRequest(cl, *obj.DeepCopy())
+	g.Expect(action(ctx, rr)).Should(Succeed())
+	g.Expect(instance).Should(WithTransform(resources.ToUnstructured,
+		jq.Match(`.status.conditions[] | select(.type == "%s") | .status == "True"`, status.ConditionFieldOwnershipConflict),
+	))
+
+	// the conflicting fields are no longer set by the operator
+	obj.SetLabels(nil)
+	ports, _, _ := unstructured.NestedSlice(obj.Object, "spec", "ports")
+	delete(ports[0].(map[string]any), "targetPort")
+	g.Expect(unstructured.SetNestedSlice(obj.Object, ports, "spec", "ports")).Should(Succeed())
+
+	rr.Resources = []unstructured.Unstructured{*obj}
+	g.Expect(action(ctx, rr)).Should(Succeed())
+	g.Expect(instance).Should(WithTransform(resources.ToUnstructured,
+		jq.Match(`[.status.conditions[] | select(.type == "%s")] | length == 0`, status.ConditionFieldOwnershipConflict),
+	))
+}
+
+func TestDeployMigrateLegacyFieldOwners(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx := t.Context()
+
+	cm := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      xid.New().String(),
+			Namespace: xid.New().String(),
+		},
+		Data: map[string]string{
+			"foo": "bar",
+		},
+	}
+
+	current := cm.DeepCopy()
+	current.ManagedFields = []metav1.ManagedFieldsEntry{
+		{
+			Manager:    "my-dsc",
+			Operation:  metav1.ManagedFieldsOperationApply,
+			APIVersion: "v1",
+			FieldsType: "FieldsV1",
+			FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:data":{"f:foo":{}}}`)},
+		},
+		{
+			Manager:    "dashboard",
+			Operation:  metav1.ManagedFieldsOperationApply,
+			APIVersion: "v1",
+			FieldsType: "FieldsV1",
+			FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:labels":{"f:app":{}}}}`)},
+		},
+		{
+			Manager:    "my-dsc",
+			Operation:  metav1.ManagedFieldsOperationUpdate,
+			APIVersion: "v1",
+			FieldsType: "FieldsV1",
+			FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:data":{"f:baz":{}}}`)},
+		},
+		{
+			Manager:    "kubectl-edit",
+			Operation:  metav1.ManagedFieldsOperationUpdate,
+			APIVersion: "v1",
+			FieldsType: "FieldsV1",
+			FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:data":{"f:other":{}}}`)},
+		},
+	}
+
+	applied := make([]*unstructured.Unstructured, 0)
+
+	cl, err := fakeclient.New(
+		fakeclient.WithObjects(current, &dscv2.DataScienceCluster{ObjectMeta: metav1.ObjectMeta{Name: "my-dsc"}}),
+		fakeclient.WithInterceptorFuncs(applyConflicts(&applied)),
+	)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	obj, err := resources.ToUnstructured(cm)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	rr, _ := conflictsRequest(cl, *obj)
+
+	err = deploy.NewAction(deploy.WithMode(deploy.ModePatch))(ctx, rr)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	err = cl.Get(ctx, client.ObjectKeyFromObject(cm), cm)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	g.Expect(cm.ManagedFields).Should(And(
+		HaveLen(2),
+		ContainElement(And(
+			HaveField("Manager", "dashboard"),
+			HaveField("Operation", metav1.ManagedFieldsOperationApply),
+			HaveField("FieldsV1.Raw", MatchJSON(`{"f:data":{"f:baz":{},"f:foo":{}},"f:metadata":{"f:labels":{"f:app":{}}}}`)),
+		)),
+		ContainElement(HaveField("Manager", "kubectl-edit")),
+		Not(ContainElement(HaveField("Manager", "my-dsc"))),
+	))
+}
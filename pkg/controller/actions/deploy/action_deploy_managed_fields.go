@@ -0,0 +1,174 @@
+package deploy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/csaupgrade"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+)
+
+// resolveLegacyFieldOwners returns the field managers whose fields are transferred to the
+// field owner of the action. Unless set with WithLegacyFieldOwners, they are the names of
+// the DataScienceCluster and DSCInitialization instances, used as field owner by
+// deploy.DeployManifestsFromPath.
+func (a *Action) resolveLegacyFieldOwners(ctx context.Context, cli client.Client) ([]string, error) {
+	if a.legacyFieldOwners != nil {
+		return a.legacyFieldOwners, nil
+	}
+
+	var owners []string
+
+	dsc, err := cluster.GetDSC(ctx, cli)
+	switch {
+	case k8serr.IsNotFound(err):
+	case err != nil:
+		return nil, fmt.Errorf("failed to get the legacy field owners: %w", err)
+	default:
+		owners = append(owners, dsc.GetName())
+	}
+
+	dsci, err := cluster.GetDSCI(ctx, cli)
+	switch {
+	case k8serr.IsNotFound(err):
+	case err != nil:
+		return nil, fmt.Errorf("failed to get the legacy field owners: %w", err)
+	default:
+		owners = append(owners, dsci.GetName())
+	}
+
+	return owners, nil
+}
+
+// migrateFieldOwners transfers the fields owned by the legacy field managers to owner, so
+// that fields removed from the manifests get pruned by the next apply and the legacy
+// managers are not reported as conflicting.
+func (a *Action) migrateFieldOwners(
+	ctx context.Context,
+	cli client.Client,
+	current *unstructured.Unstructured,
+	owner string,
+	legacyOwners []string,
+) error {
+	if len(legacyOwners) == 0 || slices.Contains(legacyOwners, owner) {
+		return nil
+	}
+
+	managedFields := current.GetManagedFields()
+
+	migrated, err := migrateManagedFields(managedFields, legacyOwners, owner)
+	if err != nil {
+		return fmt.Errorf("failed to migrate managed fields of %s: %w", current.GetName(), err)
+	}
+
+	if reflect.DeepEqual(managedFields, migrated) {
+		return nil
+	}
+
+	// Include the resource version to make the patch fail if the managed
+	// fields have been changed in the meantime.
+	data, err := json.Marshal([]map[string]any{
+		{"op": "replace", "path": "/metadata/managedFields", "value": migrated},
+		{"op": "replace", "path": "/metadata/resourceVersion", "value": current.GetResourceVersion()},
+	})
+	if err != nil {
+		return err
+	}
+
+	logf.FromContext(ctx).V(3).Info("migrate field owners",
+		"gvk", current.GroupVersionKind(),
+		"name", client.ObjectKeyFromObject(current),
+		"owner", owner,
+	)
+
+	if err := cli.Patch(ctx, current, client.RawPatch(types.JSONPatchType, data)); err != nil {
+		return fmt.Errorf("failed to migrate field owners of %s/%s: %w", current.GetNamespace(), current.GetName(), err)
+	}
+
+	return nil
+}
+
+// migrateManagedFields merges the Apply entries of the legacy managers into the Apply entry
+// of owner, then converts their Update entries as csaupgrade does for client-side apply.
+func migrateManagedFields(
+	entries []metav1.ManagedFieldsEntry,
+	legacy []string,
+	owner string,
+) ([]metav1.ManagedFieldsEntry, error) {
+	if !slices.ContainsFunc(entries, func(e metav1.ManagedFieldsEntry) bool { return slices.Contains(legacy, e.Manager) }) {
+		return entries, nil
+	}
+
+	result := make([]metav1.ManagedFieldsEntry, 0, len(entries))
+	target := -1
+
+	for _, e := range entries {
+		isApply := e.Operation == metav1.ManagedFieldsOperationApply && e.Subresource == ""
+
+		switch {
+		case isApply && e.Manager == owner && target < 0:
+			target = len(result)
+			result = append(result, *e.DeepCopy())
+		case isApply && slices.Contains(legacy, e.Manager) && target < 0:
+			target = len(result)
+			e = *e.DeepCopy()
+			e.Manager = owner
+			result = append(result, e)
+		case isApply && (e.Manager == owner || slices.Contains(legacy, e.Manager)):
+			if err := unionFields(&result[target], e); err != nil {
+				return nil, err
+			}
+		default:
+			result = append(result, *e.DeepCopy())
+		}
+	}
+
+	u := unstructured.Unstructured{}
+	u.SetManagedFields(result)
+
+	if err := csaupgrade.UpgradeManagedFields(&u, sets.New(legacy...), owner); err != nil {
+		return nil, err
+	}
+
+	return u.GetManagedFields(), nil
+}
+
+func unionFields(target *metav1.ManagedFieldsEntry, in metav1.ManagedFieldsEntry) error {
+	if in.FieldsV1 == nil {
+		return nil
+	}
+
+	src := fieldpath.Set{}
+	if err := src.FromJSON(bytes.NewReader(in.FieldsV1.Raw)); err != nil {
+		return err
+	}
+
+	dst := fieldpath.Set{}
+	if target.FieldsV1 != nil {
+		if err := dst.FromJSON(bytes.NewReader(target.FieldsV1.Raw)); err != nil {
+			return err
+		}
+	}
+
+	data, err := dst.Union(&src).ToJSON()
+	if err != nil {
+		return err
+	}
+
+	target.FieldsV1 = &metav1.FieldsV1{Raw: data}
+
+	return nil
+}
@@ -0,0 +1,222 @@
+package overlays
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"slices"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+)
+
+// Action evaluates the selectors of the manifests of the request and drops the ones that do
+// not match, so a component declares all its overlays in its initialize action and lets this
+// action pick the ones applying to the platform, the cluster and the spec of the resource.
+// It must run before the render actions. The selected overlays are reported in the status of
+// the resource.
+type Action struct {
+	mu       sync.Mutex
+	env      *cel.Env
+	programs map[string]cel.Program
+}
+
+type ActionOpts func(*Action)
+
+// specVariable is the variable holding the spec of the resource in the selector expressions.
+const specVariable = "spec"
+
+// env holds the facts shared by the selectors of a request, computed on first use.
+type env struct {
+	spec       any
+	singleNode *bool
+}
+
+func (a *Action) run(ctx context.Context, rr *types.ReconciliationRequest) error {
+	e := env{}
+
+	manifests := make([]types.ManifestInfo, 0, len(rr.Manifests))
+	applied := make([]string, 0, len(rr.Manifests))
+
+	for _, mi := range rr.Manifests {
+		ok, err := a.matches(ctx, rr, mi.Selector, &e)
+		if err != nil {
+			return fmt.Errorf("failed to evaluate the selector of overlay %s: %w", mi, err)
+		}
+		if !ok {
+			continue
+		}
+
+		manifests = append(manifests, mi)
+		applied = append(applied, name(mi))
+	}
+
+	sources := make([]types.ManifestSource, 0, len(rr.Sources))
+
+	for _, s := range rr.Sources {
+		ks, isKustomize := s.(types.KustomizeSource)
+		if !isKustomize {
+			sources = append(sources, s)
+			continue
+		}
+
+		ok, err := a.matches(ctx, rr, ks.Selector, &e)
+		if err != nil {
+			return fmt.Errorf("failed to evaluate the selector of overlay %s: %w", s.URI(), err)
+		}
+		if !ok {
+			continue
+		}
+
+		sources = append(sources, s)
+		applied = append(applied, name(ks.ManifestInfo))
+	}
+
+	rr.Manifests = manifests
+	rr.Sources = sources
+
+	if len(applied) == 0 {
+		applied = nil
+	}
+
+	rr.Instance.GetStatus().AppliedOverlays = applied
+
+	return nil
+}
+
+func (a *Action) matches(ctx context.Context, rr *types.ReconciliationRequest, s *types.OverlaySelector, e *env) (bool, error) {
+	if s == nil {
+		return true, nil
+	}
+
+	if len(s.Platforms) != 0 && !slices.Contains(s.Platforms, rr.Release.Name) {
+		return false, nil
+	}
+
+	for _, crd := range s.CRDs {
+		ok, err := cluster.HasCRD(ctx, rr.Client, crd)
+		if err != nil {
+			return false, fmt.Errorf("failed to check CRD %s: %w", crd, err)
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+
+	if s.FIPS != nil && *s.FIPS != cluster.GetClusterInfo().FipsEnabled {
+		return false, nil
+	}
+
+	if s.SingleNode != nil {
+		if e.singleNode == nil {
+			singleNode := cluster.IsSingleNodeCluster(ctx, rr.Client)
+			e.singleNode = &singleNode
+		}
+
+		if *s.SingleNode != *e.singleNode {
+			return false, nil
+		}
+	}
+
+	if s.Expression != "" {
+		return a.evaluate(ctx, rr, s.Expression, e)
+	}
+
+	return true, nil
+}
+
+// evaluate runs the CEL expression against the spec of the resource, converted to plain JSON
+// values and bound to the spec variable.
+func (a *Action) evaluate(ctx context.Context, rr *types.ReconciliationRequest, expression string, e *env) (bool, error) {
+	prg, err := a.compile(expression)
+	if err != nil {
+		return false, err
+	}
+
+	if e.spec == nil {
+		data, err := json.Marshal(rr.Instance)
+		if err != nil {
+			return false, fmt.Errorf("failed to encode %s: %w", rr.Instance.GetName(), err)
+		}
+
+		obj := map[string]any{}
+		if err := json.Unmarshal(data, &obj); err != nil {
+			return false, fmt.Errorf("failed to decode %s: %w", rr.Instance.GetName(), err)
+		}
+
+		e.spec = obj["spec"]
+		if e.spec == nil {
+			e.spec = map[string]any{}
+		}
+	}
+
+	v, _, err := prg.ContextEval(ctx, map[string]any{specVariable: e.spec})
+	if err != nil {
+		return false, fmt.Errorf("expression %q failed: %w", expression, err)
+	}
+
+	result, isBool := v.Value().(bool)
+	if !isBool {
+		return false, fmt.Errorf("expression %q returned %v, expected a boolean", expression, v.Value())
+	}
+
+	return result, nil
+}
+
+func (a *Action) compile(expression string) (cel.Program, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if prg, ok := a.programs[expression]; ok {
+		return prg, nil
+	}
+
+	ast, issues := a.env.Compile(expression)
+	if issues.Err() != nil {
+		return nil, fmt.Errorf("invalid expression %q: %w", expression, issues.Err())
+	}
+
+	if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return nil, fmt.Errorf("invalid expression %q: returns %s, expected a boolean", expression, ast.OutputType())
+	}
+
+	prg, err := a.env.Program(ast)
+	if err != nil {
+		return nil, fmt.Errorf("invalid expression %q: %w", expression, err)
+	}
+
+	a.programs[expression] = prg
+
+	return prg, nil
+}
+
+// name returns the overlay path relative to the manifests directory of the operator.
+func name(mi types.ManifestInfo) string {
+	if mi.Path == deploy.DefaultManifestPath {
+		return path.Join(mi.ContextDir, mi.SourcePath)
+	}
+
+	return mi.String()
+}
+
+func NewAction(opts ...ActionOpts) actions.Fn {
+	// the environment only declares a variable of dynamic type, it cannot fail
+	env, _ := cel.NewEnv(cel.Variable(specVariable, cel.DynType))
+
+	action := Action{
+		env:      env,
+		programs: map[string]cel.Program{},
+	}
+
+	for _, opt := range opts {
+		opt(&action)
+	}
+
+	return action.run
+}
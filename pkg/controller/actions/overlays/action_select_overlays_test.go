@@ -0,0 +1,137 @@
+package overlays_test
+
+import (
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/overlays"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+
+	. "github.com/onsi/gomega"
+)
+
+func overlay(name string) types.ManifestInfo {
+	return types.ManifestInfo{Path: "/manifests", ContextDir: "dashboard", SourcePath: name}
+}
+
+func TestSelectOverlaysAction(t *testing.T) {
+	g := NewWithT(t)
+
+	cl, err := fakeclient.New(fakeclient.WithObjects(
+		&apiextensionsv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: "dashboards.components.platform.opendatahub.io"},
+			Status: apiextensionsv1.CustomResourceDefinitionStatus{
+				StoredVersions: []string{gvk.Dashboard.Version},
+			},
+		},
+		&configv1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+			Status: configv1.InfrastructureStatus{
+				ControlPlaneTopology: configv1.SingleReplicaTopologyMode,
+			},
+		},
+	))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	dashboard := componentApi.Dashboard{
+		Spec: componentApi.DashboardSpec{
+			DashboardCommonSpec: componentApi.DashboardCommonSpec{Gateway: "custom"},
+		},
+	}
+
+	rr := types.ReconciliationRequest{
+		Client:   cl,
+		Instance: &dashboard,
+		Release:  common.Release{Name: cluster.SelfManagedRhoai},
+		Manifests: append(
+			types.PlatformOverlays(func(p common.Platform) types.ManifestInfo {
+				return overlay("overlays/" + string(p))
+			}, cluster.Platforms...),
+			overlay("base"),
+			overlay("crd").WithSelector(types.OverlaySelector{CRDs: []schema.GroupVersionKind{gvk.Dashboard}}),
+			overlay("missing-crd").WithSelector(types.OverlaySelector{CRDs: []schema.GroupVersionKind{gvk.Kueue}}),
+			overlay("fips").WithSelector(types.OverlaySelector{FIPS: ptr.To(true)}),
+			overlay("sno").WithSelector(types.OverlaySelector{SingleNode: ptr.To(true)}),
+			overlay("multi-node").WithSelector(types.OverlaySelector{SingleNode: ptr.To(false)}),
+			overlay("gateway").WithSelector(types.OverlaySelector{Expression: `spec.gateway == "custom"`}),
+			overlay("no-gateway").WithSelector(types.OverlaySelector{Expression: `spec.gateway == ""`}),
+			overlay("all").WithSelector(types.OverlaySelector{
+				Platforms:  []common.Platform{cluster.SelfManagedRhoai, cluster.ManagedRhoai},
+				SingleNode: ptr.To(true),
+				Expression: `spec.gateway.startsWith("cust")`,
+			}),
+		),
+		Sources: []types.ManifestSource{
+			types.YAMLSource{Path: "yaml"},
+			types.KustomizeSource{ManifestInfo: overlay("source").WithSelector(types.OverlaySelector{Platforms: []common.Platform{cluster.OpenDataHub}})},
+		},
+	}
+
+	err = overlays.NewAction()(t.Context(), &rr)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	names := make([]string, 0, len(rr.Manifests))
+	for _, mi := range rr.Manifests {
+		names = append(names, mi.SourcePath)
+	}
+
+	g.Expect(names).Should(Equal([]string{
+		"overlays/" + string(cluster.SelfManagedRhoai),
+		"base",
+		"crd",
+		"sno",
+		"gateway",
+		"all",
+	}))
+
+	g.Expect(rr.Sources).Should(HaveLen(1))
+	g.Expect(dashboard.Status.AppliedOverlays).Should(Equal([]string{
+		"/manifests/dashboard/overlays/" + string(cluster.SelfManagedRhoai),
+		"/manifests/dashboard/base",
+		"/manifests/dashboard/crd",
+		"/manifests/dashboard/sno",
+		"/manifests/dashboard/gateway",
+		"/manifests/dashboard/all",
+	}))
+}
+
+func TestSelectOverlaysActionInvalidExpression(t *testing.T) {
+	tests := []struct {
+		expression string
+		err        string
+	}{
+		{expression: `spec.gateway ==`, err: "invalid expression"},
+		{expression: `"custom"`, err: "expected a boolean"},
+		{expression: `spec`, err: "expected a boolean"},
+		{expression: `size(spec) + 1`, err: "expected a boolean"},
+		{expression: `spec.gateway == "custom"`, err: "no such key"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			g := NewWithT(t)
+
+			cl, err := fakeclient.New()
+			g.Expect(err).ShouldNot(HaveOccurred())
+
+			rr := types.ReconciliationRequest{
+				Client:    cl,
+				Instance:  &componentApi.Dashboard{},
+				Manifests: []types.ManifestInfo{overlay("base").WithSelector(types.OverlaySelector{Expression: tt.expression})},
+			}
+
+			err = overlays.NewAction()(t.Context(), &rr)
+			g.Expect(err).Should(MatchError(ContainSubstring(tt.err)))
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package helm
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/resourcecacher"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/manifests/helm"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/manifests/kustomize"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+const rendererEngine = "helm"
+
+// ValuesFn computes values for the charts of the request, typically from the spec of the
+// reconciled component.
+type ValuesFn func(ctx context.Context, rr *types.ReconciliationRequest) (map[string]any, error)
+
+// Action takes a set of Helm charts and renders them as Unstructured resources for further
+// processing, with values computed from the reconciled resource. The charts are rendered on
+// the client side, there is no release storage. The Action can eventually cache the results
+// in memory to avoid doing a full rendering when not needed.
+type Action struct {
+	cacher resourcecacher.ResourceCacher
+	cache  bool
+
+	valuesFn []ValuesFn
+
+	heOpts []helm.EngineOptsFn
+	he     *helm.Engine
+}
+
+type ActionOpts func(*Action)
+
+// WithValuesFn sets functions computing the values of the charts, the values computed by
+// the last functions take precedence, and the ones set on the charts take precedence over
+// all of them.
+func WithValuesFn(fns ...ValuesFn) ActionOpts {
+	return func(a *Action) {
+		a.valuesFn = append(a.valuesFn, fns...)
+	}
+}
+
+func WithLabel(name string, value string) ActionOpts {
+	return func(a *Action) {
+		a.heOpts = append(a.heOpts, helm.WithEngineRenderOpts(helm.WithLabel(name, value)))
+	}
+}
+
+func WithLabels(values map[string]string) ActionOpts {
+	return func(a *Action) {
+		a.heOpts = append(a.heOpts, helm.WithEngineRenderOpts(helm.WithLabels(values)))
+	}
+}
+
+func WithAnnotation(name string, value string) ActionOpts {
+	return func(a *Action) {
+		a.heOpts = append(a.heOpts, helm.WithEngineRenderOpts(helm.WithAnnotation(name, value)))
+	}
+}
+
+func WithAnnotations(values map[string]string) ActionOpts {
+	return func(a *Action) {
+		a.heOpts = append(a.heOpts, helm.WithEngineRenderOpts(helm.WithAnnotations(values)))
+	}
+}
+
+// WithFilters sets kustomize filters applied to the resources rendered by the charts.
+func WithFilters(values ...kustomize.FilterFn) ActionOpts {
+	return func(a *Action) {
+		a.heOpts = append(a.heOpts, helm.WithEngineRenderOpts(helm.WithFilters(values...)))
+	}
+}
+
+func WithChartsOptions(values ...helm.EngineOptsFn) ActionOpts {
+	return func(action *Action) {
+		action.heOpts = append(action.heOpts, values...)
+	}
+}
+
+func WithCache(enabled bool) ActionOpts {
+	return func(action *Action) {
+		action.cache = enabled
+	}
+}
+
+func (a *Action) run(ctx context.Context, rr *types.ReconciliationRequest) error {
+	return a.cacher.Render(ctx, rr, a.render)
+}
+
+func (a *Action) render(ctx context.Context, rr *types.ReconciliationRequest) (resources.UnstructuredList, error) {
+	result := make(resources.UnstructuredList, 0)
+
+	// Fetch application namespace from DSCI.
+	appNamespace, err := cluster.ApplicationNamespace(ctx, rr.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]any{}
+
+	for _, fn := range a.valuesFn {
+		v, err := fn(ctx, rr)
+		if err != nil {
+			return nil, fmt.Errorf("unable to compute chart values: %w", err)
+		}
+
+		maps.Copy(values, v)
+	}
+
+	for i := range rr.Charts {
+		opts := []helm.RenderOptsFn{
+			helm.WithReleaseName(rr.Charts[i].ReleaseName),
+			helm.WithNamespace(appNamespace),
+			helm.WithValues(values),
+			helm.WithValues(rr.Charts[i].Values),
+			helm.WithLabels(rr.Charts[i].Labels),
+			helm.WithAnnotations(rr.Charts[i].Annotations),
+		}
+
+		if rr.Charts[i].FS != nil {
+			opts = append(opts, helm.WithFS(rr.Charts[i].FS))
+		}
+
+		renderedResources, err := a.he.Render(rr.Charts[i].Path, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, renderedResources...)
+	}
+
+	return result, nil
+}
+
+func NewAction(opts ...ActionOpts) actions.Fn {
+	action := Action{
+		cacher: resourcecacher.NewResourceCacher(rendererEngine),
+		cache:  true,
+	}
+
+	for _, opt := range opts {
+		opt(&action)
+	}
+
+	if action.cache {
+		action.cacher.SetKeyFn(types.Hash)
+	}
+
+	action.he = helm.NewEngine(action.heOpts...)
+
+	return action.run
+}
@@ -0,0 +1,183 @@
+package helm_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"testing/fstest"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/rs/xid"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/render"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/render/helm"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+
+	. "github.com/onsi/gomega"
+)
+
+const testChart = `
+apiVersion: v2
+name: test-chart
+version: 0.1.0
+`
+
+const testChartValues = `
+replicas: 1
+`
+
+const testChartDeployment = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: {{ .Release.Name }}
+  namespace: {{ .Release.Namespace }}
+spec:
+  replicas: {{ .Values.replicas }}
+  template:
+    metadata:
+      annotations:
+        component: {{ .Values.component }}
+`
+
+var testChartFS = fstest.MapFS{
+	"chart/Chart.yaml":                {Data: []byte(testChart)},
+	"chart/values.yaml":               {Data: []byte(testChartValues)},
+	"chart/templates/deployment.yaml": {Data: []byte(testChartDeployment)},
+}
+
+func componentValues(_ context.Context, rr *types.ReconciliationRequest) (map[string]any, error) {
+	return map[string]any{"component": rr.Instance.GetName(), "replicas": 2}, nil
+}
+
+func TestRenderChartsAction(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx := t.Context()
+	ns := xid.New().String()
+
+	cl, err := fakeclient.New(fakeclient.WithObjects(&dsciv2.DSCInitialization{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-dsci"},
+		Spec:       dsciv2.DSCInitializationSpec{ApplicationsNamespace: ns},
+	}))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	action := helm.NewAction(
+		helm.WithCache(false),
+		helm.WithValuesFn(componentValues),
+		helm.WithLabel("platform.opendatahub.io/part-of", "foo"),
+		helm.WithAnnotation("platform.opendatahub.io/release", "1.2.3"),
+	)
+
+	render.RenderedResourcesTotal.Reset()
+
+	rr := types.ReconciliationRequest{
+		Client:   cl,
+		Instance: &componentApi.Dashboard{TypeMeta: metav1.TypeMeta{Kind: componentApi.DashboardKind}, ObjectMeta: metav1.ObjectMeta{Name: "dashboard"}},
+		Release:  common.Release{Name: cluster.OpenDataHub},
+		Charts: []types.HelmChartInfo{{
+			FS:          testChartFS,
+			Path:        "chart",
+			ReleaseName: "dashboard-chart",
+			Values:      map[string]any{"replicas": 3},
+			Labels:      map[string]string{"chart": "test-chart"},
+		}},
+	}
+
+	err = action(ctx, &rr)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(rr.Generated).Should(BeTrue())
+
+	g.Expect(rr.Resources).Should(And(
+		HaveLen(1),
+		HaveEach(And(
+			jq.Match(`.metadata.name == "dashboard-chart"`),
+			jq.Match(`.metadata.namespace == "%s"`, ns),
+			jq.Match(`.metadata.labels."platform.opendatahub.io/part-of" == "foo"`),
+			jq.Match(`.metadata.labels.chart == "test-chart"`),
+			jq.Match(`.metadata.annotations."platform.opendatahub.io/release" == "1.2.3"`),
+			jq.Match(`.spec.replicas == 3`),
+			jq.Match(`.spec.template.metadata.annotations.component == "dashboard"`),
+		)),
+	))
+
+	rc := testutil.ToFloat64(render.RenderedResourcesTotal.WithLabelValues("dashboard", "helm"))
+	g.Expect(rc).Should(BeNumerically("==", 1))
+}
+
+func TestRenderChartsActionWithCache(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx := t.Context()
+
+	cl, err := fakeclient.New(fakeclient.WithObjects(&dsciv2.DSCInitialization{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-dsci"},
+		Spec:       dsciv2.DSCInitializationSpec{ApplicationsNamespace: xid.New().String()},
+	}))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	calls := 0
+
+	action := helm.NewAction(
+		helm.WithValuesFn(func(ctx context.Context, rr *types.ReconciliationRequest) (map[string]any, error) {
+			calls++
+			return componentValues(ctx, rr)
+		}),
+	)
+
+	render.RenderedResourcesTotal.Reset()
+
+	for i := range 3 {
+		rr := types.ReconciliationRequest{
+			Client:   cl,
+			Instance: &componentApi.Dashboard{TypeMeta: metav1.TypeMeta{Kind: componentApi.DashboardKind}, ObjectMeta: metav1.ObjectMeta{Name: "dashboard"}},
+			Release:  common.Release{Name: cluster.OpenDataHub},
+			Charts:   []types.HelmChartInfo{{FS: testChartFS, Path: "chart"}},
+		}
+
+		err = action(ctx, &rr)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(rr.Resources).Should(And(
+			HaveLen(1),
+			HaveEach(jq.Match(`.spec.replicas == 2`)),
+		))
+		g.Expect(rr.Generated).Should(Equal(i == 0))
+
+		rc := testutil.ToFloat64(render.RenderedResourcesTotal)
+		g.Expect(rc).Should(BeNumerically("==", 1))
+	}
+
+	g.Expect(calls).Should(Equal(1))
+}
+
+func TestRenderChartsActionValuesError(t *testing.T) {
+	g := NewWithT(t)
+
+	cl, err := fakeclient.New(fakeclient.WithObjects(&dsciv2.DSCInitialization{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-dsci"},
+		Spec:       dsciv2.DSCInitializationSpec{ApplicationsNamespace: xid.New().String()},
+	}))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	action := helm.NewAction(
+		helm.WithValuesFn(func(context.Context, *types.ReconciliationRequest) (map[string]any, error) {
+			return nil, errors.New("boom")
+		}),
+	)
+
+	rr := types.ReconciliationRequest{
+		Client:   cl,
+		Instance: &componentApi.Dashboard{},
+		Charts:   []types.HelmChartInfo{{FS: testChartFS, Path: "chart"}},
+	}
+
+	err = action(t.Context(), &rr)
+	g.Expect(err).Should(MatchError(ContainSubstring("unable to compute chart values: boom")))
+}
@@ -0,0 +1,317 @@
+package sources
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"maps"
+	"path"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/resourcecacher"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/manifests/kustomize"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/manifests/oci"
+	mt "github.com/opendatahub-io/opendatahub-operator/v2/pkg/manifests/template"
+	my "github.com/opendatahub-io/opendatahub-operator/v2/pkg/manifests/yaml"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+const (
+	ComponentKey    = "Component"
+	AppNamespaceKey = "AppNamespace"
+)
+
+// schemes are the supported ManifestSource schemes, in rendering order.
+var schemes = []string{
+	types.KustomizeScheme,
+	types.TemplateScheme,
+	types.YAMLScheme,
+	types.OCIScheme,
+}
+
+// Action takes the ManifestSources of the request and renders them as Unstructured resources
+// for further processing, each with the engine selected by the scheme of its URI. The resources
+// are rendered grouped by scheme, and each scheme is cached and accounted in the
+// action_renderer_manifests_total metric separately.
+type Action struct {
+	cachers map[string]*resourcecacher.ResourceCacher
+	cache   bool
+
+	data   map[string]any
+	dataFn []func(context.Context, *types.ReconciliationRequest) (map[string]any, error)
+
+	labels      map[string]string
+	annotations map[string]string
+
+	keOpts  []kustomize.EngineOptsFn
+	ke      *kustomize.Engine
+	ociOpts []oci.ClientOpts
+}
+
+type ActionOpts func(*Action)
+
+func WithCache(enabled bool) ActionOpts {
+	return func(action *Action) {
+		action.cache = enabled
+	}
+}
+
+// WithData sets data available to the templates.
+func WithData(data map[string]any) ActionOpts {
+	return func(action *Action) {
+		maps.Copy(action.data, data)
+	}
+}
+
+// WithDataFn sets functions computing data available to the templates.
+func WithDataFn(fns ...func(context.Context, *types.ReconciliationRequest) (map[string]any, error)) ActionOpts {
+	return func(action *Action) {
+		action.dataFn = append(action.dataFn, fns...)
+	}
+}
+
+func WithLabel(name string, value string) ActionOpts {
+	return func(a *Action) {
+		a.labels[name] = value
+	}
+}
+
+func WithLabels(values map[string]string) ActionOpts {
+	return func(a *Action) {
+		maps.Copy(a.labels, values)
+	}
+}
+
+func WithAnnotation(name string, value string) ActionOpts {
+	return func(a *Action) {
+		a.annotations[name] = value
+	}
+}
+
+func WithAnnotations(values map[string]string) ActionOpts {
+	return func(a *Action) {
+		maps.Copy(a.annotations, values)
+	}
+}
+
+// WithManifestsOptions configures the engine rendering the kustomize sources.
+func WithManifestsOptions(values ...kustomize.EngineOptsFn) ActionOpts {
+	return func(action *Action) {
+		action.keOpts = append(action.keOpts, values...)
+	}
+}
+
+// WithOCIOptions configures the client pulling the OCI sources.
+func WithOCIOptions(values ...oci.ClientOpts) ActionOpts {
+	return func(action *Action) {
+		action.ociOpts = append(action.ociOpts, values...)
+	}
+}
+
+func (a *Action) run(ctx context.Context, rr *types.ReconciliationRequest) error {
+	for i := range rr.Sources {
+		if _, ok := a.cachers[rr.Sources[i].URI().Scheme]; !ok {
+			return fmt.Errorf("unsupported manifest source %s", rr.Sources[i].URI())
+		}
+	}
+
+	for _, scheme := range schemes {
+		if !slices.ContainsFunc(rr.Sources, func(s types.ManifestSource) bool { return s.URI().Scheme == scheme }) {
+			continue
+		}
+
+		err := a.cachers[scheme].Render(ctx, rr, func(ctx context.Context, rr *types.ReconciliationRequest) (resources.UnstructuredList, error) {
+			return a.render(ctx, rr, scheme)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (a *Action) render(ctx context.Context, rr *types.ReconciliationRequest, scheme string) (resources.UnstructuredList, error) {
+	decoder := serializer.NewCodecFactory(rr.Client.Scheme()).UniversalDeserializer()
+
+	// Fetch application namespace from DSCI.
+	appNamespace, err := cluster.ApplicationNamespace(ctx, rr.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(resources.UnstructuredList, 0)
+
+	for _, source := range rr.Sources {
+		if source.URI().Scheme != scheme {
+			continue
+		}
+
+		var u []unstructured.Unstructured
+
+		switch s := source.(type) {
+		case types.KustomizeSource:
+			u, err = a.renderKustomize(s.ManifestInfo.String(), s.FS, appNamespace)
+		case types.TemplateSource:
+			u, err = a.renderTemplate(ctx, rr, decoder, s, appNamespace)
+		case types.YAMLSource:
+			u, err = my.Render(decoder, s.FS, s.Path)
+			a.decorate(u, s.Labels, s.Annotations)
+		case types.OCISource:
+			u, err = a.renderOCI(ctx, decoder, s, appNamespace)
+		default:
+			err = fmt.Errorf("unsupported manifest source type %T", source)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to render %s: %w", source.URI(), err)
+		}
+
+		result = append(result, u...)
+	}
+
+	return result, nil
+}
+
+func (a *Action) renderKustomize(path string, fsys fs.FS, appNamespace string) ([]unstructured.Unstructured, error) {
+	opts := []kustomize.RenderOptsFn{
+		kustomize.WithNamespace(appNamespace),
+		kustomize.WithLabels(a.labels),
+		kustomize.WithAnnotations(a.annotations),
+	}
+
+	if fsys != nil {
+		kfs, err := kustomize.ToFileSystem(fsys)
+		if err != nil {
+			return nil, err
+		}
+
+		opts = append(opts, kustomize.WithFS(kfs))
+	}
+
+	return a.ke.Render(path, opts...)
+}
+
+func (a *Action) renderTemplate(
+	ctx context.Context,
+	rr *types.ReconciliationRequest,
+	decoder runtime.Decoder,
+	s types.TemplateSource,
+	appNamespace string,
+) ([]unstructured.Unstructured, error) {
+	data := maps.Clone(a.data)
+
+	for _, fn := range a.dataFn {
+		values, err := fn(ctx, rr)
+		if err != nil {
+			return nil, fmt.Errorf("unable to compute template data: %w", err)
+		}
+
+		maps.Copy(data, values)
+	}
+
+	data[ComponentKey] = rr.Instance
+	data[AppNamespaceKey] = appNamespace
+
+	u, err := mt.Render(decoder, s.FS, s.Path, data)
+	if err != nil {
+		return nil, err
+	}
+
+	a.decorate(u, s.Labels, s.Annotations)
+
+	return u, nil
+}
+
+// renderOCI renders the manifests of the artifact with kustomize when the path holds a
+// kustomization, as plain manifests otherwise.
+func (a *Action) renderOCI(ctx context.Context, decoder runtime.Decoder, s types.OCISource, appNamespace string) ([]unstructured.Unstructured, error) {
+	client := oci.NewClient(append(slices.Clone(a.ociOpts), oci.WithPlainHTTP(s.PlainHTTP))...)
+
+	files, err := client.Pull(ctx, s.Reference)
+	if err != nil {
+		return nil, err
+	}
+
+	root := s.Path
+	if root == "" {
+		root = "."
+	}
+
+	if !hasKustomization(files, root) {
+		u, err := my.Render(decoder, files, root)
+		a.decorate(u, s.Labels, s.Annotations)
+
+		return u, err
+	}
+
+	u, err := a.renderKustomize(root, files, appNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range u {
+		resources.SetLabels(&u[i], s.Labels)
+		resources.SetAnnotations(&u[i], s.Annotations)
+	}
+
+	return u, nil
+}
+
+// decorate sets the labels and annotations of the action, then the ones of the source.
+func (a *Action) decorate(u []unstructured.Unstructured, labels map[string]string, annotations map[string]string) {
+	for i := range u {
+		resources.SetLabels(&u[i], a.labels)
+		resources.SetAnnotations(&u[i], a.annotations)
+
+		resources.SetLabels(&u[i], labels)
+		resources.SetAnnotations(&u[i], annotations)
+	}
+}
+
+func hasKustomization(fsys fs.FS, root string) bool {
+	for _, name := range []string{
+		path.Join(root, kustomize.DefaultKustomizationFileName),
+		path.Join(root, kustomize.DefaultKustomizationFilePath, kustomize.DefaultKustomizationFileName),
+	} {
+		if _, err := fs.Stat(fsys, name); err == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+func NewAction(opts ...ActionOpts) actions.Fn {
+	action := Action{
+		cachers:     make(map[string]*resourcecacher.ResourceCacher, len(schemes)),
+		cache:       true,
+		data:        make(map[string]any),
+		labels:      make(map[string]string),
+		annotations: make(map[string]string),
+	}
+
+	for _, opt := range opts {
+		opt(&action)
+	}
+
+	for _, scheme := range schemes {
+		cacher := resourcecacher.NewResourceCacher(scheme)
+		if action.cache {
+			cacher.SetKeyFn(types.Hash)
+		}
+
+		action.cachers[scheme] = &cacher
+	}
+
+	action.ke = kustomize.NewEngine(action.keOpts...)
+
+	return action.run
+}
@@ -0,0 +1,217 @@
+package sources_test
+
+import (
+	"net/url"
+	"testing"
+	"testing/fstest"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/rs/xid"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	dsciv2 "github.com/opendatahub-io/opendatahub-operator/v2/api/dscinitialization/v2"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/render"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/render/sources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/registry"
+
+	. "github.com/onsi/gomega"
+)
+
+const testKustomization = `
+apiVersion: kustomize.config.k8s.io/v1beta1
+resources:
+- cm.yaml
+`
+
+const testTemplate = `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: template-cm
+  namespace: {{ .AppNamespace }}
+data:
+  component: {{ .Component.Name }}
+`
+
+func configMap(name string) string {
+	return `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: ` + name + `
+data:
+  foo: bar
+`
+}
+
+func TestRenderSourcesAction(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx := t.Context()
+	ns := xid.New().String()
+
+	reg := registry.New(t)
+
+	kustomizeRef, err := reg.Push("manifests/kustomize", "v1", map[string]string{
+		"base/kustomization.yaml": testKustomization,
+		"base/cm.yaml":            configMap("oci-kustomize-cm"),
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	yamlRef, err := reg.Push("manifests/yaml", "v1", map[string]string{
+		"cm.yaml": configMap("oci-yaml-cm"),
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	dsci := &dsciv2.DSCInitialization{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-dsci",
+		},
+		Spec: dsciv2.DSCInitializationSpec{
+			ApplicationsNamespace: ns,
+		},
+	}
+
+	cl, err := fakeclient.New(fakeclient.WithObjects(dsci))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	files := fstest.MapFS{
+		"kustomize/kustomization.yaml": {Data: []byte(testKustomization)},
+		"kustomize/cm.yaml":            {Data: []byte(configMap("kustomize-cm"))},
+		"template/cm.tmpl.yaml":        {Data: []byte(testTemplate)},
+		"yaml/cm.yaml":                 {Data: []byte(configMap("yaml-cm"))},
+		"yaml/README.md":               {Data: []byte("ignored")},
+	}
+
+	action := sources.NewAction(
+		sources.WithCache(false),
+		sources.WithLabel("platform.opendatahub.io/part-of", "foo"),
+		sources.WithAnnotation("platform.opendatahub.io/release", "1.2.3"),
+	)
+
+	render.RenderedResourcesTotal.Reset()
+
+	rr := types.ReconciliationRequest{
+		Client:   cl,
+		Instance: &componentApi.Dashboard{TypeMeta: metav1.TypeMeta{Kind: componentApi.DashboardKind}, ObjectMeta: metav1.ObjectMeta{Name: "dashboard"}},
+		Release:  common.Release{Name: cluster.OpenDataHub},
+		Sources: []types.ManifestSource{
+			types.OCISource{Reference: yamlRef, PlainHTTP: true, Labels: map[string]string{"source": "oci"}},
+			types.YAMLSource{FS: files, Path: "yaml"},
+			types.TemplateSource{TemplateInfo: types.TemplateInfo{FS: files, Path: "template/*.tmpl.yaml"}},
+			types.KustomizeSource{ManifestInfo: types.ManifestInfo{Path: "kustomize"}, FS: files},
+			types.OCISource{Reference: kustomizeRef, Path: "base", PlainHTTP: true},
+		},
+	}
+
+	err = action(ctx, &rr)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(rr.Generated).Should(BeTrue())
+
+	g.Expect(rr.Resources).Should(And(
+		HaveLen(5),
+		HaveEach(And(
+			jq.Match(`.metadata.labels."platform.opendatahub.io/part-of" == "foo"`),
+			jq.Match(`.metadata.annotations."platform.opendatahub.io/release" == "1.2.3"`),
+		)),
+	))
+
+	// resources are rendered grouped by scheme
+	g.Expect(rr.Resources[0].GetName()).Should(Equal("kustomize-cm"))
+	g.Expect(rr.Resources[0].GetNamespace()).Should(Equal(ns))
+	g.Expect(rr.Resources[1]).Should(And(
+		jq.Match(`.metadata.name == "template-cm"`),
+		jq.Match(`.metadata.namespace == "%s"`, ns),
+		jq.Match(`.data.component == "dashboard"`),
+	))
+	g.Expect(rr.Resources[2].GetName()).Should(Equal("yaml-cm"))
+	g.Expect(rr.Resources[3]).Should(And(
+		jq.Match(`.metadata.name == "oci-yaml-cm"`),
+		jq.Match(`.metadata.labels.source == "oci"`),
+	))
+	g.Expect(rr.Resources[4]).Should(And(
+		jq.Match(`.metadata.name == "oci-kustomize-cm"`),
+		jq.Match(`.metadata.namespace == "%s"`, ns),
+	))
+
+	for engine, count := range map[string]int{
+		types.KustomizeScheme: 1,
+		types.TemplateScheme:  1,
+		types.YAMLScheme:      1,
+		types.OCIScheme:       2,
+	} {
+		rc := testutil.ToFloat64(render.RenderedResourcesTotal.WithLabelValues("dashboard", engine))
+		g.Expect(rc).Should(BeNumerically("==", count), engine)
+	}
+}
+
+func TestRenderSourcesActionWithCache(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx := t.Context()
+
+	dsci := &dsciv2.DSCInitialization{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-dsci",
+		},
+		Spec: dsciv2.DSCInitializationSpec{
+			ApplicationsNamespace: xid.New().String(),
+		},
+	}
+
+	cl, err := fakeclient.New(fakeclient.WithObjects(dsci))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	files := fstest.MapFS{
+		"yaml/cm.yaml": {Data: []byte(configMap("yaml-cm"))},
+	}
+
+	action := sources.NewAction()
+
+	render.RenderedResourcesTotal.Reset()
+
+	for i := range 3 {
+		rr := types.ReconciliationRequest{
+			Client:   cl,
+			Instance: &componentApi.Dashboard{TypeMeta: metav1.TypeMeta{Kind: componentApi.DashboardKind}, ObjectMeta: metav1.ObjectMeta{Name: "dashboard"}},
+			Release:  common.Release{Name: cluster.OpenDataHub},
+			Sources:  []types.ManifestSource{types.YAMLSource{FS: files, Path: "yaml"}},
+		}
+
+		err = action(ctx, &rr)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(rr.Resources).Should(HaveLen(1))
+		g.Expect(rr.Generated).Should(Equal(i == 0))
+
+		rc := testutil.ToFloat64(render.RenderedResourcesTotal)
+		g.Expect(rc).Should(BeNumerically("==", 1))
+	}
+}
+
+func TestRenderSourcesActionUnsupportedScheme(t *testing.T) {
+	g := NewWithT(t)
+
+	cl, err := fakeclient.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	rr := types.ReconciliationRequest{
+		Client:   cl,
+		Instance: &componentApi.Dashboard{},
+		Sources:  []types.ManifestSource{unknownSource{}},
+	}
+
+	err = sources.NewAction()(t.Context(), &rr)
+	g.Expect(err).Should(MatchError(ContainSubstring("unsupported manifest source helm://chart")))
+}
+
+type unknownSource struct{}
+
+func (unknownSource) URI() *url.URL {
+	return &url.URL{Scheme: "helm", Host: "chart"}
+}
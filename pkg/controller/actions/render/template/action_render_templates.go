@@ -1,22 +1,18 @@
 package template
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"maps"
-	gt "text/template"
 
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/resourcecacher"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	mt "github.com/opendatahub-io/opendatahub-operator/v2/pkg/manifests/template"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
-	templateutils "github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/template"
 )
 
 const (
@@ -89,23 +85,6 @@ func (a *Action) run(ctx context.Context, rr *types.ReconciliationRequest) error
 	return a.cacher.Render(ctx, rr, a.render)
 }
 
-func (a *Action) decode(decoder runtime.Decoder, data []byte, info types.TemplateInfo) ([]unstructured.Unstructured, error) {
-	u, err := resources.Decode(decoder, data)
-	if err != nil {
-		return nil, fmt.Errorf("failed to decode template: %w", err)
-	}
-
-	for i := range u {
-		resources.SetLabels(&u[i], a.labels)
-		resources.SetAnnotations(&u[i], a.annotations)
-
-		resources.SetLabels(&u[i], info.Labels)
-		resources.SetAnnotations(&u[i], info.Annotations)
-	}
-
-	return u, err
-}
-
 func (a *Action) render(ctx context.Context, rr *types.ReconciliationRequest) (resources.UnstructuredList, error) {
 	// Early return if no templates to render
 	if len(rr.Templates) == 0 {
@@ -136,28 +115,21 @@ func (a *Action) render(ctx context.Context, rr *types.ReconciliationRequest) (r
 
 	result := make(resources.UnstructuredList, 0)
 
-	var buffer bytes.Buffer
-
 	for i := range rr.Templates {
-		tmpl, err := gt.New("").Option("missingkey=error").Funcs(templateutils.TextTemplateFuncMap()).ParseFS(rr.Templates[i].FS, rr.Templates[i].Path)
+		u, err := mt.Render(decoder, rr.Templates[i].FS, rr.Templates[i].Path, data)
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse template from: %w", err)
+			return nil, err
 		}
 
-		for _, t := range tmpl.Templates() {
-			buffer.Reset()
-			err = t.Execute(&buffer, data)
-			if err != nil {
-				return nil, fmt.Errorf("failed to execute template: %w", err)
-			}
+		for j := range u {
+			resources.SetLabels(&u[j], a.labels)
+			resources.SetAnnotations(&u[j], a.annotations)
 
-			u, err := a.decode(decoder, buffer.Bytes(), rr.Templates[i])
-			if err != nil {
-				return nil, fmt.Errorf("failed to decode template: %w", err)
-			}
-
-			result = append(result, u...)
+			resources.SetLabels(&u[j], rr.Templates[i].Labels)
+			resources.SetAnnotations(&u[j], rr.Templates[i].Annotations)
 		}
+
+		result = append(result, u...)
 	}
 
 	return result, nil
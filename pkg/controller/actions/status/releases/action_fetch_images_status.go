@@ -0,0 +1,294 @@
+package releases
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+)
+
+// defaultImageInspector is shared by the controllers so that each image is inspected once. It
+// is used when the image revisions are enabled with SetImageRevisions.
+var defaultImageInspector ImageInspector = newRegistryInspector()
+
+type ImagesAction struct {
+	inspector ImageInspector
+
+	mu      sync.Mutex
+	digests map[k8stypes.NamespacedName]podDigests
+}
+
+// podDigests are the digests running in the pods of a Deployment, by container. They are listed
+// again only when the generation or the status of the Deployment changes, since the pods are
+// not cached and a rollout or a restart always updates the status of the Deployment.
+type podDigests struct {
+	generation int64
+	status     appsv1.DeploymentStatus
+	containers map[string][]string
+}
+
+type ImagesActionOpts func(*ImagesAction)
+
+// WithImageInspector sets the inspector reading the labels of the running images, used whether
+// or not the image revisions are enabled.
+func WithImageInspector(inspector ImageInspector) ImagesActionOpts {
+	return func(a *ImagesAction) {
+		a.inspector = inspector
+	}
+}
+
+// run reports the images of the Deployments rendered for the component, together with the
+// digests running in their pods and the upstream revision of the running images, and flags the
+// images that do not run as declared. It must run after the resources have been deployed.
+func (a *ImagesAction) run(ctx context.Context, rr *types.ReconciliationRequest) error {
+	obj, ok := rr.Instance.(common.WithImages)
+	if !ok {
+		return fmt.Errorf("resource instance %v is not a WithImages", rr.Instance)
+	}
+
+	images := make([]common.ComponentImage, 0)
+	seen := make(map[k8stypes.NamespacedName]struct{})
+
+	for i := range rr.Resources {
+		if rr.Resources[i].GroupVersionKind() != gvk.Deployment {
+			continue
+		}
+
+		declared := appsv1.Deployment{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(rr.Resources[i].Object, &declared); err != nil {
+			return fmt.Errorf("failed to convert Deployment %s: %w", rr.Resources[i].GetName(), err)
+		}
+
+		deploymentImages, err := a.deploymentImages(ctx, rr.Client, &declared)
+		if err != nil {
+			return err
+		}
+
+		images = append(images, deploymentImages...)
+		seen[client.ObjectKeyFromObject(&declared)] = struct{}{}
+	}
+
+	// forget the Deployments no longer rendered for the component
+	a.mu.Lock()
+	for key := range a.digests {
+		if _, ok := seen[key]; !ok {
+			delete(a.digests, key)
+		}
+	}
+	a.mu.Unlock()
+
+	slices.SortFunc(images, func(a, b common.ComponentImage) int {
+		return cmp.Or(cmp.Compare(a.Deployment, b.Deployment), cmp.Compare(a.Container, b.Container))
+	})
+
+	obj.SetImageStatus(images)
+
+	return nil
+}
+
+func (a *ImagesAction) deploymentImages(ctx context.Context, cli client.Client, declared *appsv1.Deployment) ([]common.ComponentImage, error) {
+	live := appsv1.Deployment{}
+	digests := map[string][]string{}
+
+	err := cli.Get(ctx, client.ObjectKeyFromObject(declared), &live)
+	switch {
+	case k8serr.IsNotFound(err):
+		// reported as not deployed
+	case err != nil:
+		return nil, fmt.Errorf("failed to get Deployment %s: %w", declared.Name, err)
+	case live.Spec.Selector != nil:
+		digests, err = a.podDigests(ctx, cli, &live)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	images := make([]common.ComponentImage, 0, len(declared.Spec.Template.Spec.Containers))
+
+	for _, c := range declared.Spec.Template.Spec.Containers {
+		image := common.ComponentImage{
+			Deployment: declared.Name,
+			Container:  c.Name,
+			Image:      c.Image,
+			Digests:    digests[c.Name],
+		}
+
+		liveImage := ""
+		for _, lc := range live.Spec.Template.Spec.Containers {
+			if lc.Name == c.Name {
+				liveImage = lc.Image
+			}
+		}
+
+		image.Mismatch = imageMismatch(c.Image, liveImage, image.Digests)
+		image.Revision = a.revision(ctx, c.Image, image.Digests)
+
+		images = append(images, image)
+	}
+
+	return images, nil
+}
+
+// podDigests returns the digests running in the pods of a Deployment by container, listing the
+// pods only when the Deployment changed since the last time they were listed.
+func (a *ImagesAction) podDigests(ctx context.Context, cli client.Client, live *appsv1.Deployment) (map[string][]string, error) {
+	key := client.ObjectKeyFromObject(live)
+
+	a.mu.Lock()
+	cached, ok := a.digests[key]
+	a.mu.Unlock()
+
+	if ok && cached.generation == live.Generation && equality.Semantic.DeepEqual(cached.status, live.Status) {
+		return cached.containers, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(live.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid selector of Deployment %s: %w", live.Name, err)
+	}
+
+	pods := corev1.PodList{}
+
+	err = cli.List(ctx, &pods, client.InNamespace(live.Namespace), client.MatchingLabelsSelector{Selector: selector})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the pods of Deployment %s: %w", live.Name, err)
+	}
+
+	containers := make(map[string][]string, len(live.Spec.Template.Spec.Containers))
+	for _, c := range live.Spec.Template.Spec.Containers {
+		if d := runningDigests(pods.Items, c.Name); len(d) != 0 {
+			containers[c.Name] = d
+		}
+	}
+
+	a.mu.Lock()
+	a.digests[key] = podDigests{
+		generation: live.Generation,
+		status:     *live.Status.DeepCopy(),
+		containers: containers,
+	}
+	a.mu.Unlock()
+
+	return containers, nil
+}
+
+// revision returns the upstream revision of the running image, if all its running digests
+// agree on it. The images that cannot be inspected are reported without revision.
+func (a *ImagesAction) revision(ctx context.Context, image string, digests []string) string {
+	inspector := a.inspector
+	if inspector == nil {
+		if !ImageRevisionsEnabled() {
+			return ""
+		}
+
+		inspector = defaultImageInspector
+	}
+
+	log := logf.FromContext(ctx)
+	repository := imageRepository(image)
+	revisions := make([]string, 0, len(digests))
+
+	for _, d := range digests {
+		labels, err := inspector.Labels(ctx, repository+"@"+d)
+		if err != nil {
+			log.V(3).Info("Unable to read the labels of the image", "image", repository, "digest", d, "error", err.Error())
+			continue
+		}
+
+		if rev := cmp.Or(labels[ImageRevisionLabel], labels[ImageVCSRefLabel]); rev != "" {
+			revisions = append(revisions, rev)
+		}
+	}
+
+	slices.Sort(revisions)
+	if revisions = slices.Compact(revisions); len(revisions) != 1 {
+		return ""
+	}
+
+	return revisions[0]
+}
+
+// runningDigests returns the digests of the image of a container running in the given pods.
+func runningDigests(pods []corev1.Pod, container string) []string {
+	var digests []string
+
+	for i := range pods {
+		for _, cs := range pods[i].Status.ContainerStatuses {
+			if cs.Name != container || cs.ImageID == "" {
+				continue
+			}
+
+			digest := cs.ImageID
+			if i := strings.LastIndex(digest, "@"); i >= 0 {
+				digest = digest[i+1:]
+			}
+
+			digests = append(digests, digest)
+		}
+	}
+
+	slices.Sort(digests)
+
+	return slices.Compact(digests)
+}
+
+// imageMismatch returns how the image running in a container differs from the declared one.
+func imageMismatch(declared string, live string, digests []string) string {
+	_, declaredDigest, pinned := strings.Cut(declared, "@")
+
+	switch {
+	case live == "":
+		return "the container is not deployed"
+	case live != declared:
+		return fmt.Sprintf("the Deployment runs %s instead of the declared image", live)
+	case len(digests) > 1:
+		return fmt.Sprintf("the pods run %d different digests of the image", len(digests))
+	case pinned && len(digests) == 1 && digests[0] != declaredDigest:
+		return fmt.Sprintf("the pods run %s instead of the declared digest %s", digests[0], declaredDigest)
+	default:
+		return ""
+	}
+}
+
+// imageRepository returns the repository of an image reference, without tag or digest.
+func imageRepository(image string) string {
+	image, _, _ = strings.Cut(image, "@")
+
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		image = image[:i]
+	}
+
+	return image
+}
+
+// NewImagesAction returns an action reporting the images running in the Deployments rendered
+// for a component. The upstream revisions of the running images are read from their registry
+// when the image revisions are enabled.
+func NewImagesAction(opts ...ImagesActionOpts) actions.Fn {
+	action := ImagesAction{
+		digests: map[k8stypes.NamespacedName]podDigests{},
+	}
+
+	for _, opt := range opts {
+		opt(&action)
+	}
+
+	return action.run
+}
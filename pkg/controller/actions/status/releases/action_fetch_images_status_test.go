@@ -0,0 +1,236 @@
+package releases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	componentApi "github.com/opendatahub-io/opendatahub-operator/v2/api/components/v1alpha1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions/status/releases"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/types"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	testNamespace = "opendatahub"
+	digestA       = "sha256:aaaa"
+	digestB       = "sha256:bbbb"
+)
+
+type fakeInspector map[string]map[string]string
+
+func (f fakeInspector) Labels(_ context.Context, image string) (map[string]string, error) {
+	labels, ok := f[image]
+	if !ok {
+		return nil, errors.New("unauthorized")
+	}
+
+	return labels, nil
+}
+
+func newDeployment(name string, images map[string]string) *appsv1.Deployment {
+	d := &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: gvk.Deployment.GroupVersion().String(), Kind: gvk.Deployment.Kind},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": name}},
+		},
+	}
+
+	for container, image := range images {
+		d.Spec.Template.Spec.Containers = append(d.Spec.Template.Spec.Containers, corev1.Container{Name: container, Image: image})
+	}
+
+	return d
+}
+
+func newPod(name string, app string, imageIDs map[string]string) *corev1.Pod {
+	p := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace, Labels: map[string]string{"app": app}},
+	}
+
+	for container, imageID := range imageIDs {
+		p.Status.ContainerStatuses = append(p.Status.ContainerStatuses, corev1.ContainerStatus{Name: container, ImageID: imageID})
+	}
+
+	return p
+}
+
+func TestFetchImagesStatusAction(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	declared := []*appsv1.Deployment{
+		newDeployment("controller", map[string]string{
+			"manager": "quay.io/opendatahub/controller@" + digestA,
+			"proxy":   "quay.io/opendatahub/proxy:v1",
+		}),
+		newDeployment("pinned", map[string]string{"manager": "quay.io/opendatahub/pinned@" + digestA}),
+		newDeployment("patched", map[string]string{"manager": "quay.io/opendatahub/patched:v1"}),
+		newDeployment("missing", map[string]string{"manager": "quay.io/opendatahub/missing:v1"}),
+	}
+
+	cl, err := fakeclient.New(fakeclient.WithObjects(
+		newDeployment("controller", map[string]string{
+			"manager": "quay.io/opendatahub/controller@" + digestA,
+			"proxy":   "quay.io/opendatahub/proxy:v1",
+		}),
+		newPod("controller-1", "controller", map[string]string{
+			"manager": "quay.io/opendatahub/controller@" + digestA,
+			"proxy":   "quay.io/opendatahub/proxy@" + digestA,
+		}),
+		newPod("controller-2", "controller", map[string]string{
+			"manager": "quay.io/opendatahub/controller@" + digestA,
+			"proxy":   "quay.io/opendatahub/proxy@" + digestB,
+		}),
+		newDeployment("pinned", map[string]string{"manager": "quay.io/opendatahub/pinned@" + digestA}),
+		newPod("pinned-1", "pinned", map[string]string{"manager": "quay.io/opendatahub/pinned@" + digestB}),
+		newDeployment("patched", map[string]string{"manager": "quay.io/opendatahub/patched:v2"}),
+	))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	instance := &componentApi.Ray{}
+
+	rr := types.ReconciliationRequest{
+		Client:   cl,
+		Instance: instance,
+	}
+
+	for _, d := range declared {
+		u, err := resources.ToUnstructured(d)
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		rr.Resources = append(rr.Resources, *u)
+	}
+
+	cm := unstructured.Unstructured{}
+	cm.SetGroupVersionKind(gvk.ConfigMap)
+	cm.SetName("config")
+	rr.Resources = append(rr.Resources, cm)
+
+	action := releases.NewImagesAction(releases.WithImageInspector(fakeInspector{
+		"quay.io/opendatahub/controller@" + digestA: {releases.ImageRevisionLabel: "0123abc"},
+		"quay.io/opendatahub/proxy@" + digestA:      {releases.ImageVCSRefLabel: "4567def"},
+		"quay.io/opendatahub/proxy@" + digestB:      {releases.ImageVCSRefLabel: "89abcde"},
+	}))
+
+	g.Expect(action(ctx, &rr)).Should(Succeed())
+
+	g.Expect(instance.Status.Images).Should(HaveExactElements(
+		common.ComponentImage{
+			Deployment: "controller",
+			Container:  "manager",
+			Image:      "quay.io/opendatahub/controller@" + digestA,
+			Digests:    []string{digestA},
+			Revision:   "0123abc",
+		},
+		common.ComponentImage{
+			Deployment: "controller",
+			Container:  "proxy",
+			Image:      "quay.io/opendatahub/proxy:v1",
+			Digests:    []string{digestA, digestB},
+			Mismatch:   "the pods run 2 different digests of the image",
+		},
+		common.ComponentImage{
+			Deployment: "missing",
+			Container:  "manager",
+			Image:      "quay.io/opendatahub/missing:v1",
+			Mismatch:   "the container is not deployed",
+		},
+		common.ComponentImage{
+			Deployment: "patched",
+			Container:  "manager",
+			Image:      "quay.io/opendatahub/patched:v1",
+			Mismatch:   "the Deployment runs quay.io/opendatahub/patched:v2 instead of the declared image",
+		},
+		common.ComponentImage{
+			Deployment: "pinned",
+			Container:  "manager",
+			Image:      "quay.io/opendatahub/pinned@" + digestA,
+			Digests:    []string{digestB},
+			Mismatch:   "the pods run " + digestB + " instead of the declared digest " + digestA,
+		},
+	))
+}
+
+func TestFetchImagesStatusActionListsPodsOnChange(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	images := map[string]string{"manager": "quay.io/opendatahub/controller:v1"}
+	lists := 0
+
+	cl, err := fakeclient.New(
+		fakeclient.WithObjects(
+			newDeployment("controller", images),
+			newPod("controller-1", "controller", map[string]string{"manager": "quay.io/opendatahub/controller@" + digestA}),
+		),
+		fakeclient.WithInterceptorFuncs(interceptor.Funcs{
+			List: func(ctx context.Context, cl client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+				if _, ok := list.(*corev1.PodList); ok {
+					lists++
+				}
+
+				return cl.List(ctx, list, opts...)
+			},
+		}),
+	)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	u, err := resources.ToUnstructured(newDeployment("controller", images))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	instance := &componentApi.Ray{}
+	rr := types.ReconciliationRequest{Client: cl, Instance: instance, Resources: []unstructured.Unstructured{*u}}
+
+	action := releases.NewImagesAction(releases.WithImageInspector(fakeInspector{}))
+
+	// the pods are listed once while the Deployment does not change
+	g.Expect(action(ctx, &rr)).Should(Succeed())
+	g.Expect(action(ctx, &rr)).Should(Succeed())
+	g.Expect(lists).Should(Equal(1))
+	g.Expect(instance.Status.Images).Should(HaveExactElements(
+		HaveField("Digests", []string{digestA}),
+	))
+
+	// a restart of the pods updates the status of the Deployment
+	g.Expect(cl.Delete(ctx, newPod("controller-1", "controller", nil))).Should(Succeed())
+	g.Expect(cl.Create(ctx, newPod("controller-2", "controller", map[string]string{"manager": "quay.io/opendatahub/controller@" + digestB}))).Should(Succeed())
+
+	live := appsv1.Deployment{}
+	g.Expect(cl.Get(ctx, client.ObjectKeyFromObject(u), &live)).Should(Succeed())
+	live.Status.ObservedGeneration++
+	g.Expect(cl.Status().Update(ctx, &live)).Should(Succeed())
+
+	g.Expect(action(ctx, &rr)).Should(Succeed())
+	g.Expect(lists).Should(Equal(2))
+	g.Expect(instance.Status.Images).Should(HaveExactElements(
+		HaveField("Digests", []string{digestB}),
+	))
+}
+
+func TestFetchImagesStatusActionRequiresWithImages(t *testing.T) {
+	g := NewWithT(t)
+
+	cl, err := fakeclient.New()
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	rr := types.ReconciliationRequest{
+		Client:   cl,
+		Instance: &componentApi.Dashboard{ObjectMeta: metav1.ObjectMeta{Name: "dashboard"}},
+	}
+
+	g.Expect(releases.NewImagesAction()(t.Context(), &rr)).Should(MatchError(ContainSubstring("is not a WithImages")))
+}
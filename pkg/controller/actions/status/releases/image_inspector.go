@@ -0,0 +1,96 @@
+package releases
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"k8s.io/utils/lru"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/manifests/oci"
+)
+
+const (
+	// ImageRevisionLabel is the OCI label holding the source control revision of an image.
+	ImageRevisionLabel = "org.opencontainers.image.revision"
+	// ImageVCSRefLabel is the label holding the source control revision of the images built
+	// before the adoption of the OCI labels.
+	ImageVCSRefLabel = "vcs-ref"
+
+	imageInspectTimeout = 5 * time.Second
+	// imageCacheSize bounds the number of inspected images kept in the cache.
+	imageCacheSize = 256
+	// imageFailureTTL is the duration after which an image that could not be inspected is
+	// inspected again.
+	imageFailureTTL = 10 * time.Minute
+)
+
+// imageRevisions enables the inspection of the running images in their registry.
+var imageRevisions atomic.Bool
+
+// SetImageRevisions enables or disables the inspection of the running images in their registry,
+// to report their upstream revision in the release status of the components. Disabled by default.
+func SetImageRevisions(enabled bool) {
+	imageRevisions.Store(enabled)
+}
+
+// ImageRevisionsEnabled returns true if the running images are inspected in their registry.
+func ImageRevisionsEnabled() bool {
+	return imageRevisions.Load()
+}
+
+// ImageInspector returns the labels of the configuration of an image referenced by digest.
+type ImageInspector interface {
+	Labels(ctx context.Context, image string) (map[string]string, error)
+}
+
+// registryInspector reads the labels of the images from their registry through the OCI
+// distribution API, with anonymous access. Since the images are referenced by digest, their
+// labels are cached until evicted by more recently inspected images, while the failures are
+// retried after imageFailureTTL.
+type registryInspector struct {
+	client *oci.Client
+	cache  *lru.Cache
+	now    func() time.Time
+}
+
+type inspectResult struct {
+	labels  map[string]string
+	err     error
+	expires time.Time
+}
+
+func newRegistryInspector(opts ...oci.ClientOpts) *registryInspector {
+	return &registryInspector{
+		client: oci.NewClient(append([]oci.ClientOpts{oci.WithHTTPClient(&http.Client{Timeout: imageInspectTimeout})}, opts...)...),
+		cache:  lru.New(imageCacheSize),
+		now:    time.Now,
+	}
+}
+
+func (r *registryInspector) Labels(ctx context.Context, image string) (map[string]string, error) {
+	if !strings.Contains(image, "@") {
+		return nil, fmt.Errorf("image %s is not referenced by digest", image)
+	}
+
+	if cached, ok := r.cache.Get(image); ok {
+		res, _ := cached.(inspectResult)
+		if res.err == nil || r.now().Before(res.expires) {
+			return res.labels, res.err
+		}
+	}
+
+	labels, err := r.client.ImageLabels(ctx, image)
+
+	res := inspectResult{labels: labels, err: err}
+	if err != nil {
+		res.expires = r.now().Add(imageFailureTTL)
+	}
+
+	r.cache.Add(image, res)
+
+	return labels, err
+}
@@ -0,0 +1,75 @@
+//nolint:testpackage // Need to test the unexported registry inspector
+package releases
+
+import (
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/manifests/oci"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/registry"
+
+	. "github.com/onsi/gomega"
+)
+
+type countingTransport struct {
+	requests atomic.Int32
+}
+
+func (c *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	c.requests.Add(1)
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestRegistryInspectorLabels(t *testing.T) {
+	g := NewWithT(t)
+
+	reg := registry.New(t, registry.WithToken("anonymous"))
+
+	image, err := reg.PushImage("org/app", "v1", map[string]string{ImageRevisionLabel: "0123abc"})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	transport := countingTransport{}
+	now := time.Now()
+
+	inspector := newRegistryInspector(
+		oci.WithPlainHTTP(true),
+		oci.WithHTTPClient(&http.Client{Transport: &transport}),
+	)
+	inspector.now = func() time.Time { return now }
+
+	labels, err := inspector.Labels(t.Context(), image)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(labels).Should(HaveKeyWithValue(ImageRevisionLabel, "0123abc"))
+
+	// the labels of an image are cached
+	count := transport.requests.Load()
+	_, err = inspector.Labels(t.Context(), image)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(transport.requests.Load()).Should(Equal(count))
+
+	// the failures are cached until they expire
+	unknown := reg.Host() + "/org/app@sha256:0000"
+	_, err = inspector.Labels(t.Context(), unknown)
+	g.Expect(err).Should(MatchError(ContainSubstring("404")))
+
+	count = transport.requests.Load()
+	_, err = inspector.Labels(t.Context(), unknown)
+	g.Expect(err).Should(HaveOccurred())
+	g.Expect(transport.requests.Load()).Should(Equal(count))
+
+	now = now.Add(imageFailureTTL)
+	_, err = inspector.Labels(t.Context(), unknown)
+	g.Expect(err).Should(HaveOccurred())
+	g.Expect(transport.requests.Load()).Should(BeNumerically(">", count))
+
+	_, err = inspector.Labels(t.Context(), "quay.io/org/app:latest")
+	g.Expect(err).Should(MatchError(ContainSubstring("is not referenced by digest")))
+}
+
+func TestImageRevisionsDisabledByDefault(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(ImageRevisionsEnabled()).Should(BeFalse())
+}
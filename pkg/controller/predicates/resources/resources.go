@@ -64,6 +64,25 @@ func Deleted() predicate.Funcs {
 	}
 }
 
+// CreatedOrDeleted returns a predicate that only accepts create and delete events, to react to
+// the presence of objects rather than to their content.
+func CreatedOrDeleted() predicate.Funcs {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			return true
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return false
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return true
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return false
+		},
+	}
+}
+
 // Content predicates moved from original controller.
 var CMContentChangedPredicate = predicate.Funcs{
 	UpdateFunc: func(e event.UpdateEvent) bool {
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
@@ -65,6 +66,7 @@ type Reconciler struct {
 	instanceFactory          func() (common.PlatformObject, error)
 	conditionsManagerFactory func(common.ConditionsAccessor) *conditions.Manager
 	gvks                     map[schema.GroupVersionKind]gvkInfo
+	limiter                  concurrencyLimiter
 }
 
 // NewReconciler creates a new reconciler for the given type.
@@ -152,6 +154,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	l := log.FromContext(ctx)
 	l.Info("reconcile")
 
+	if err := r.limiter.acquire(ctx); err != nil {
+		return ctrl.Result{}, err
+	}
+	defer r.limiter.release()
+
 	res, err := r.instanceFactory()
 	if err != nil {
 		return ctrl.Result{}, err
@@ -184,9 +191,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 			return ctrl.Result{}, err
 		}
 
-		if err := r.apply(ctx, res); err != nil {
+		requeueAfter, err := r.apply(ctx, res)
+		if err != nil {
 			return ctrl.Result{}, err
 		}
+
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
 	}
 
 	return ctrl.Result{}, nil
@@ -263,7 +273,9 @@ func (r *Reconciler) delete(ctx context.Context, res common.PlatformObject) erro
 	return nil
 }
 
-func (r *Reconciler) apply(ctx context.Context, res common.PlatformObject) error {
+// apply runs the actions and updates the status of the resource, it returns the duration after
+// which the actions asked the resource to be reconciled again.
+func (r *Reconciler) apply(ctx context.Context, res common.PlatformObject) (time.Duration, error) {
 	l := log.FromContext(ctx)
 	l.Info("apply")
 
@@ -345,7 +357,7 @@ func (r *Reconciler) apply(ctx context.Context, res common.PlatformObject) error
 			err.Error(),
 		)
 
-		return fmt.Errorf("reconcile failed: %w", err)
+		return 0, fmt.Errorf("reconcile failed: %w", err)
 	}
 
 	if provisionErr != nil {
@@ -356,8 +368,8 @@ func (r *Reconciler) apply(ctx context.Context, res common.PlatformObject) error
 			provisionErr.Error(),
 		)
 
-		return fmt.Errorf("provisioning failed: %w", provisionErr)
+		return 0, fmt.Errorf("provisioning failed: %w", provisionErr)
 	}
 
-	return nil
+	return rr.RequeueAfter, nil
 }
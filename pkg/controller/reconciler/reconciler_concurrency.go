@@ -0,0 +1,98 @@
+package reconciler
+
+import (
+	"context"
+	"sync"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+)
+
+// The controllers are built with serviceApi.MaxReconcileConcurrency workers, the number of
+// reconciliations actually running concurrently is limited at runtime so that it can be changed
+// without restarting the operator.
+var (
+	concurrencyMu      sync.Mutex
+	concurrency        = 1
+	concurrencyChanged = make(chan struct{})
+)
+
+// SetMaxConcurrentReconciles sets the number of resources each controller reconciles
+// concurrently, clamped between 1 and serviceApi.MaxReconcileConcurrency.
+func SetMaxConcurrentReconciles(n int) {
+	n = min(max(n, 1), serviceApi.MaxReconcileConcurrency)
+
+	concurrencyMu.Lock()
+	defer concurrencyMu.Unlock()
+
+	if n == concurrency {
+		return
+	}
+
+	concurrency = n
+
+	// wake up the reconciliations waiting for a slot
+	close(concurrencyChanged)
+	concurrencyChanged = make(chan struct{})
+}
+
+// MaxConcurrentReconciles returns the number of resources each controller reconciles concurrently.
+func MaxConcurrentReconciles() int {
+	concurrencyMu.Lock()
+	defer concurrencyMu.Unlock()
+
+	return concurrency
+}
+
+func currentConcurrency() (int, <-chan struct{}) {
+	concurrencyMu.Lock()
+	defer concurrencyMu.Unlock()
+
+	return concurrency, concurrencyChanged
+}
+
+// concurrencyLimiter limits the number of reconciliations a controller runs concurrently.
+// The zero value is ready to use.
+type concurrencyLimiter struct {
+	mu       sync.Mutex
+	running  int
+	released chan struct{}
+}
+
+func (l *concurrencyLimiter) acquire(ctx context.Context) error {
+	for {
+		limit, changed := currentConcurrency()
+
+		l.mu.Lock()
+		if l.running < limit {
+			l.running++
+			l.mu.Unlock()
+
+			return nil
+		}
+
+		if l.released == nil {
+			l.released = make(chan struct{})
+		}
+		released := l.released
+		l.mu.Unlock()
+
+		select {
+		case <-released:
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (l *concurrencyLimiter) release() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.running--
+
+	if l.released != nil {
+		close(l.released)
+		l.released = nil
+	}
+}
@@ -0,0 +1,152 @@
+//nolint:testpackage
+package reconciler
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
+
+	. "github.com/onsi/gomega"
+)
+
+// setConcurrency sets the concurrency for the duration of the test.
+func setConcurrency(t *testing.T, n int) {
+	t.Helper()
+
+	previous := MaxConcurrentReconciles()
+	t.Cleanup(func() { SetMaxConcurrentReconciles(previous) })
+
+	SetMaxConcurrentReconciles(n)
+}
+
+// acquireAsync acquires a slot of the limiter in a goroutine, the returned channel receives the
+// result of the acquisition.
+func acquireAsync(ctx context.Context, l *concurrencyLimiter) <-chan error {
+	ch := make(chan error, 1)
+
+	go func() {
+		ch <- l.acquire(ctx)
+	}()
+
+	return ch
+}
+
+func TestSetMaxConcurrentReconciles(t *testing.T) {
+	g := NewWithT(t)
+
+	setConcurrency(t, 0)
+	g.Expect(MaxConcurrentReconciles()).Should(Equal(1))
+
+	SetMaxConcurrentReconciles(serviceApi.MaxReconcileConcurrency + 1)
+	g.Expect(MaxConcurrentReconciles()).Should(Equal(serviceApi.MaxReconcileConcurrency))
+
+	SetMaxConcurrentReconciles(2)
+	g.Expect(MaxConcurrentReconciles()).Should(Equal(2))
+}
+
+func TestConcurrencyLimiterContention(t *testing.T) {
+	g := NewWithT(t)
+
+	setConcurrency(t, 2)
+
+	l := concurrencyLimiter{}
+
+	var running, peak atomic.Int32
+	var wg sync.WaitGroup
+
+	for range 10 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if err := l.acquire(t.Context()); err != nil {
+				t.Error(err)
+				return
+			}
+			defer l.release()
+
+			n := running.Add(1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+
+			time.Sleep(5 * time.Millisecond)
+			running.Add(-1)
+		}()
+	}
+
+	wg.Wait()
+
+	g.Expect(peak.Load()).Should(BeEquivalentTo(2))
+	g.Expect(l.running).Should(BeZero())
+}
+
+func TestConcurrencyLimiterRelease(t *testing.T) {
+	g := NewWithT(t)
+
+	setConcurrency(t, 1)
+
+	l := concurrencyLimiter{}
+	g.Expect(l.acquire(t.Context())).Should(Succeed())
+
+	waiting := acquireAsync(t.Context(), &l)
+	g.Consistently(waiting).WithTimeout(50 * time.Millisecond).ShouldNot(Receive())
+
+	l.release()
+	g.Eventually(waiting).Should(Receive(BeNil()))
+}
+
+func TestConcurrencyLimiterContextCancellation(t *testing.T) {
+	g := NewWithT(t)
+
+	setConcurrency(t, 1)
+
+	l := concurrencyLimiter{}
+	g.Expect(l.acquire(t.Context())).Should(Succeed())
+
+	ctx, cancel := context.WithCancel(t.Context())
+	waiting := acquireAsync(ctx, &l)
+	g.Consistently(waiting).WithTimeout(50 * time.Millisecond).ShouldNot(Receive())
+
+	cancel()
+	g.Eventually(waiting).Should(Receive(MatchError(context.Canceled)))
+
+	// the cancelled acquisition doesn't hold a slot
+	l.release()
+	g.Expect(l.acquire(t.Context())).Should(Succeed())
+	g.Expect(l.running).Should(Equal(1))
+}
+
+func TestConcurrencyLimiterReload(t *testing.T) {
+	g := NewWithT(t)
+
+	setConcurrency(t, 1)
+
+	l := concurrencyLimiter{}
+	g.Expect(l.acquire(t.Context())).Should(Succeed())
+
+	waiting := acquireAsync(t.Context(), &l)
+	g.Consistently(waiting).WithTimeout(50 * time.Millisecond).ShouldNot(Receive())
+
+	// raising the limit wakes up the waiting reconciliation
+	SetMaxConcurrentReconciles(2)
+	g.Eventually(waiting).Should(Receive(BeNil()))
+
+	// lowering the limit makes the next reconciliation wait until enough slots are released
+	SetMaxConcurrentReconciles(1)
+
+	waiting = acquireAsync(t.Context(), &l)
+	l.release()
+	g.Consistently(waiting).WithTimeout(50 * time.Millisecond).ShouldNot(Receive())
+
+	l.release()
+	g.Eventually(waiting).Should(Receive(BeNil()))
+}
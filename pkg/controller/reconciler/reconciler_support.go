@@ -12,11 +12,13 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/api/common"
+	serviceApi "github.com/opendatahub-io/opendatahub-operator/v2/api/services/v1alpha1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/internal/controller/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/controller/actions"
@@ -233,7 +235,11 @@ func (b *ReconcilerBuilder[T]) Build(_ context.Context) (*Reconciler, error) {
 		return nil, fmt.Errorf("failed to create reconciler for component %s: %w", name, err)
 	}
 
-	c := ctrl.NewControllerManagedBy(b.mgr)
+	// the number of concurrent reconciliations is limited by the reconciler, see
+	// SetMaxConcurrentReconciles
+	c := ctrl.NewControllerManagedBy(b.mgr).WithOptions(controller.Options{
+		MaxConcurrentReconciles: serviceApi.MaxReconcileConcurrency,
+	})
 
 	// automatically add default predicates to the watched API if no
 	// predicates are provided
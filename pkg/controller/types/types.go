@@ -3,9 +3,13 @@ package types
 import (
 	"crypto/sha256"
 	"encoding/binary"
+	"encoding/json"
 	"fmt"
 	"io/fs"
+	"net/url"
 	"path"
+	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -47,6 +51,45 @@ type ManifestInfo struct {
 	Path       string
 	ContextDir string
 	SourcePath string
+
+	// Selector restricts the overlay to the platforms, clusters and specs it matches, the
+	// overlays action drops the manifests whose selector does not match. A nil selector
+	// always matches.
+	Selector *OverlaySelector
+}
+
+// OverlaySelector declares when an overlay applies, all the set conditions must match.
+type OverlaySelector struct {
+	// Platforms the overlay applies to.
+	Platforms []common.Platform
+	// CRDs that must be installed in the cluster.
+	CRDs []schema.GroupVersionKind
+	// FIPS, when set, requires the FIPS mode of the cluster to be enabled or disabled.
+	FIPS *bool
+	// SingleNode, when set, requires the cluster to be single-node or multi-node.
+	SingleNode *bool
+	// Expression is a CEL expression evaluated against the spec of the reconciled resource,
+	// bound to the spec variable, the overlay applies when it returns true, i.e.
+	// 'has(spec.devFlags) && size(spec.devFlags.manifests) > 0'.
+	Expression string
+}
+
+// WithSelector returns a copy of the ManifestInfo restricted by value.
+func (mi ManifestInfo) WithSelector(value OverlaySelector) ManifestInfo {
+	mi.Selector = &value
+	return mi
+}
+
+// PlatformOverlays returns the ManifestInfo built by fn for each platform, each one selected
+// on its platform only.
+func PlatformOverlays(fn func(common.Platform) ManifestInfo, platforms ...common.Platform) []ManifestInfo {
+	result := make([]ManifestInfo, 0, len(platforms))
+
+	for _, p := range platforms {
+		result = append(result, fn(p).WithSelector(OverlaySelector{Platforms: []common.Platform{p}}))
+	}
+
+	return result
 }
 
 func (mi ManifestInfo) String() string {
@@ -71,6 +114,96 @@ type TemplateInfo struct {
 	Annotations map[string]string
 }
 
+// HelmChartInfo is an unpacked Helm chart rendered by the helm render action, read from FS
+// if set or from the local filesystem otherwise.
+type HelmChartInfo struct {
+	FS   fs.FS
+	Path string
+
+	// ReleaseName is exposed to the templates as .Release.Name, it defaults to the name of
+	// the chart.
+	ReleaseName string
+	// Values override the default values of the chart, they are merged with the ones
+	// computed by the action.
+	Values map[string]any
+
+	Labels      map[string]string
+	Annotations map[string]string
+}
+
+// Schemes of the ManifestSource URIs, they select the engine rendering the manifests.
+const (
+	KustomizeScheme = "kustomize"
+	TemplateScheme  = "template"
+	YAMLScheme      = "yaml"
+	OCIScheme       = "oci"
+)
+
+// ManifestSource is a set of manifests rendered by the sources render action, the scheme of its
+// URI selects the rendering engine, i.e.:
+// - kustomize:///path/to/overlay
+// - template:///path/to/resource.tmpl.yaml
+// - yaml:///path/to/resources
+// - oci://registry.example.com/org/manifests:v1.0.0#path/in/artifact
+type ManifestSource interface {
+	URI() *url.URL
+}
+
+// KustomizeSource is a kustomize overlay, read from FS if set or from the filesystem of the
+// kustomize engine otherwise.
+type KustomizeSource struct {
+	ManifestInfo
+
+	FS fs.FS
+}
+
+func (s KustomizeSource) URI() *url.URL {
+	return &url.URL{Scheme: KustomizeScheme, Path: s.ManifestInfo.String()}
+}
+
+// TemplateSource is a set of Go templates matching the Path pattern in FS.
+type TemplateSource struct {
+	TemplateInfo
+}
+
+func (s TemplateSource) URI() *url.URL {
+	return &url.URL{Scheme: TemplateScheme, Path: s.Path}
+}
+
+// YAMLSource is a directory of plain YAML manifests in FS, or a single manifest.
+type YAMLSource struct {
+	FS   fs.FS
+	Path string
+
+	Labels      map[string]string
+	Annotations map[string]string
+}
+
+func (s YAMLSource) URI() *url.URL {
+	return &url.URL{Scheme: YAMLScheme, Path: s.Path}
+}
+
+// OCISource is an OCI artifact pulled from a registry. The manifests are read from Path in
+// the artifact, rendered with kustomize when Path holds a kustomization and as plain YAML
+// manifests otherwise. Reference should include a digest, the rendered manifests are cached
+// until the reference or the reconciled resource change.
+type OCISource struct {
+	// Reference is the artifact reference: registry/repository[:tag][@digest].
+	Reference string
+	Path      string
+	// PlainHTTP pulls the artifact over HTTP instead of HTTPS.
+	PlainHTTP bool
+
+	Labels      map[string]string
+	Annotations map[string]string
+}
+
+func (s OCISource) URI() *url.URL {
+	registry, repository, _ := strings.Cut(s.Reference, "/")
+
+	return &url.URL{Scheme: OCIScheme, Host: registry, Path: "/" + repository, Fragment: s.Path}
+}
+
 type ReconciliationRequest struct {
 	Client     client.Client
 	Controller Controller
@@ -78,28 +211,14 @@ type ReconciliationRequest struct {
 	Instance   common.PlatformObject
 	Release    common.Release
 	Manifests  []ManifestInfo
+	Templates  []TemplateInfo
+	Charts     []HelmChartInfo
+
+	// Sources are rendered by the sources render action, which dispatches each source to
+	// the engine selected by the scheme of its URI, so a component can mix kustomize
+	// overlays, templates, plain manifests and OCI artifacts with a single action.
+	Sources []ManifestSource
 
-	//
-	// TODO: unify templates and resources.
-	//
-	// Unfortunately, the kustomize APIs do not yet support a FileSystem that is
-	// backed by golang's fs.Fs so it is not simple to have a single abstraction
-	// for both the manifests types.
-	//
-	// it would be nice to have a structure like:
-	//
-	// struct {
-	//   FS  fs.FS
-	//   URI net.URL
-	// }
-	//
-	// where the URI could be something like:
-	// - kustomize:///path/to/overlay
-	// - template:///path/to/resource.tmpl.yaml
-	//
-	// and use the scheme as discriminator for the rendering engine
-	//
-	Templates []TemplateInfo
 	Resources []unstructured.Unstructured
 
 	// TODO: this has been added to reduce GC work and only run when
@@ -107,6 +226,19 @@ type ReconciliationRequest struct {
 	//       replaced with a better way of describing resources and
 	//       their origin
 	Generated bool
+
+	// RequeueAfter, when set by an action, reconciles the resource again after the given
+	// duration, to refresh a status that is not driven by watched resources. The shortest
+	// duration set by the actions is kept, see Requeue.
+	RequeueAfter time.Duration
+}
+
+// Requeue reconciles the resource again after the given duration, unless an action already
+// asked for a shorter one.
+func (rr *ReconciliationRequest) Requeue(after time.Duration) {
+	if rr.RequeueAfter == 0 || after < rr.RequeueAfter {
+		rr.RequeueAfter = after
+	}
 }
 
 // AddResources adds one or more resources to the ReconciliationRequest's Resources slice.
@@ -212,6 +344,28 @@ func Hash(rr *ReconciliationRequest) ([]byte, error) {
 			return nil, fmt.Errorf("failed to hash template: %w", err)
 		}
 	}
+	for i := range rr.Charts {
+		if _, err := hash.Write([]byte(rr.Charts[i].Path)); err != nil {
+			return nil, fmt.Errorf("failed to hash chart: %w", err)
+		}
+		if _, err := hash.Write([]byte(rr.Charts[i].ReleaseName)); err != nil {
+			return nil, fmt.Errorf("failed to hash chart release name: %w", err)
+		}
+
+		// the keys of the maps are sorted by the encoder
+		values, err := json.Marshal(rr.Charts[i].Values)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode chart values: %w", err)
+		}
+		if _, err := hash.Write(values); err != nil {
+			return nil, fmt.Errorf("failed to hash chart values: %w", err)
+		}
+	}
+	for i := range rr.Sources {
+		if _, err := hash.Write([]byte(rr.Sources[i].URI().String())); err != nil {
+			return nil, fmt.Errorf("failed to hash manifest source: %w", err)
+		}
+	}
 
 	return hash.Sum(nil), nil
 }
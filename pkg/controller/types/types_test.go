@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/blang/semver/v4"
 	"github.com/operator-framework/api/pkg/lib/version"
@@ -125,6 +126,21 @@ func TestReconciliationRequest_RemoveResources(t *testing.T) {
 	))
 }
 
+func TestReconciliationRequest_Requeue(t *testing.T) {
+	g := NewWithT(t)
+
+	rr := types.ReconciliationRequest{}
+
+	rr.Requeue(5 * time.Minute)
+	g.Expect(rr.RequeueAfter).Should(Equal(5 * time.Minute))
+
+	rr.Requeue(time.Minute)
+	g.Expect(rr.RequeueAfter).Should(Equal(time.Minute))
+
+	rr.Requeue(10 * time.Minute)
+	g.Expect(rr.RequeueAfter).Should(Equal(time.Minute))
+}
+
 func TestHash_WithNilDSCI(t *testing.T) {
 	g := NewWithT(t)
 
@@ -155,3 +171,35 @@ func TestHash_WithNilDSCI(t *testing.T) {
 	g.Expect(err).ToNot(HaveOccurred())
 	g.Expect(hash1).ToNot(BeEmpty())
 }
+
+func TestHash_Charts(t *testing.T) {
+	g := NewWithT(t)
+
+	instance := &v1alpha1.Dashboard{}
+	instance.SetName("test-dashboard")
+	instance.SetUID("test-uid-123")
+	instance.SetGeneration(1)
+
+	rr := types.ReconciliationRequest{
+		Instance: instance,
+		Charts: []types.HelmChartInfo{{
+			Path:   "charts/app",
+			Values: map[string]any{"replicas": 1, "image": map[string]any{"tag": "v1"}},
+		}},
+	}
+
+	hash, err := types.Hash(&rr)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	// the values are hashed regardless of the order of their keys
+	rr.Charts[0].Values = map[string]any{"image": map[string]any{"tag": "v1"}, "replicas": 1}
+	g.Expect(types.Hash(&rr)).Should(Equal(hash))
+
+	rr.Charts[0].Values = map[string]any{"replicas": 2, "image": map[string]any{"tag": "v1"}}
+	valuesHash, err := types.Hash(&rr)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(valuesHash).ShouldNot(Equal(hash))
+
+	rr.Charts[0].ReleaseName = "app"
+	g.Expect(types.Hash(&rr)).ShouldNot(Equal(valuesHash))
+}
@@ -6,6 +6,8 @@ import (
 
 	operatorv1 "github.com/openshift/api/operator/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
@@ -19,7 +21,8 @@ import (
 
 // CreateDefaultDSC creates a default instance of DSC.
 // Note: When the platform is not Managed, and a DSC instance already exists, the function doesn't re-create/update the resource.
-func CreateDefaultDSC(ctx context.Context, cli client.Client) error {
+// managementStates overrides the management state of the components, keyed by the fields of spec.components.
+func CreateDefaultDSC(ctx context.Context, cli client.Client, managementStates map[string]operatorv1.ManagementState) error {
 	// Set the default DSC name depending on the platform
 	releaseDataScienceCluster := &dscv2.DataScienceCluster{
 		TypeMeta: metav1.TypeMeta{
@@ -67,6 +70,9 @@ func CreateDefaultDSC(ctx context.Context, cli client.Client) error {
 			},
 		},
 	}
+	if err := SetManagementStates(releaseDataScienceCluster, managementStates); err != nil {
+		return fmt.Errorf("failed to set default management states: %w", err)
+	}
 	err := cluster.CreateWithRetry(ctx, cli, releaseDataScienceCluster) // 1 min timeout
 	if err != nil {
 		return fmt.Errorf("failed to create DataScienceCluster custom resource: %w", err)
@@ -74,6 +80,30 @@ func CreateDefaultDSC(ctx context.Context, cli client.Client) error {
 	return nil
 }
 
+// SetManagementStates sets the management state of the components of the DSC, keyed by the fields
+// of spec.components. It returns an error if a key is not a component of the DSC.
+func SetManagementStates(dsc *dscv2.DataScienceCluster, managementStates map[string]operatorv1.ManagementState) error {
+	if len(managementStates) == 0 {
+		return nil
+	}
+
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(dsc)
+	if err != nil {
+		return err
+	}
+
+	for name, state := range managementStates {
+		if _, found, err := unstructured.NestedMap(u, "spec", "components", name); err != nil || !found {
+			return fmt.Errorf("unknown component %q", name)
+		}
+		if err := unstructured.SetNestedField(u, string(state), "spec", "components", name, "managementState"); err != nil {
+			return err
+		}
+	}
+
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(u, dsc)
+}
+
 // CreateDefaultDSCI creates a default instance of DSCI
 // If there exists default-dsci instance already, it will not update DSCISpec on it.
 // Note: DSCI CR modifcations are not supported, as it is the initial prereq setting for the components.
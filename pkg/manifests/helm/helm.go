@@ -0,0 +1,26 @@
+package helm
+
+const (
+	ChartFileName  = "Chart.yaml"
+	ValuesFileName = "values.yaml"
+	TemplatesDir   = "templates"
+	ChartsDir      = "charts"
+
+	// DefaultKubeVersion is the Kubernetes version exposed to the charts through
+	// .Capabilities.KubeVersion, it matches the version of the client libraries.
+	DefaultKubeVersion = "v1.32.4"
+)
+
+func NewEngine(opts ...EngineOptsFn) *Engine {
+	e := Engine{
+		renderOpts: renderOpts{
+			kubeVersion: DefaultKubeVersion,
+		},
+	}
+
+	for _, fn := range opts {
+		fn(&e)
+	}
+
+	return &e
+}
@@ -0,0 +1,200 @@
+package helm
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path"
+	"slices"
+	"strings"
+
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/engine"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/kustomize/api/provider"
+	"sigs.k8s.io/kustomize/api/resmap"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/manifests/kustomize"
+)
+
+// notesFileName is the template of the usage notes of a chart, it is not a manifest.
+const notesFileName = "NOTES.txt"
+
+// Engine renders Helm charts on the client side, like helm template: there is no release
+// storage, lookup returns nothing and the hooks are rendered as regular resources. The
+// subcharts must be vendored in the charts directory of the chart.
+type Engine struct {
+	fs         fs.FS
+	renderOpts renderOpts
+}
+
+// Render renders the chart in path, read from the filesystem set with WithFS, or the one of
+// the engine, or the local filesystem.
+func (e *Engine) Render(chartPath string, opts ...RenderOptsFn) ([]unstructured.Unstructured, error) {
+	// poor man clone
+	ro := e.renderOpts
+	ro.values = maps.Clone(e.renderOpts.values)
+	ro.apiVersions = slices.Clone(e.renderOpts.apiVersions)
+	ro.postRender = slices.Clone(e.renderOpts.postRender)
+
+	for _, fn := range opts {
+		fn(&ro)
+	}
+
+	fsys := e.fs
+	if ro.fs != nil {
+		fsys = ro.fs
+	}
+	if fsys == nil {
+		fsys = os.DirFS(chartPath)
+		chartPath = "."
+	}
+
+	c, err := loadChart(fsys, path.Clean(chartPath))
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := e.render(c, &ro)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render chart %s: %w", c.Name(), err)
+	}
+
+	resMap, err := resmap.NewFactory(provider.NewDefaultDepProvider().GetResourceFactory()).NewResMapFromBytes(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode resources of chart %s: %w", c.Name(), err)
+	}
+
+	postRender := ro.postRender
+	if ro.namespace != "" {
+		postRender = append([]kustomize.RenderOptsFn{kustomize.WithNamespace(ro.namespace)}, postRender...)
+	}
+
+	return kustomize.PostRender(resMap, postRender...)
+}
+
+// render computes the values of the chart and of its enabled subcharts as helm template does,
+// executes their templates and returns the resulting documents.
+func (e *Engine) render(c *chart.Chart, ro *renderOpts) ([]byte, error) {
+	values := merge(ro.values, nil)
+
+	if err := chartutil.ProcessDependenciesWithMerge(c, values); err != nil {
+		return nil, fmt.Errorf("failed to process dependencies: %w", err)
+	}
+
+	releaseName := ro.releaseName
+	if releaseName == "" {
+		releaseName = c.Name()
+	}
+
+	kubeVersion, err := chartutil.ParseKubeVersion(ro.kubeVersion)
+	if err != nil {
+		return nil, fmt.Errorf("invalid kube version: %w", err)
+	}
+
+	caps := chartutil.Capabilities{
+		KubeVersion: *kubeVersion,
+		APIVersions: chartutil.VersionSet(ro.apiVersions),
+		HelmVersion: chartutil.DefaultCapabilities.HelmVersion,
+	}
+
+	renderValues, err := chartutil.ToRenderValues(c, values, chartutil.ReleaseOptions{
+		Name:      releaseName,
+		Namespace: ro.namespace,
+		Revision:  1,
+		IsInstall: true,
+	}, &caps)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute values: %w", err)
+	}
+
+	files, err := engine.Render(c, renderValues)
+	if err != nil {
+		return nil, err
+	}
+
+	var out strings.Builder
+
+	for _, name := range slices.Sorted(maps.Keys(files)) {
+		content := files[name]
+		if path.Base(name) == notesFileName || strings.TrimSpace(content) == "" {
+			continue
+		}
+
+		out.WriteString("---\n# Source: " + name + "\n")
+		out.WriteString(content)
+		out.WriteString("\n")
+	}
+
+	return []byte(out.String()), nil
+}
+
+// loadChart reads the chart in dir, along with its subcharts vendored in charts/, either
+// unpacked or packaged.
+func loadChart(fsys fs.FS, dir string) (*chart.Chart, error) {
+	if _, err := fs.Stat(fsys, path.Join(dir, ChartFileName)); err != nil {
+		return nil, fmt.Errorf("failed to read chart %s: %w", dir, err)
+	}
+
+	files := make([]*loader.BufferedFile, 0)
+
+	err := fs.WalkDir(fsys, dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		data, err := fs.ReadFile(fsys, p)
+		if err != nil {
+			return err
+		}
+
+		name := p
+		if dir != "." {
+			name = strings.TrimPrefix(p, dir+"/")
+		}
+
+		files = append(files, &loader.BufferedFile{Name: name, Data: data})
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read chart %s: %w", dir, err)
+	}
+
+	c, err := loader.LoadFiles(files)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load chart %s: %w", dir, err)
+	}
+
+	if c.Metadata.Type == "library" {
+		return nil, fmt.Errorf("invalid chart %s: library charts cannot be rendered", dir)
+	}
+
+	if err := validateDependencies(c); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// validateDependencies ensures the dependencies declared by a chart and its subcharts are
+// vendored, as the engine does not download charts.
+func validateDependencies(c *chart.Chart) error {
+	var errs []error
+
+	for _, d := range c.Metadata.Dependencies {
+		if !slices.ContainsFunc(c.Dependencies(), func(sub *chart.Chart) bool { return sub.Name() == d.Name }) {
+			errs = append(errs, fmt.Errorf("dependency %s of chart %s is missing from %s", d.Name, c.Name(), ChartsDir))
+		}
+	}
+
+	for _, sub := range c.Dependencies() {
+		errs = append(errs, validateDependencies(sub))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,23 @@
+package helm
+
+import (
+	"io/fs"
+)
+
+type EngineOptsFn func(engine *Engine)
+
+// WithEngineFS reads the charts from value, by default the charts are read from the local
+// filesystem.
+func WithEngineFS(value fs.FS) EngineOptsFn {
+	return func(engine *Engine) {
+		engine.fs = value
+	}
+}
+
+func WithEngineRenderOpts(values ...RenderOptsFn) EngineOptsFn {
+	return func(engine *Engine) {
+		for _, fn := range values {
+			fn(&engine.renderOpts)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package helm
+
+import (
+	"io/fs"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/manifests/kustomize"
+)
+
+type renderOpts struct {
+	fs          fs.FS
+	releaseName string
+	namespace   string
+	values      map[string]any
+	kubeVersion string
+	apiVersions []string
+	postRender  []kustomize.RenderOptsFn
+}
+
+type RenderOptsFn func(*renderOpts)
+
+// WithFS reads the chart from value instead of the filesystem of the engine.
+func WithFS(value fs.FS) RenderOptsFn {
+	return func(opts *renderOpts) {
+		opts.fs = value
+	}
+}
+
+// WithReleaseName sets .Release.Name, it defaults to the name of the chart.
+func WithReleaseName(value string) RenderOptsFn {
+	return func(opts *renderOpts) {
+		opts.releaseName = value
+	}
+}
+
+// WithNamespace sets .Release.Namespace and the namespace of the rendered resources.
+func WithNamespace(value string) RenderOptsFn {
+	return func(opts *renderOpts) {
+		opts.namespace = value
+	}
+}
+
+// WithValues sets values overriding the ones of the chart, as helm --values would. The values
+// set by successive calls are deep merged.
+func WithValues(values map[string]any) RenderOptsFn {
+	return func(opts *renderOpts) {
+		opts.values = merge(opts.values, values)
+	}
+}
+
+func WithKubeVersion(value string) RenderOptsFn {
+	return func(opts *renderOpts) {
+		opts.kubeVersion = value
+	}
+}
+
+// WithAPIVersions sets the group/versions and group/version/kinds reported as available by
+// .Capabilities.APIVersions.Has.
+func WithAPIVersions(values ...string) RenderOptsFn {
+	return func(opts *renderOpts) {
+		opts.apiVersions = append(opts.apiVersions, values...)
+	}
+}
+
+// WithPostRenderOpts applies kustomize transformations to the rendered resources.
+func WithPostRenderOpts(values ...kustomize.RenderOptsFn) RenderOptsFn {
+	return func(opts *renderOpts) {
+		opts.postRender = append(opts.postRender, values...)
+	}
+}
+
+func WithLabel(name string, value string) RenderOptsFn {
+	return WithPostRenderOpts(kustomize.WithLabel(name, value))
+}
+
+func WithLabels(values map[string]string) RenderOptsFn {
+	return WithPostRenderOpts(kustomize.WithLabels(values))
+}
+
+func WithAnnotation(name string, value string) RenderOptsFn {
+	return WithPostRenderOpts(kustomize.WithAnnotation(name, value))
+}
+
+func WithAnnotations(values map[string]string) RenderOptsFn {
+	return WithPostRenderOpts(kustomize.WithAnnotations(values))
+}
+
+func WithFilters(values ...kustomize.FilterFn) RenderOptsFn {
+	return WithPostRenderOpts(kustomize.WithFilters(values...))
+}
+
+// merge deep merges overrides into a copy of base, a nil override deletes the key.
+func merge(base map[string]any, overrides map[string]any) map[string]any {
+	result := make(map[string]any, len(base))
+
+	for k, v := range base {
+		if m, ok := v.(map[string]any); ok {
+			v = merge(m, nil)
+		}
+
+		result[k] = v
+	}
+
+	for k, v := range overrides {
+		if v == nil {
+			delete(result, k)
+			continue
+		}
+
+		bm, bok := result[k].(map[string]any)
+		om, ook := v.(map[string]any)
+
+		switch {
+		case bok && ook:
+			result[k] = merge(bm, om)
+		case ook:
+			result[k] = merge(om, nil)
+		default:
+			result[k] = v
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,347 @@
+package helm_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/rs/xid"
+	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/manifests/helm"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+
+	. "github.com/onsi/gomega"
+)
+
+const testChart = `
+apiVersion: v2
+name: test-chart
+version: 0.1.0
+appVersion: 1.2.3
+dependencies:
+- name: sub
+  version: 0.1.0
+  condition: sub.enabled
+- name: other
+  version: 0.1.0
+  alias: renamed
+`
+
+const testChartValues = `
+replicas: 1
+image:
+  repository: quay.io/org/app
+  tag: latest
+config:
+  key: value
+sub:
+  enabled: true
+global:
+  env: test
+`
+
+const testChartHelpers = `
+{{- define "test-chart.labels" -}}
+app.kubernetes.io/name: {{ .Chart.Name }}
+app.kubernetes.io/instance: {{ .Release.Name }}
+app.kubernetes.io/version: {{ .Chart.AppVersion | quote }}
+{{- end }}
+`
+
+const testChartDeployment = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: {{ .Release.Name }}
+  labels:
+    {{- include "test-chart.labels" . | nindent 4 }}
+spec:
+  replicas: {{ .Values.replicas }}
+  selector:
+    matchLabels:
+      app: {{ .Release.Name }}
+  template:
+    metadata:
+      labels:
+        app: {{ .Release.Name }}
+    spec:
+      containers:
+      - name: app
+        image: {{ printf "%s:%s" .Values.image.repository .Values.image.tag }}
+`
+
+const testChartConfigMap = `
+{{- if .Capabilities.APIVersions.Has "v1" }}
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Release.Name }}-config
+data:
+  {{- toYaml .Values.config | nindent 2 }}
+  namespace: {{ .Release.Namespace }}
+  kube: {{ .Capabilities.KubeVersion.Minor | quote }}
+  file: {{ .Files.Get "files/data.txt" | trim | quote }}
+  tpl: {{ tpl "{{ .Values.image.tag }}" . }}
+{{- end }}
+`
+
+const testSubChart = `
+apiVersion: v2
+name: sub
+version: 0.1.0
+`
+
+const testSubChartValues = `
+name: sub-default
+`
+
+const testSubChartConfigMap = `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Values.name }}
+data:
+  env: {{ .Values.global.env }}
+`
+
+const testOtherChart = `
+apiVersion: v2
+name: other
+version: 0.1.0
+`
+
+const testOtherChartConfigMap = `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: other-{{ .Values.suffix | default "none" }}
+`
+
+func testChartFS() fstest.MapFS {
+	return fstest.MapFS{
+		"chart/Chart.yaml":                               {Data: []byte(testChart)},
+		"chart/values.yaml":                              {Data: []byte(testChartValues)},
+		"chart/files/data.txt":                           {Data: []byte("some data\n")},
+		"chart/templates/_helpers.tpl":                   {Data: []byte(testChartHelpers)},
+		"chart/templates/deployment.yaml":                {Data: []byte(testChartDeployment)},
+		"chart/templates/configmap.yaml":                 {Data: []byte(testChartConfigMap)},
+		"chart/templates/NOTES.txt":                      {Data: []byte("{{ .Release.Name }} installed")},
+		"chart/charts/sub/Chart.yaml":                    {Data: []byte(testSubChart)},
+		"chart/charts/sub/values.yaml":                   {Data: []byte(testSubChartValues)},
+		"chart/charts/sub/templates/configmap.yaml":      {Data: []byte(testSubChartConfigMap)},
+		"chart/charts/other/Chart.yaml":                  {Data: []byte(testOtherChart)},
+		"chart/charts/other/templates/configmap.yaml":    {Data: []byte(testOtherChartConfigMap)},
+		"chart/charts/other/templates/_empty.tpl":        {Data: []byte(`{{- define "other.empty" }}{{ end }}`)},
+		"chart/charts/other/templates/disabled.yaml":     {Data: []byte("{{- if false }}\nkind: ConfigMap\n{{- end }}")},
+		"chart/charts/other/templates/tests/ignored.txt": {Data: []byte("")},
+	}
+}
+
+func TestEngine(t *testing.T) {
+	g := NewWithT(t)
+	ns := xid.New().String()
+
+	e := helm.NewEngine(
+		helm.WithEngineFS(testChartFS()),
+		helm.WithEngineRenderOpts(
+			helm.WithAPIVersions("v1", "apps/v1"),
+		),
+	)
+
+	r, err := e.Render(
+		"chart",
+		helm.WithReleaseName("foo"),
+		helm.WithNamespace(ns),
+		helm.WithValues(map[string]any{
+			"replicas": 3,
+			"image":    map[string]any{"tag": "v1.0.0"},
+			"config":   map[string]any{"other": "value"},
+			"renamed":  map[string]any{"suffix": "bar"},
+		}),
+		helm.WithLabel("platform.opendatahub.io/part-of", "foo"),
+		helm.WithAnnotation("platform.opendatahub.io/release", "1.2.3"),
+	)
+
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(r).Should(And(
+		HaveLen(4),
+		HaveEach(And(
+			jq.Match(`.metadata.namespace == "%s"`, ns),
+			jq.Match(`.metadata.labels."platform.opendatahub.io/part-of" == "foo"`),
+			jq.Match(`.metadata.annotations."platform.opendatahub.io/release" == "1.2.3"`),
+		)),
+		ContainElement(And(
+			jq.Match(`.kind == "Deployment"`),
+			jq.Match(`.metadata.name == "foo"`),
+			jq.Match(`.metadata.labels."app.kubernetes.io/name" == "test-chart"`),
+			jq.Match(`.metadata.labels."app.kubernetes.io/version" == "1.2.3"`),
+			jq.Match(`.spec.replicas == 3`),
+			jq.Match(`.spec.template.spec.containers[0].image == "quay.io/org/app:v1.0.0"`),
+		)),
+		ContainElement(And(
+			jq.Match(`.metadata.name == "foo-config"`),
+			jq.Match(`.data.key == "value"`),
+			jq.Match(`.data.other == "value"`),
+			jq.Match(`.data.namespace == "%s"`, ns),
+			jq.Match(`.data.kube == "32"`),
+			jq.Match(`.data.file == "some data"`),
+			jq.Match(`.data.tpl == "v1.0.0"`),
+		)),
+		ContainElement(And(
+			jq.Match(`.metadata.name == "sub-default"`),
+			jq.Match(`.data.env == "test"`),
+		)),
+		ContainElement(
+			jq.Match(`.metadata.name == "other-bar"`),
+		),
+	))
+}
+
+func TestEngineDisabledDependency(t *testing.T) {
+	g := NewWithT(t)
+
+	e := helm.NewEngine(helm.WithEngineFS(testChartFS()))
+
+	r, err := e.Render(
+		"chart",
+		helm.WithValues(map[string]any{
+			"sub": map[string]any{"enabled": false},
+		}),
+	)
+
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// the configmap of the chart is not rendered as v1 is not reported as available
+	g.Expect(r).Should(And(
+		HaveLen(2),
+		ContainElement(jq.Match(`.metadata.name == "test-chart"`)),
+		ContainElement(jq.Match(`.metadata.name == "other-none"`)),
+	))
+}
+
+func TestEngineFilters(t *testing.T) {
+	g := NewWithT(t)
+
+	e := helm.NewEngine(helm.WithEngineFS(testChartFS()))
+
+	r, err := e.Render(
+		"chart",
+		helm.WithFilters(func(nodes []*kyaml.RNode) ([]*kyaml.RNode, error) {
+			result := make([]*kyaml.RNode, 0, len(nodes))
+			for _, n := range nodes {
+				if n.GetKind() != "Deployment" {
+					result = append(result, n)
+				}
+			}
+
+			return result, nil
+		}),
+	)
+
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(r).Should(And(
+		HaveLen(2),
+		HaveEach(jq.Match(`.kind == "ConfigMap"`)),
+	))
+}
+
+// packageChart returns the files as a packaged chart, i.e. a gzipped tarball.
+func packageChart(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+
+	for name, data := range files {
+		err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0o600, Size: int64(len(data))})
+		NewWithT(t).Expect(err).NotTo(HaveOccurred())
+
+		_, err = tw.Write([]byte(data))
+		NewWithT(t).Expect(err).NotTo(HaveOccurred())
+	}
+
+	NewWithT(t).Expect(tw.Close()).To(Succeed())
+	NewWithT(t).Expect(gw.Close()).To(Succeed())
+
+	return buf.Bytes()
+}
+
+func TestEnginePackagedDependency(t *testing.T) {
+	g := NewWithT(t)
+
+	files := testChartFS()
+	for name := range files {
+		if strings.HasPrefix(name, "chart/charts/sub/") {
+			delete(files, name)
+		}
+	}
+
+	files["chart/charts/sub-0.1.0.tgz"] = &fstest.MapFile{Data: packageChart(t, map[string]string{
+		"sub/Chart.yaml":               testSubChart,
+		"sub/values.yaml":              testSubChartValues,
+		"sub/templates/configmap.yaml": testSubChartConfigMap,
+	})}
+
+	r, err := helm.NewEngine(helm.WithEngineFS(files)).Render("chart")
+
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(r).Should(ContainElement(And(
+		jq.Match(`.metadata.name == "sub-default"`),
+		jq.Match(`.data.env == "test"`),
+	)))
+}
+
+func TestEngineErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		files  fstest.MapFS
+		values map[string]any
+		err    string
+	}{
+		{
+			name:  "missing chart",
+			files: fstest.MapFS{},
+			err:   "failed to read chart",
+		},
+		{
+			name: "missing dependency",
+			files: fstest.MapFS{
+				"chart/Chart.yaml": {Data: []byte("apiVersion: v2\nname: chart\nversion: 0.1.0\ndependencies:\n- name: missing\n")},
+			},
+			err: "dependency missing of chart chart is missing",
+		},
+		{
+			name: "invalid packaged dependency",
+			files: fstest.MapFS{
+				"chart/Chart.yaml":       {Data: []byte("apiVersion: v2\nname: chart\nversion: 0.1.0\n")},
+				"chart/charts/sub.tgz":   {Data: []byte("")},
+				"chart/templates/a.yaml": {Data: []byte("")},
+			},
+			err: "error unpacking subchart sub.tgz",
+		},
+		{
+			name: "required value",
+			files: fstest.MapFS{
+				"chart/Chart.yaml":        {Data: []byte("apiVersion: v2\nname: chart\nversion: 0.1.0\n")},
+				"chart/templates/cm.yaml": {Data: []byte(`{{ required "name is required" .Values.name }}`)},
+			},
+			err: "name is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			_, err := helm.NewEngine(helm.WithEngineFS(tt.files)).Render("chart", helm.WithValues(tt.values))
+			g.Expect(err).Should(MatchError(ContainSubstring(tt.err)))
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/kustomize/api/krusty"
+	"sigs.k8s.io/kustomize/api/resmap"
 	"sigs.k8s.io/kustomize/kyaml/filesys"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
@@ -31,15 +32,37 @@ func (e *Engine) Render(path string, opts ...RenderOptsFn) ([]unstructured.Unstr
 		fn(&ro)
 	}
 
-	if !e.fs.Exists(filepath.Join(path, ro.kustomizationFileName)) {
+	fs := e.fs
+	if ro.fs != nil {
+		fs = ro.fs
+	}
+
+	if !fs.Exists(filepath.Join(path, ro.kustomizationFileName)) {
 		path = filepath.Join(path, ro.kustomizationFileOverlay)
 	}
 
-	resMap, err := e.k.Run(e.fs, path)
+	resMap, err := e.k.Run(fs, path)
 	if err != nil {
 		return nil, err
 	}
 
+	return postRender(resMap, &ro)
+}
+
+// PostRender applies the namespace, labels, annotations and plugins set by opts to the
+// resources of resMap and converts them to Unstructured, so engines rendering manifests
+// by other means can reuse the kustomize transformations.
+func PostRender(resMap resmap.ResMap, opts ...RenderOptsFn) ([]unstructured.Unstructured, error) {
+	ro := renderOpts{}
+
+	for _, fn := range opts {
+		fn(&ro)
+	}
+
+	return postRender(resMap, &ro)
+}
+
+func postRender(resMap resmap.ResMap, ro *renderOpts) ([]unstructured.Unstructured, error) {
 	if ro.ns != "" {
 		plugin := plugins.CreateNamespaceApplierPlugin(ro.ns)
 		if err := plugin.Transform(resMap); err != nil {
@@ -2,6 +2,7 @@ package kustomize
 
 import (
 	"sigs.k8s.io/kustomize/api/resmap"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
 	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
 )
 
@@ -14,6 +15,7 @@ type renderOpts struct {
 	labels                   map[string]string
 	annotations              map[string]string
 	plugins                  []resmap.Transformer
+	fs                       filesys.FileSystem
 }
 
 type RenderOptsFn func(*renderOpts)
@@ -30,6 +32,13 @@ func WithKustomizationOverlayPath(value string) RenderOptsFn {
 	}
 }
 
+// WithFS renders the manifests from value instead of the filesystem of the engine.
+func WithFS(value filesys.FileSystem) RenderOptsFn {
+	return func(opts *renderOpts) {
+		opts.fs = value
+	}
+}
+
 func WithNamespace(value string) RenderOptsFn {
 	return func(opts *renderOpts) {
 		opts.ns = value
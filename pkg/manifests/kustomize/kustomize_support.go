@@ -1,7 +1,11 @@
 package kustomize
 
 import (
+	"fmt"
+	"io/fs"
+
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
 	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
 )
 
@@ -14,3 +18,31 @@ func NodeToUnstructured(n *kyaml.RNode) unstructured.Unstructured {
 
 	return u
 }
+
+// ToFileSystem copies fsys to an in-memory filesystem, the kustomize APIs do not support fs.FS.
+func ToFileSystem(fsys fs.FS) (filesys.FileSystem, error) {
+	result := filesys.MakeFsInMemory()
+
+	err := fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			return result.MkdirAll(path)
+		}
+
+		data, err := fs.ReadFile(fsys, path)
+		if err != nil {
+			return err
+		}
+
+		return result.WriteFile(path, data)
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy manifests: %w", err)
+	}
+
+	return result, nil
+}
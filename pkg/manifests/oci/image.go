@@ -0,0 +1,98 @@
+package oci
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"runtime"
+	"strings"
+)
+
+const (
+	MediaTypeImageIndex         = "application/vnd.oci.image.index.v1+json"
+	MediaTypeDockerManifestList = "application/vnd.docker.distribution.manifest.list.v2+json"
+
+	// DockerHubRegistry is the registry of the images named without registry.
+	DockerHubRegistry = "registry-1.docker.io"
+)
+
+var imageMediaTypes = strings.Join([]string{
+	MediaTypeImageIndex,
+	MediaTypeDockerManifestList,
+	MediaTypeImageManifest,
+	MediaTypeDockerManifest,
+}, ",")
+
+type imageConfig struct {
+	Config struct {
+		Labels map[string]string `json:"Labels"`
+	} `json:"config"`
+}
+
+// ParseImageReference parses the reference of a container image, following the conventions of
+// the container runtimes for the images of Docker Hub.
+func ParseImageReference(value string) (Reference, error) {
+	registry, rest, found := strings.Cut(value, "/")
+	if !found || (!strings.ContainsAny(registry, ".:") && registry != "localhost") {
+		registry, rest = DockerHubRegistry, value
+	}
+
+	if registry == "docker.io" {
+		registry = DockerHubRegistry
+	}
+
+	if registry == DockerHubRegistry && !strings.Contains(rest, "/") {
+		rest = "library/" + rest
+	}
+
+	return ParseReference(registry + "/" + rest)
+}
+
+// ImageLabels returns the labels of the configuration of a container image. The labels of a
+// multi-platform image are read from the image of the platform of the operator, or from the
+// first image of its index.
+func (c *Client) ImageLabels(ctx context.Context, reference string) (map[string]string, error) {
+	ref, err := ParseImageReference(reference)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := c.manifest(ctx, ref, ref.Version(), imageMediaTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(m.Manifests) > 0 {
+		d := m.Manifests[0]
+		for _, candidate := range m.Manifests {
+			if candidate.Platform != nil && candidate.Platform.OS == runtime.GOOS && candidate.Platform.Architecture == runtime.GOARCH {
+				d = candidate
+				break
+			}
+		}
+
+		if m, err = c.manifest(ctx, ref, d.Digest, imageMediaTypes); err != nil {
+			return nil, err
+		}
+	}
+
+	if m.Config.Digest == "" {
+		return nil, fmt.Errorf("manifest of image %s has no configuration", ref)
+	}
+
+	blob, err := c.fetch(ctx, ref, "blobs/"+m.Config.Digest, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch configuration of %s: %w", ref, err)
+	}
+
+	if err := verify(blob, m.Config.Digest); err != nil {
+		return nil, fmt.Errorf("invalid configuration of %s: %w", ref, err)
+	}
+
+	cfg := imageConfig{}
+	if err := json.Unmarshal(blob, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to decode configuration of %s: %w", ref, err)
+	}
+
+	return cfg.Config.Labels, nil
+}
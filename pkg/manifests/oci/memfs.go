@@ -0,0 +1,173 @@
+package oci
+
+import (
+	"bytes"
+	"io"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+	"time"
+)
+
+// memFS is a read-only in-memory fs.FS holding the files of an artifact by path, the
+// directories are implied by the paths of the files.
+type memFS map[string][]byte
+
+var (
+	_ fs.ReadDirFS  = memFS{}
+	_ fs.ReadFileFS = memFS{}
+)
+
+func (m memFS) Open(name string) (fs.File, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+
+	if data, ok := m[name]; ok {
+		return &memFile{info: memFileInfo{name: path.Base(name), size: int64(len(data))}, r: bytes.NewReader(data)}, nil
+	}
+
+	entries, ok := m.entries(name)
+	if !ok {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+
+	return &memDir{info: memFileInfo{name: path.Base(name), dir: true}, entries: entries}, nil
+}
+
+func (m memFS) ReadFile(name string) ([]byte, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "read", Path: name, Err: fs.ErrInvalid}
+	}
+
+	data, ok := m[name]
+	if !ok {
+		return nil, &fs.PathError{Op: "read", Path: name, Err: fs.ErrNotExist}
+	}
+
+	return slices.Clone(data), nil
+}
+
+func (m memFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrInvalid}
+	}
+
+	entries, ok := m.entries(name)
+	if !ok {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrNotExist}
+	}
+
+	return entries, nil
+}
+
+// entries returns the sorted entries of the directory name, false if it is not a directory.
+func (m memFS) entries(name string) ([]fs.DirEntry, bool) {
+	prefix := ""
+	if name != "." {
+		prefix = name + "/"
+	}
+
+	children := map[string]memFileInfo{}
+
+	for file, data := range m {
+		rel, ok := strings.CutPrefix(file, prefix)
+		if !ok {
+			continue
+		}
+
+		child, _, nested := strings.Cut(rel, "/")
+		if nested {
+			children[child] = memFileInfo{name: child, dir: true}
+		} else {
+			children[child] = memFileInfo{name: child, size: int64(len(data))}
+		}
+	}
+
+	if len(children) == 0 && name != "." {
+		return nil, false
+	}
+
+	entries := make([]fs.DirEntry, 0, len(children))
+	for _, child := range children {
+		entries = append(entries, child)
+	}
+
+	slices.SortFunc(entries, func(a, b fs.DirEntry) int {
+		return strings.Compare(a.Name(), b.Name())
+	})
+
+	return entries, true
+}
+
+// memFileInfo describes a file or a directory of a memFS, it is also its directory entry.
+type memFileInfo struct {
+	name string
+	size int64
+	dir  bool
+}
+
+func (i memFileInfo) Name() string               { return i.name }
+func (i memFileInfo) Size() int64                { return i.size }
+func (i memFileInfo) ModTime() time.Time         { return time.Time{} }
+func (i memFileInfo) IsDir() bool                { return i.dir }
+func (i memFileInfo) Sys() any                   { return nil }
+func (i memFileInfo) Type() fs.FileMode          { return i.Mode().Type() }
+func (i memFileInfo) Info() (fs.FileInfo, error) { return i, nil }
+
+func (i memFileInfo) Mode() fs.FileMode {
+	if i.dir {
+		return fs.ModeDir | 0o555
+	}
+
+	return 0o444
+}
+
+type memFile struct {
+	info memFileInfo
+	r    *bytes.Reader
+}
+
+func (f *memFile) Stat() (fs.FileInfo, error) { return f.info, nil }
+func (f *memFile) Read(b []byte) (int, error) { return f.r.Read(b) }
+func (f *memFile) Close() error               { return nil }
+
+func (f *memFile) Seek(offset int64, whence int) (int64, error) {
+	return f.r.Seek(offset, whence)
+}
+
+func (f *memFile) ReadAt(b []byte, offset int64) (int, error) {
+	return f.r.ReadAt(b, offset)
+}
+
+type memDir struct {
+	info    memFileInfo
+	entries []fs.DirEntry
+	offset  int
+}
+
+func (d *memDir) Stat() (fs.FileInfo, error) { return d.info, nil }
+func (d *memDir) Close() error               { return nil }
+
+func (d *memDir) Read(_ []byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: d.info.name, Err: fs.ErrInvalid}
+}
+
+func (d *memDir) ReadDir(n int) ([]fs.DirEntry, error) {
+	remaining := d.entries[d.offset:]
+
+	if n <= 0 {
+		d.offset = len(d.entries)
+		return slices.Clone(remaining), nil
+	}
+
+	if len(remaining) == 0 {
+		return nil, io.EOF
+	}
+
+	n = min(n, len(remaining))
+	d.offset += n
+
+	return slices.Clone(remaining[:n]), nil
+}
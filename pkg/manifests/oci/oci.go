@@ -0,0 +1,415 @@
+package oci
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+)
+
+const (
+	MediaTypeImageManifest  = "application/vnd.oci.image.manifest.v1+json"
+	MediaTypeDockerManifest = "application/vnd.docker.distribution.manifest.v2+json"
+	MediaTypeLayerTar       = "application/vnd.oci.image.layer.v1.tar"
+	MediaTypeLayerTarGzip   = "application/vnd.oci.image.layer.v1.tar+gzip"
+	MediaTypeDockerLayer    = "application/vnd.docker.image.rootfs.diff.tar.gzip"
+
+	// AnnotationTitle is the name of a file pushed as a layer, i.e. with oras push.
+	AnnotationTitle = "org.opencontainers.image.title"
+
+	// MaxArtifactSize is the maximum size of the files of an artifact.
+	MaxArtifactSize = 64 << 20
+
+	defaultTag = "latest"
+)
+
+var challengeParamRegexp = regexp.MustCompile(`(\w+)=(?:"([^"]*)"|([^,\s]*))`)
+
+// Reference identifies an artifact in a registry.
+type Reference struct {
+	Registry   string
+	Repository string
+	Tag        string
+	Digest     string
+}
+
+// ParseReference parses registry/repository[:tag][@digest], the tag defaults to latest when
+// neither a tag nor a digest are set.
+func ParseReference(value string) (Reference, error) {
+	ref := Reference{}
+
+	registry, rest, found := strings.Cut(value, "/")
+	if !found || registry == "" || rest == "" {
+		return ref, fmt.Errorf("invalid reference %q: missing registry or repository", value)
+	}
+
+	ref.Registry = registry
+
+	if name, digest, found := strings.Cut(rest, "@"); found {
+		rest = name
+		ref.Digest = digest
+	}
+
+	if i := strings.LastIndex(rest, ":"); i != -1 {
+		ref.Tag = rest[i+1:]
+		rest = rest[:i]
+	}
+
+	ref.Repository = rest
+
+	if ref.Repository == "" {
+		return ref, fmt.Errorf("invalid reference %q: missing repository", value)
+	}
+	if ref.Tag == "" && ref.Digest == "" {
+		ref.Tag = defaultTag
+	}
+
+	return ref, nil
+}
+
+// Version returns the digest of the reference, or its tag when there is no digest.
+func (r Reference) Version() string {
+	if r.Digest != "" {
+		return r.Digest
+	}
+
+	return r.Tag
+}
+
+func (r Reference) String() string {
+	result := r.Registry + "/" + r.Repository
+	if r.Tag != "" {
+		result += ":" + r.Tag
+	}
+	if r.Digest != "" {
+		result += "@" + r.Digest
+	}
+
+	return result
+}
+
+type descriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	Platform    *platform         `json:"platform,omitempty"`
+}
+
+type platform struct {
+	Architecture string `json:"architecture"`
+	OS           string `json:"os"`
+}
+
+// manifest is an image manifest, or an image index listing the manifests of a multi-platform
+// image.
+type manifest struct {
+	MediaType string       `json:"mediaType"`
+	Config    descriptor   `json:"config"`
+	Layers    []descriptor `json:"layers"`
+	Manifests []descriptor `json:"manifests"`
+}
+
+// Client pulls artifacts from a registry implementing the OCI distribution API.
+type Client struct {
+	httpClient *http.Client
+	plainHTTP  bool
+	username   string
+	password   string
+}
+
+type ClientOpts func(*Client)
+
+func WithHTTPClient(value *http.Client) ClientOpts {
+	return func(c *Client) {
+		c.httpClient = value
+	}
+}
+
+func WithPlainHTTP(value bool) ClientOpts {
+	return func(c *Client) {
+		c.plainHTTP = value
+	}
+}
+
+func WithCredentials(username string, password string) ClientOpts {
+	return func(c *Client) {
+		c.username = username
+		c.password = password
+	}
+}
+
+func NewClient(opts ...ClientOpts) *Client {
+	c := Client{
+		httpClient: http.DefaultClient,
+	}
+
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	return &c
+}
+
+// Pull downloads the artifact and returns its files. The tar layers are extracted, the other
+// layers are stored as a file named after their title annotation.
+func (c *Client) Pull(ctx context.Context, reference string) (fs.FS, error) {
+	ref, err := ParseReference(reference)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := c.manifest(ctx, ref, ref.Version(), MediaTypeImageManifest+","+MediaTypeDockerManifest)
+	if err != nil {
+		return nil, err
+	}
+
+	// the artifacts are small enough to be kept in memory
+	files := memFS{}
+	size := int64(0)
+
+	for _, layer := range m.Layers {
+		size += layer.Size
+		if size > MaxArtifactSize {
+			return nil, fmt.Errorf("artifact %s exceeds %d bytes", ref, MaxArtifactSize)
+		}
+
+		blob, err := c.fetch(ctx, ref, "blobs/"+layer.Digest, "")
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch layer %s of %s: %w", layer.Digest, ref, err)
+		}
+
+		if err := verify(blob, layer.Digest); err != nil {
+			return nil, fmt.Errorf("invalid layer %s of %s: %w", layer.Digest, ref, err)
+		}
+
+		if err := extract(files, layer, blob); err != nil {
+			return nil, fmt.Errorf("failed to extract layer %s of %s: %w", layer.Digest, ref, err)
+		}
+	}
+
+	return files, nil
+}
+
+// manifest fetches the manifest of the given version of the reference, verified when the
+// version is a digest.
+func (c *Client) manifest(ctx context.Context, ref Reference, version string, accept string) (manifest, error) {
+	m := manifest{}
+
+	data, err := c.fetch(ctx, ref, "manifests/"+version, accept)
+	if err != nil {
+		return m, fmt.Errorf("failed to fetch manifest %s of %s: %w", version, ref, err)
+	}
+
+	// tags cannot contain a colon, unlike digests
+	if strings.Contains(version, ":") {
+		if err := verify(data, version); err != nil {
+			return m, fmt.Errorf("invalid manifest %s of %s: %w", version, ref, err)
+		}
+	}
+
+	if err := json.Unmarshal(data, &m); err != nil {
+		return m, fmt.Errorf("failed to decode manifest %s of %s: %w", version, ref, err)
+	}
+
+	return m, nil
+}
+
+func (c *Client) fetch(ctx context.Context, ref Reference, resource string, accept string) ([]byte, error) {
+	scheme := "https"
+	if c.plainHTTP {
+		scheme = "http"
+	}
+
+	u := url.URL{
+		Scheme: scheme,
+		Host:   ref.Registry,
+		Path:   path.Join("/v2", ref.Repository, resource),
+	}
+
+	resp, err := c.get(ctx, u.String(), accept, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		_ = resp.Body.Close()
+
+		token, err := c.token(ctx, challenge)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err = c.get(ctx, u.String(), accept, token)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	return io.ReadAll(io.LimitReader(resp.Body, MaxArtifactSize+1))
+}
+
+func (c *Client) get(ctx context.Context, u string, accept string, authorization string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+
+	switch {
+	case authorization != "":
+		req.Header.Set("Authorization", authorization)
+	case c.username != "":
+		req.SetBasicAuth(c.username, c.password)
+	}
+
+	return c.httpClient.Do(req)
+}
+
+// token requests a bearer token as described by the WWW-Authenticate challenge of the registry.
+func (c *Client) token(ctx context.Context, challenge string) (string, error) {
+	scheme, params, _ := strings.Cut(challenge, " ")
+	if !strings.EqualFold(scheme, "Bearer") {
+		return "", fmt.Errorf("unsupported authentication challenge %q", challenge)
+	}
+
+	values := url.Values{}
+	realm := ""
+
+	// the values are matched rather than split on commas, since a scope can hold several
+	// comma separated actions
+	for _, m := range challengeParamRegexp.FindAllStringSubmatch(params, -1) {
+		k, v := m[1], m[2]+m[3]
+		if k == "realm" {
+			realm = v
+		} else {
+			values.Set(k, v)
+		}
+	}
+
+	if realm == "" {
+		return "", fmt.Errorf("missing realm in authentication challenge %q", challenge)
+	}
+
+	resp, err := c.get(ctx, realm+"?"+values.Encode(), "", "")
+	if err != nil {
+		return "", err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to get token: unexpected status %s", resp.Status)
+	}
+
+	t := struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}{}
+
+	if err := json.NewDecoder(resp.Body).Decode(&t); err != nil {
+		return "", fmt.Errorf("failed to decode token: %w", err)
+	}
+
+	if t.Token == "" {
+		t.Token = t.AccessToken
+	}
+
+	return "Bearer " + t.Token, nil
+}
+
+func verify(data []byte, digest string) error {
+	algorithm, expected, found := strings.Cut(digest, ":")
+	if !found || algorithm != "sha256" {
+		return fmt.Errorf("unsupported digest %q", digest)
+	}
+
+	sum := sha256.Sum256(data)
+	if hex.EncodeToString(sum[:]) != expected {
+		return fmt.Errorf("digest mismatch, expected %s", digest)
+	}
+
+	return nil
+}
+
+func extract(files memFS, layer descriptor, blob []byte) error {
+	var r io.Reader = bytes.NewReader(blob)
+
+	switch layer.MediaType {
+	case MediaTypeLayerTarGzip, MediaTypeDockerLayer:
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return err
+		}
+
+		defer gz.Close()
+
+		r = gz
+	case MediaTypeLayerTar:
+	default:
+		name := layer.Annotations[AnnotationTitle]
+		if !fs.ValidPath(name) || name == "." {
+			return fmt.Errorf("unsupported layer media type %q without a valid title", layer.MediaType)
+		}
+
+		files[name] = blob
+
+		return nil
+	}
+
+	tr := tar.NewReader(r)
+	size := int64(0)
+
+	for {
+		h, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if h.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		name := path.Clean(strings.TrimPrefix(h.Name, "/"))
+		if !fs.ValidPath(name) {
+			return fmt.Errorf("invalid file name %q", h.Name)
+		}
+
+		size += h.Size
+		if size > MaxArtifactSize {
+			return fmt.Errorf("layer exceeds %d bytes", MaxArtifactSize)
+		}
+
+		data, err := io.ReadAll(io.LimitReader(tr, h.Size))
+		if err != nil {
+			return err
+		}
+
+		files[name] = data
+	}
+}
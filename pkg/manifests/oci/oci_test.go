@@ -0,0 +1,151 @@
+package oci_test
+
+import (
+	"io/fs"
+	"testing"
+	"testing/fstest"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/manifests/oci"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/registry"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestParseReference(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected oci.Reference
+		str      string
+		err      bool
+	}{
+		{
+			value:    "quay.io/org/manifests",
+			expected: oci.Reference{Registry: "quay.io", Repository: "org/manifests", Tag: "latest"},
+			str:      "quay.io/org/manifests:latest",
+		},
+		{
+			value:    "localhost:5000/manifests:v1.0.0",
+			expected: oci.Reference{Registry: "localhost:5000", Repository: "manifests", Tag: "v1.0.0"},
+		},
+		{
+			value:    "quay.io/org/manifests:v1@sha256:abcd",
+			expected: oci.Reference{Registry: "quay.io", Repository: "org/manifests", Tag: "v1", Digest: "sha256:abcd"},
+		},
+		{
+			value:    "quay.io/org/manifests@sha256:abcd",
+			expected: oci.Reference{Registry: "quay.io", Repository: "org/manifests", Digest: "sha256:abcd"},
+		},
+		{
+			value: "manifests",
+			err:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			g := NewWithT(t)
+
+			ref, err := oci.ParseReference(tt.value)
+			if tt.err {
+				g.Expect(err).Should(HaveOccurred())
+				return
+			}
+
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(ref).Should(Equal(tt.expected))
+
+			str := tt.str
+			if str == "" {
+				str = tt.value
+			}
+			g.Expect(ref.String()).Should(Equal(str))
+		})
+	}
+}
+
+func TestPull(t *testing.T) {
+	g := NewWithT(t)
+
+	reg := registry.New(t, registry.WithToken("secret"))
+
+	ref, err := reg.Push("org/manifests", "v1", map[string]string{
+		"manifests/cm.yaml":     "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: cm\n",
+		"manifests/ignored.txt": "ignored",
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	client := oci.NewClient(oci.WithPlainHTTP(true))
+
+	t.Run("by digest", func(t *testing.T) {
+		g := NewWithT(t)
+
+		files, err := client.Pull(t.Context(), ref)
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		data, err := fs.ReadFile(files, "manifests/cm.yaml")
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(string(data)).Should(ContainSubstring("name: cm"))
+
+		entries, err := fs.ReadDir(files, "manifests")
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(entries).Should(HaveLen(2))
+
+		g.Expect(fstest.TestFS(files, "manifests/cm.yaml", "manifests/ignored.txt")).Should(Succeed())
+	})
+
+	t.Run("by tag", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := client.Pull(t.Context(), reg.Host()+"/org/manifests:v1")
+		g.Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	t.Run("unknown tag", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := client.Pull(t.Context(), reg.Host()+"/org/manifests:v2")
+		g.Expect(err).Should(MatchError(ContainSubstring("404")))
+	})
+}
+
+func TestParseImageReference(t *testing.T) {
+	g := NewWithT(t)
+
+	ref, err := oci.ParseImageReference("quay.io/org/app@sha256:abcd")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(ref).Should(Equal(oci.Reference{Registry: "quay.io", Repository: "org/app", Digest: "sha256:abcd"}))
+
+	ref, err = oci.ParseImageReference("localhost:5000/app:v1")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(ref).Should(Equal(oci.Reference{Registry: "localhost:5000", Repository: "app", Tag: "v1"}))
+
+	ref, err = oci.ParseImageReference("docker.io/org/app")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(ref).Should(Equal(oci.Reference{Registry: oci.DockerHubRegistry, Repository: "org/app", Tag: "latest"}))
+
+	ref, err = oci.ParseImageReference("nginx@sha256:abcd")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(ref).Should(Equal(oci.Reference{Registry: oci.DockerHubRegistry, Repository: "library/nginx", Digest: "sha256:abcd"}))
+
+	ref, err = oci.ParseImageReference("org/app")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(ref).Should(Equal(oci.Reference{Registry: oci.DockerHubRegistry, Repository: "org/app", Tag: "latest"}))
+}
+
+func TestImageLabels(t *testing.T) {
+	g := NewWithT(t)
+
+	reg := registry.New(t, registry.WithToken("secret"))
+
+	ref, err := reg.PushImage("org/app", "v1", map[string]string{"org.opencontainers.image.revision": "0123abc"})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	client := oci.NewClient(oci.WithPlainHTTP(true))
+
+	labels, err := client.ImageLabels(t.Context(), ref)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(labels).Should(HaveKeyWithValue("org.opencontainers.image.revision", "0123abc"))
+
+	_, err = client.ImageLabels(t.Context(), reg.Host()+"/org/app@sha256:0000")
+	g.Expect(err).Should(MatchError(ContainSubstring("404")))
+}
@@ -0,0 +1,44 @@
+package template
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	gt "text/template"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+	templateutils "github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/template"
+)
+
+// Render executes the Go templates of fsys matching pattern with data, and decodes the
+// resulting manifests.
+func Render(decoder runtime.Decoder, fsys fs.FS, pattern string, data map[string]any) ([]unstructured.Unstructured, error) {
+	tmpl, err := gt.New("").Option("missingkey=error").Funcs(templateutils.TextTemplateFuncMap()).ParseFS(fsys, pattern)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template from: %w", err)
+	}
+
+	result := make([]unstructured.Unstructured, 0)
+
+	var buffer bytes.Buffer
+
+	for _, t := range tmpl.Templates() {
+		buffer.Reset()
+		err = t.Execute(&buffer, data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to execute template: %w", err)
+		}
+
+		u, err := resources.Decode(decoder, buffer.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode template: %w", err)
+		}
+
+		result = append(result, u...)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,52 @@
+package yaml
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+)
+
+// Render decodes the manifests of the .yaml and .yml files of fsys under root, or root
+// itself when it is a file. The files are read in lexical order.
+func Render(decoder runtime.Decoder, fsys fs.FS, root string) ([]unstructured.Unstructured, error) {
+	result := make([]unstructured.Unstructured, 0)
+
+	err := fs.WalkDir(fsys, root, func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		if ext := path.Ext(name); name != root && ext != ".yaml" && ext != ".yml" {
+			return nil
+		}
+
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return err
+		}
+
+		u, err := resources.Decode(decoder, data)
+		if err != nil {
+			return fmt.Errorf("failed to decode %s: %w", name, err)
+		}
+
+		result = append(result, u...)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to render manifests from %s: %w", root, err)
+	}
+
+	return result, nil
+}
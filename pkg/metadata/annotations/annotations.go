@@ -38,3 +38,19 @@ const ConnectionTypeProtocol = "opendatahub.io/connection-type-protocol"
 // ConnectionPath annotation for specifying the path under bucket(s3) to use for the connection.
 // TODO: extend to oci.
 const ConnectionPath = "opendatahub.io/connection-path"
+
+// NotebookInjections annotation records the resources injected into a Notebook by the injection
+// profiles of the Workbenches component, so that they can be removed when no longer needed.
+const NotebookInjections = "opendatahub.io/notebook-injections"
+
+// NotebookCullingIdleTimeout annotation overrides on a namespace the idle timeout of the notebook
+// culling policy of the Workbenches component, a zero duration disabling the culling.
+const NotebookCullingIdleTimeout = "opendatahub.io/notebook-culling-idle-timeout"
+
+// NotebookStopped annotation is set on a Notebook to stop its server, e.g. by the culler of the
+// notebook controller.
+const NotebookStopped = "kubeflow-resource-stopped"
+
+// NotebookLastActivity annotation records the last activity of a Notebook, as observed by the
+// culler of the notebook controller.
+const NotebookLastActivity = "notebooks.kubeflow.org/last-activity"
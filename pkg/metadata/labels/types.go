@@ -43,3 +43,19 @@ var ODH = struct {
 		return ODHAppPrefix + "/" + name
 	},
 }
+
+// NetworkPolicy holds the labels of the NetworkPolicies generated by the operator.
+var NetworkPolicy = struct {
+	Mode  string
+	Audit string
+}{
+	Mode:  "opendatahub.io/network-policy-mode",
+	Audit: "opendatahub.io/network-policy-audit",
+}
+
+// Monitoring holds the labels of the monitoring resources generated by the operator.
+var Monitoring = struct {
+	InstrumentationPolicy string
+}{
+	InstrumentationPolicy: "monitoring.opendatahub.io/instrumentation-policy",
+}
@@ -0,0 +1,169 @@
+package resources
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// readinessConditions are the condition types reporting the readiness of a workload, in order of
+// precedence.
+var readinessConditions = []string{"Ready", "Available"}
+
+// failureConditions are the condition types reporting a failure of a workload when true.
+var failureConditions = []string{"Degraded", "Failed", "Failure"}
+
+// Health is the result of the evaluation of the health of a workload.
+type Health struct {
+	// Status is True when the workload is ready, False when it is not and Unknown when its
+	// status does not report readiness information.
+	Status metav1.ConditionStatus
+	// Reason is a CamelCase reason for the status.
+	Reason string
+	// Message is a human readable explanation of the status.
+	Message string
+}
+
+// IsReady returns true if the workload is ready.
+func (h Health) IsReady() bool {
+	return h.Status == metav1.ConditionTrue
+}
+
+// EvaluateHealth evaluates the health of a workload from its status, without any knowledge of
+// its kind. The following sources are used, in order:
+//   - a true Degraded, Failed or Failure condition makes the workload not ready
+//   - the Ready or Available condition
+//   - the ready replicas compared to the desired replicas, as reported by Deployments and
+//     StatefulSets, or by operators using the status.scale sub-resource layout
+//
+// When the status is older than the spec, or has none of the sources above, the health is unknown.
+func EvaluateHealth(obj *unstructured.Unstructured) Health {
+	if obj == nil {
+		return Health{Status: metav1.ConditionUnknown, Reason: "NotFound", Message: "resource not found"}
+	}
+
+	generation := obj.GetGeneration()
+	observed, found, _ := unstructured.NestedInt64(obj.Object, "status", "observedGeneration")
+	if found && generation > 0 && observed < generation {
+		return Health{
+			Status:  metav1.ConditionUnknown,
+			Reason:  "NotObserved",
+			Message: fmt.Sprintf("generation %d not yet observed (observed: %d)", generation, observed),
+		}
+	}
+
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+
+	for _, t := range failureConditions {
+		if c, ok := findCondition(conditions, t); ok && c.Status == metav1.ConditionTrue {
+			return healthFromCondition(c, metav1.ConditionFalse)
+		}
+	}
+
+	for _, t := range readinessConditions {
+		if c, ok := findCondition(conditions, t); ok {
+			return healthFromCondition(c, c.Status)
+		}
+	}
+
+	if desired, ready, ok := replicas(obj); ok {
+		if ready >= desired {
+			return Health{
+				Status:  metav1.ConditionTrue,
+				Reason:  "ReplicasReady",
+				Message: fmt.Sprintf("%d/%d replicas ready", ready, desired),
+			}
+		}
+
+		return Health{
+			Status:  metav1.ConditionFalse,
+			Reason:  "ReplicasNotReady",
+			Message: fmt.Sprintf("%d/%d replicas ready", ready, desired),
+		}
+	}
+
+	return Health{
+		Status:  metav1.ConditionUnknown,
+		Reason:  "NoReadinessInformation",
+		Message: "the resource status does not report readiness",
+	}
+}
+
+func findCondition(conditions []any, conditionType string) (metav1.Condition, bool) {
+	for _, raw := range conditions {
+		c, ok := raw.(map[string]any)
+		if !ok || c["type"] != conditionType {
+			continue
+		}
+
+		status, _ := c["status"].(string)
+		reason, _ := c["reason"].(string)
+		message, _ := c["message"].(string)
+
+		return metav1.Condition{
+			Type:    conditionType,
+			Status:  metav1.ConditionStatus(status),
+			Reason:  reason,
+			Message: message,
+		}, true
+	}
+
+	return metav1.Condition{}, false
+}
+
+func healthFromCondition(c metav1.Condition, status metav1.ConditionStatus) Health {
+	if status == "" {
+		status = metav1.ConditionUnknown
+	}
+
+	reason := c.Reason
+	if reason == "" {
+		reason = c.Type
+	}
+
+	message := c.Message
+	if message == "" {
+		message = fmt.Sprintf("condition %s is %s", c.Type, c.Status)
+	}
+
+	return Health{Status: status, Reason: reason, Message: message}
+}
+
+// replicas returns the desired and ready replicas of a workload.
+func replicas(obj *unstructured.Unstructured) (int64, int64, bool) {
+	// status.scale.statusReplicas, e.g. OpenTelemetryCollector, reports "ready/desired"
+	if sr, found, _ := unstructured.NestedString(obj.Object, "status", "scale", "statusReplicas"); found {
+		ready, desired, ok := strings.Cut(sr, "/")
+		if !ok {
+			return 0, 0, false
+		}
+
+		r, err := strconv.ParseInt(ready, 10, 64)
+		if err != nil {
+			return 0, 0, false
+		}
+
+		d, err := strconv.ParseInt(desired, 10, 64)
+		if err != nil {
+			return 0, 0, false
+		}
+
+		return d, r, true
+	}
+
+	desired, found, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+	if !found {
+		return 0, 0, false
+	}
+
+	if _, found, _ := unstructured.NestedFieldNoCopy(obj.Object, "status"); !found {
+		return 0, 0, false
+	}
+
+	ready, _, _ := unstructured.NestedInt64(obj.Object, "status", "readyReplicas")
+
+	return desired, ready, true
+}
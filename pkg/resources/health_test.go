@@ -0,0 +1,119 @@
+package resources_test
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/resources"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestEvaluateHealth(t *testing.T) {
+	tests := []struct {
+		name   string
+		obj    map[string]any
+		status metav1.ConditionStatus
+		reason string
+	}{
+		{
+			name: "ready condition",
+			obj: map[string]any{
+				"status": map[string]any{
+					"conditions": []any{
+						map[string]any{"type": "Ready", "status": "True", "reason": "Ready"},
+					},
+				},
+			},
+			status: metav1.ConditionTrue,
+			reason: "Ready",
+		},
+		{
+			name: "available condition",
+			obj: map[string]any{
+				"status": map[string]any{
+					"conditions": []any{
+						map[string]any{"type": "Available", "status": "False", "reason": "PodsNotReady"},
+					},
+				},
+			},
+			status: metav1.ConditionFalse,
+			reason: "PodsNotReady",
+		},
+		{
+			name: "degraded condition wins over ready",
+			obj: map[string]any{
+				"status": map[string]any{
+					"conditions": []any{
+						map[string]any{"type": "Ready", "status": "True"},
+						map[string]any{"type": "Degraded", "status": "True", "reason": "StorageError"},
+					},
+				},
+			},
+			status: metav1.ConditionFalse,
+			reason: "StorageError",
+		},
+		{
+			name: "ready replicas",
+			obj: map[string]any{
+				"spec":   map[string]any{"replicas": int64(2)},
+				"status": map[string]any{"readyReplicas": int64(2)},
+			},
+			status: metav1.ConditionTrue,
+			reason: "ReplicasReady",
+		},
+		{
+			name: "missing replicas",
+			obj: map[string]any{
+				"spec":   map[string]any{"replicas": int64(2)},
+				"status": map[string]any{},
+			},
+			status: metav1.ConditionFalse,
+			reason: "ReplicasNotReady",
+		},
+		{
+			name: "scale status replicas",
+			obj: map[string]any{
+				"status": map[string]any{"scale": map[string]any{"statusReplicas": "1/2"}},
+			},
+			status: metav1.ConditionFalse,
+			reason: "ReplicasNotReady",
+		},
+		{
+			name: "generation not observed",
+			obj: map[string]any{
+				"metadata": map[string]any{"generation": int64(3)},
+				"status": map[string]any{
+					"observedGeneration": int64(2),
+					"conditions": []any{
+						map[string]any{"type": "Ready", "status": "True"},
+					},
+				},
+			},
+			status: metav1.ConditionUnknown,
+			reason: "NotObserved",
+		},
+		{
+			name:   "no status",
+			obj:    map[string]any{},
+			status: metav1.ConditionUnknown,
+			reason: "NoReadinessInformation",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			h := resources.EvaluateHealth(&unstructured.Unstructured{Object: tt.obj})
+			g.Expect(h.Status).Should(Equal(tt.status))
+			g.Expect(h.Reason).Should(Equal(tt.reason))
+			g.Expect(h.Message).ShouldNot(BeEmpty())
+		})
+	}
+
+	g := NewWithT(t)
+	g.Expect(resources.EvaluateHealth(nil).Status).Should(Equal(metav1.ConditionUnknown))
+}
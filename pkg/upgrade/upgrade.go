@@ -221,7 +221,7 @@ func MigrateToInfraHardwareProfiles(ctx context.Context, cli client.Client, appl
 	}
 
 	// Get OdhDashboardConfig to extract container sizes
-	odhConfig, found, err := getOdhDashboardConfig(ctx, cli, applicationNS)
+	odhConfig, found, err := GetOdhDashboardConfig(ctx, cli, applicationNS)
 	if err != nil {
 		return fmt.Errorf("failed to get OdhDashboardConfig: %w", err)
 	}
@@ -260,21 +260,9 @@ func MigrateAcceleratorProfilesToHardwareProfiles(ctx context.Context, cli clien
 		return nil
 	}
 
-	// Get notebooks-only toleration if applicable
-	notebooksOnlyToleration, err := getNotebooksOnlyToleration(odhConfig)
+	notebookContainerCounts, servingContainerCounts, notebooksOnlyToleration, err := acceleratorProfileMigrationSettings(odhConfig)
 	if err != nil {
-		return fmt.Errorf("failed to get notebooks-only toleration: %w", err)
-	}
-
-	// Calculate container resource limits
-	notebookContainerCounts, err := FindContainerCpuMemoryMinMaxCount(odhConfig, "notebookSizes")
-	if err != nil {
-		return fmt.Errorf("failed to calculate notebook container limits: %w", err)
-	}
-	// default min limits only for serving HardwareProfile, max limits are not set
-	servingContainerCounts := map[string]string{
-		"minMemory": "1Gi",
-		"minCpu":    "1",
+		return err
 	}
 
 	var multiErr *multierror.Error
@@ -297,6 +285,55 @@ func MigrateAcceleratorProfilesToHardwareProfiles(ctx context.Context, cli clien
 	return multiErr.ErrorOrNil()
 }
 
+// ConvertAcceleratorProfileToHardwareProfiles returns the notebooks and serving HardwareProfiles
+// MigrateAcceleratorProfilesToHardwareProfiles creates for the given AcceleratorProfile, without
+// creating them.
+func ConvertAcceleratorProfileToHardwareProfiles(
+	ctx context.Context,
+	ap unstructured.Unstructured,
+	odhConfig *unstructured.Unstructured,
+) ([]*infrav1.HardwareProfile, error) {
+	notebookContainerCounts, servingContainerCounts, notebooksOnlyToleration, err := acceleratorProfileMigrationSettings(odhConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	notebooksHwp, err := generateHardwareProfileFromAcceleratorProfile(ctx, ap, notebooks, notebookContainerCounts, notebooksOnlyToleration)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate notebooks HardwareProfile for AP %s: %w", ap.GetName(), err)
+	}
+
+	servingHwp, err := generateHardwareProfileFromAcceleratorProfile(ctx, ap, serving, servingContainerCounts, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate serving HardwareProfile for AP %s: %w", ap.GetName(), err)
+	}
+
+	return []*infrav1.HardwareProfile{notebooksHwp, servingHwp}, nil
+}
+
+// acceleratorProfileMigrationSettings returns the notebooks and serving container counts, and the
+// notebooks-only toleration, of the HardwareProfiles created from AcceleratorProfiles.
+func acceleratorProfileMigrationSettings(odhConfig *unstructured.Unstructured) (map[string]string, map[string]string, []corev1.Toleration, error) {
+	// Get notebooks-only toleration if applicable
+	notebooksOnlyToleration, err := getNotebooksOnlyToleration(odhConfig)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to get notebooks-only toleration: %w", err)
+	}
+
+	// Calculate container resource limits
+	notebookContainerCounts, err := FindContainerCpuMemoryMinMaxCount(odhConfig, "notebookSizes")
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to calculate notebook container limits: %w", err)
+	}
+	// default min limits only for serving HardwareProfile, max limits are not set
+	servingContainerCounts := map[string]string{
+		"minMemory": "1Gi",
+		"minCpu":    "1",
+	}
+
+	return notebookContainerCounts, servingContainerCounts, notebooksOnlyToleration, nil
+}
+
 // MigrateContainerSizesToHardwareProfiles migrates container sizes to HardwareProfiles
 // as described in RHOAIENG-33158. This creates 1 HardwareProfile for each container size.
 func MigrateContainerSizesToHardwareProfiles(ctx context.Context, cli client.Client, applicationNS string, odhConfig *unstructured.Unstructured) error {
@@ -0,0 +1,232 @@
+package registry
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/manifests/oci"
+)
+
+// Registry is an in-memory registry implementing the pull side of the OCI distribution API
+// over plain HTTP, to test the OCI manifest sources.
+type Registry struct {
+	server *httptest.Server
+	token  string
+
+	mu        sync.RWMutex
+	manifests map[string][]byte
+	blobs     map[string][]byte
+}
+
+type Opts func(*Registry)
+
+// WithToken requires the clients to authenticate with a bearer token, obtained anonymously
+// from the token endpoint of the registry.
+func WithToken(value string) Opts {
+	return func(r *Registry) {
+		r.token = value
+	}
+}
+
+// New starts a registry, stopped when the test ends.
+func New(t *testing.T, opts ...Opts) *Registry {
+	t.Helper()
+
+	r := Registry{
+		manifests: map[string][]byte{},
+		blobs:     map[string][]byte{},
+	}
+
+	for _, opt := range opts {
+		opt(&r)
+	}
+
+	r.server = httptest.NewServer(http.HandlerFunc(r.serve))
+	t.Cleanup(r.server.Close)
+
+	return &r
+}
+
+// Host returns the host of the registry, to be used in references.
+func (r *Registry) Host() string {
+	return strings.TrimPrefix(r.server.URL, "http://")
+}
+
+// Push stores an artifact made of a single tar+gzip layer holding files, and returns its
+// reference by digest.
+func (r *Registry) Push(repository string, tag string, files map[string]string) (string, error) {
+	var buf bytes.Buffer
+
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+
+	for _, name := range slices.Sorted(maps.Keys(files)) {
+		h := tar.Header{Name: name, Mode: 0o644, Size: int64(len(files[name])), Typeflag: tar.TypeReg}
+		if err := tw.WriteHeader(&h); err != nil {
+			return "", err
+		}
+		if _, err := tw.Write([]byte(files[name])); err != nil {
+			return "", err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return "", err
+	}
+	if err := gz.Close(); err != nil {
+		return "", err
+	}
+
+	layer := buf.Bytes()
+	layerDigest := digest(layer)
+
+	manifest, err := json.Marshal(map[string]any{
+		"schemaVersion": 2,
+		"mediaType":     oci.MediaTypeImageManifest,
+		"layers": []map[string]any{{
+			"mediaType": oci.MediaTypeLayerTarGzip,
+			"digest":    layerDigest,
+			"size":      len(layer),
+		}},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	manifestDigest := digest(manifest)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.blobs[layerDigest] = layer
+	r.manifests[repository+":"+tag] = manifest
+	r.manifests[repository+":"+manifestDigest] = manifest
+
+	return fmt.Sprintf("%s/%s:%s@%s", r.Host(), repository, tag, manifestDigest), nil
+}
+
+// PushImage stores a multi-platform image whose configuration holds labels, and returns its
+// reference by digest. The index lists the image for the platform of the test and an image for
+// another platform without labels.
+func (r *Registry) PushImage(repository string, tag string, labels map[string]string) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	image, err := r.storeImage(repository, labels)
+	if err != nil {
+		return "", err
+	}
+
+	other, err := r.storeImage(repository, nil)
+	if err != nil {
+		return "", err
+	}
+
+	index, err := json.Marshal(map[string]any{
+		"schemaVersion": 2,
+		"mediaType":     oci.MediaTypeImageIndex,
+		"manifests": []map[string]any{
+			{"digest": other, "platform": map[string]string{"os": runtime.GOOS, "architecture": "other"}},
+			{"digest": image, "platform": map[string]string{"os": runtime.GOOS, "architecture": runtime.GOARCH}},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	indexDigest := digest(index)
+
+	r.manifests[repository+":"+tag] = index
+	r.manifests[repository+":"+indexDigest] = index
+
+	return fmt.Sprintf("%s/%s@%s", r.Host(), repository, indexDigest), nil
+}
+
+// storeImage stores the manifest and the configuration of an image, and returns the digest of
+// the manifest.
+func (r *Registry) storeImage(repository string, labels map[string]string) (string, error) {
+	config, err := json.Marshal(map[string]any{
+		"architecture": runtime.GOARCH,
+		"os":           runtime.GOOS,
+		"config":       map[string]any{"Labels": labels},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	configDigest := digest(config)
+
+	manifest, err := json.Marshal(map[string]any{
+		"schemaVersion": 2,
+		"mediaType":     oci.MediaTypeImageManifest,
+		"config": map[string]any{
+			"mediaType": "application/vnd.oci.image.config.v1+json",
+			"digest":    configDigest,
+			"size":      len(config),
+		},
+		"layers": []map[string]any{},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	manifestDigest := digest(manifest)
+
+	r.blobs[configDigest] = config
+	r.manifests[repository+":"+manifestDigest] = manifest
+
+	return manifestDigest, nil
+}
+
+func (r *Registry) serve(w http.ResponseWriter, req *http.Request) {
+	if req.URL.Path == "/token" {
+		_ = json.NewEncoder(w).Encode(map[string]string{"token": r.token})
+		return
+	}
+
+	if r.token != "" && req.Header.Get("Authorization") != "Bearer "+r.token {
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="test"`, r.server.URL))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	path := strings.TrimPrefix(req.URL.Path, "/v2/")
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if i := strings.LastIndex(path, "/manifests/"); i != -1 {
+		if m, ok := r.manifests[path[:i]+":"+path[i+len("/manifests/"):]]; ok {
+			w.Header().Set("Content-Type", oci.MediaTypeImageManifest)
+			_, _ = w.Write(m)
+			return
+		}
+	}
+
+	if i := strings.LastIndex(path, "/blobs/"); i != -1 {
+		if b, ok := r.blobs[path[i+len("/blobs/"):]]; ok {
+			_, _ = w.Write(b)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNotFound)
+}
+
+func digest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,241 @@
+package scenario
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/onsi/gomega"
+	gomegaTypes "github.com/onsi/gomega/types"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/matchers/jq"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/testf"
+)
+
+type runOpts struct {
+	vars      map[string]string
+	withTOpts []testf.WithTOpts
+}
+
+// RunOpt configures the execution of a scenario.
+type RunOpt func(*runOpts)
+
+// WithVars sets the values of the variables, overriding the defaults of the scenario.
+func WithVars(vars map[string]string) RunOpt {
+	return func(o *runOpts) {
+		maps.Copy(o.vars, vars)
+	}
+}
+
+// WithVar sets the value of a variable, overriding the default of the scenario.
+func WithVar(name string, value string) RunOpt {
+	return func(o *runOpts) {
+		o.vars[name] = value
+	}
+}
+
+// WithTOptions configures the testf.WithT used to run the steps, e.g. the Eventually timeout.
+func WithTOptions(opts ...testf.WithTOpts) RunOpt {
+	return func(o *runOpts) {
+		o.withTOpts = append(o.withTOpts, opts...)
+	}
+}
+
+// Run executes the steps of the scenario as subtests of t, stopping at the first failing
+// step, then registers the cleanup steps to run when t completes.
+func (s *Scenario) Run(t *testing.T, tc *testf.TestContext, opts ...RunOpt) {
+	t.Helper()
+
+	ro := runOpts{vars: make(map[string]string, len(s.Vars))}
+	maps.Copy(ro.vars, s.Vars)
+
+	for _, opt := range opts {
+		opt(&ro)
+	}
+
+	if len(s.Cleanup) > 0 {
+		t.Cleanup(func() {
+			// subtests can't run during the cleanup, so the failures are reported without
+			// stopping, to give all the cleanup steps a chance to run
+			for _, step := range s.Cleanup {
+				cro := ro
+				cro.withTOpts = append(slices.Clone(ro.withTOpts), testf.WithFailHandler(func(message string, _ ...int) {
+					t.Errorf("cleanup step %q failed: %s", step.String(), message)
+				}))
+
+				runStep(t, tc, step, cro)
+			}
+		})
+	}
+
+	for _, step := range s.Steps {
+		if !t.Run(step.String(), func(t *testing.T) { runStep(t, tc, step, ro) }) {
+			return
+		}
+	}
+}
+
+func runStep(t *testing.T, tc *testf.TestContext, step Step, ro runOpts) {
+	t.Helper()
+
+	wt := tc.NewWithT(t, ro.withTOpts...)
+
+	step, err := expand(step, ro.vars)
+	wt.Expect(err).NotTo(gomega.HaveOccurred())
+
+	eventually := func(ev *testf.EventuallyValue[*unstructured.Unstructured]) *testf.Assertion[*unstructured.Unstructured] {
+		a := ev.Eventually()
+		if step.Timeout != nil {
+			a = a.WithTimeout(step.Timeout.Duration)
+		}
+		return a
+	}
+
+	switch {
+	case step.Apply != nil:
+		obj := unstructured.Unstructured{Object: step.Apply.Object}
+		desired := obj.DeepCopy()
+
+		eventually(wt.CreateOrPatch(&obj, func(in *unstructured.Unstructured) error {
+			mergeInto(in.Object, desired.Object)
+			return nil
+		})).ShouldNot(gomega.BeNil())
+
+	case step.Patch != nil:
+		r := step.Patch.Resource
+
+		fn := testf.Transform("%s", step.Patch.JQ)
+		if step.Patch.JQ == "" {
+			fn = func(in *unstructured.Unstructured) error {
+				mergeInto(in.Object, step.Patch.Merge)
+				return nil
+			}
+		}
+
+		eventually(wt.Patch(r.GroupVersionKind(), r.NamespacedName(), fn)).ShouldNot(
+			gomega.BeNil(),
+			"%s not found", r,
+		)
+
+	case step.Delete != nil:
+		r := step.Delete.Resource
+
+		a := wt.Delete(r.GroupVersionKind(), r.NamespacedName()).Eventually()
+		if step.Timeout != nil {
+			a = a.WithTimeout(step.Timeout.Duration)
+		}
+
+		a.Should(gomega.Succeed())
+
+	case step.ExpectJQ != nil:
+		r := step.ExpectJQ.Resource
+
+		matchers := []gomegaTypes.GomegaMatcher{gomega.Not(gomega.BeNil())}
+		for _, e := range step.ExpectJQ.Expressions {
+			matchers = append(matchers, jq.Match("%s", e))
+		}
+
+		ev := wt.Get(r.GroupVersionKind(), r.NamespacedName())
+
+		a := eventually(ev)
+		if step.ExpectJQ.Consistently {
+			a = ev.Consistently()
+			if step.Timeout != nil {
+				a = a.WithTimeout(step.Timeout.Duration)
+			}
+		}
+
+		a.Should(gomega.And(matchers...))
+
+	case step.ExpectAbsent != nil:
+		r := step.ExpectAbsent.Resource
+
+		eventually(wt.Get(r.GroupVersionKind(), r.NamespacedName())).Should(
+			gomega.BeNil(),
+			"%s still exists", r,
+		)
+
+	case step.WaitCondition != nil:
+		r := step.WaitCondition.Resource
+
+		status := step.WaitCondition.Status
+		if status == "" {
+			status = "True"
+		}
+
+		matcher := jq.Match(`.status.conditions[] | select(.type == "%s") | .status == "%s"`,
+			step.WaitCondition.Type, status)
+		if step.WaitCondition.Reason != "" {
+			matcher = jq.Match(`.status.conditions[] | select(.type == "%s") | .status == "%s" and .reason == "%s"`,
+				step.WaitCondition.Type, status, step.WaitCondition.Reason)
+		}
+
+		eventually(wt.Get(r.GroupVersionKind(), r.NamespacedName())).Should(
+			gomega.And(gomega.Not(gomega.BeNil()), matcher),
+		)
+
+	default:
+		t.Fatalf("step %q has no action", step.String())
+	}
+}
+
+// expand substitutes the ${name} references to the variables in all the fields of the step.
+// References to unknown variables are left as they are, so that a jq expression can still use
+// its own $name variables. The step is always round-tripped through JSON, so that the objects
+// built in Go only hold JSON compatible values.
+func expand(step Step, vars map[string]string) (Step, error) {
+	data, err := json.Marshal(step)
+	if err != nil {
+		return step, fmt.Errorf("unable to marshal step: %w", err)
+	}
+
+	data = varRegexp.ReplaceAllFunc(data, func(ref []byte) []byte {
+		value, ok := vars[string(varRegexp.FindSubmatch(ref)[1])]
+		if !ok {
+			return ref
+		}
+
+		// the references are inside JSON strings, so the value is escaped and unquoted
+		quoted, err := json.Marshal(value)
+		if err != nil {
+			return ref
+		}
+
+		return quoted[1 : len(quoted)-1]
+	})
+
+	expanded := Step{}
+	if err := json.Unmarshal(data, &expanded); err != nil {
+		return step, fmt.Errorf("unable to expand variables of step: %w", err)
+	}
+
+	return expanded, nil
+}
+
+// mergeInto merges src into dst recursively, following the JSON merge patch semantics: maps are
+// merged, null values delete the corresponding field, any other value replaces the existing one.
+func mergeInto(dst map[string]any, src map[string]any) {
+	for k, v := range src {
+		if v == nil {
+			delete(dst, k)
+			continue
+		}
+
+		sm, ok := v.(map[string]any)
+		if !ok {
+			dst[k] = v
+			continue
+		}
+
+		dm, ok := dst[k].(map[string]any)
+		if !ok {
+			dm = make(map[string]any, len(sm))
+			dst[k] = dm
+		}
+
+		mergeInto(dm, sm)
+	}
+}
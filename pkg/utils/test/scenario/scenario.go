@@ -0,0 +1,322 @@
+// Package scenario provides a declarative DSL to write tests as a sequence of steps, e.g.
+// apply a resource, patch it, and expect some jq expressions to eventually match it. The
+// scenarios can be written in YAML or in Go, and are executed through testf.WithT against
+// either an envtest environment or a real cluster, depending on the client of the
+// testf.TestContext.
+//
+// A YAML scenario looks like:
+//
+//	name: dashboard enabled
+//	vars:
+//	  ns: opendatahub
+//	steps:
+//	- name: enable dashboard
+//	  patch:
+//	    resource: {apiVersion: datasciencecluster.opendatahub.io/v2, kind: DataScienceCluster, name: default-dsc}
+//	    merge:
+//	      spec: {components: {dashboard: {managementState: Managed}}}
+//	- wait-condition:
+//	    resource: {apiVersion: components.platform.opendatahub.io/v1alpha1, kind: Dashboard, name: default-dashboard}
+//	    type: Ready
+//	- expect-jq:
+//	    resource: {apiVersion: apps/v1, kind: Deployment, name: odh-dashboard, namespace: "${ns}"}
+//	    expressions:
+//	    - .status.readyReplicas > 0
+//
+// Variables are referenced as ${name} and substituted in all the fields of the steps.
+package scenario
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+)
+
+var varRegexp = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// Scenario is a named sequence of steps.
+type Scenario struct {
+	Name string `json:"name"`
+	// Vars are the default values of the variables referenced by the steps as ${name}.
+	Vars map[string]string `json:"vars,omitempty"`
+	// Steps run in order, the scenario stops at the first failing step.
+	Steps []Step `json:"steps"`
+	// Cleanup steps run once the scenario completes, whether it failed or not.
+	Cleanup []Step `json:"cleanup,omitempty"`
+}
+
+// Step is a single action of a scenario. Exactly one of the actions must be set.
+type Step struct {
+	Name string `json:"name,omitempty"`
+	// Timeout overrides the default Eventually timeout of the step.
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	Apply         *ApplyStep         `json:"apply,omitempty"`
+	Patch         *PatchStep         `json:"patch,omitempty"`
+	Delete        *DeleteStep        `json:"delete,omitempty"`
+	ExpectJQ      *ExpectJQStep      `json:"expect-jq,omitempty"`
+	ExpectAbsent  *ExpectAbsentStep  `json:"expect-absent,omitempty"`
+	WaitCondition *WaitConditionStep `json:"wait-condition,omitempty"`
+}
+
+// Resource references a resource of the cluster.
+type Resource struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+	Namespace  string `json:"namespace,omitempty"`
+}
+
+// ApplyStep creates the object, or merges it into the existing one.
+type ApplyStep struct {
+	Object map[string]any `json:"object"`
+}
+
+// PatchStep patches an existing resource, with a JSON merge patch or a jq transformation.
+type PatchStep struct {
+	Resource Resource       `json:"resource"`
+	Merge    map[string]any `json:"merge,omitempty"`
+	JQ       string         `json:"jq,omitempty"`
+}
+
+// DeleteStep deletes a resource, ignoring it if not found.
+type DeleteStep struct {
+	Resource Resource `json:"resource"`
+}
+
+// ExpectJQStep expects all the jq expressions to eventually, or consistently, match a resource.
+type ExpectJQStep struct {
+	Resource    Resource `json:"resource"`
+	Expressions []string `json:"expressions"`
+	// Consistently expects the expressions to match for the whole consistently duration.
+	Consistently bool `json:"consistently,omitempty"`
+}
+
+// ExpectAbsentStep expects a resource to eventually not exist, e.g. once garbage collected.
+type ExpectAbsentStep struct {
+	Resource Resource `json:"resource"`
+}
+
+// WaitConditionStep waits for a status condition of a resource to have the expected status,
+// and reason when set.
+type WaitConditionStep struct {
+	Resource Resource `json:"resource"`
+	Type     string   `json:"type"`
+	// Status defaults to True.
+	Status metav1.ConditionStatus `json:"status,omitempty"`
+	Reason string                 `json:"reason,omitempty"`
+}
+
+// Load reads a scenario from a YAML file.
+func Load(path string) (*Scenario, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read scenario %s: %w", path, err)
+	}
+
+	s, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid scenario %s: %w", path, err)
+	}
+
+	return s, nil
+}
+
+// Parse decodes and validates a YAML scenario.
+func Parse(data []byte) (*Scenario, error) {
+	s := Scenario{}
+	if err := yaml.UnmarshalStrict(data, &s); err != nil {
+		return nil, err
+	}
+
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+// Validate checks that the scenario is well formed.
+func (s *Scenario) Validate() error {
+	if s.Name == "" {
+		return errors.New("name is required")
+	}
+
+	if len(s.Steps) == 0 {
+		return errors.New("at least one step is required")
+	}
+
+	var errs []error
+
+	for i, step := range s.Steps {
+		if err := step.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("step %d (%s): %w", i+1, step.String(), err))
+		}
+	}
+
+	for i, step := range s.Cleanup {
+		if err := step.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("cleanup step %d (%s): %w", i+1, step.String(), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Validate checks that exactly one action is set, along with its required fields.
+func (s *Step) Validate() error {
+	var refs []Resource
+
+	actions := 0
+	if s.Apply != nil {
+		actions++
+		if len(s.Apply.Object) == 0 {
+			return errors.New("apply: object is required")
+		}
+	}
+	if s.Patch != nil {
+		actions++
+		if (len(s.Patch.Merge) == 0) == (s.Patch.JQ == "") {
+			return errors.New("patch: exactly one of merge or jq is required")
+		}
+		refs = append(refs, s.Patch.Resource)
+	}
+	if s.Delete != nil {
+		actions++
+		refs = append(refs, s.Delete.Resource)
+	}
+	if s.ExpectJQ != nil {
+		actions++
+		if len(s.ExpectJQ.Expressions) == 0 {
+			return errors.New("expect-jq: at least one expression is required")
+		}
+		refs = append(refs, s.ExpectJQ.Resource)
+	}
+	if s.ExpectAbsent != nil {
+		actions++
+		refs = append(refs, s.ExpectAbsent.Resource)
+	}
+	if s.WaitCondition != nil {
+		actions++
+		if s.WaitCondition.Type == "" {
+			return errors.New("wait-condition: type is required")
+		}
+		refs = append(refs, s.WaitCondition.Resource)
+	}
+
+	if actions != 1 {
+		return fmt.Errorf("exactly one action is required, got %d", actions)
+	}
+
+	for _, r := range refs {
+		if r.APIVersion == "" || r.Kind == "" || r.Name == "" {
+			return errors.New("resource: apiVersion, kind and name are required")
+		}
+	}
+
+	return nil
+}
+
+// String returns the name of the step, or a description of its action.
+func (s *Step) String() string {
+	if s.Name != "" {
+		return s.Name
+	}
+
+	switch {
+	case s.Apply != nil:
+		return fmt.Sprintf("apply %v %v", s.Apply.Object["kind"], s.Apply.Object["metadata"])
+	case s.Patch != nil:
+		return "patch " + s.Patch.Resource.String()
+	case s.Delete != nil:
+		return "delete " + s.Delete.Resource.String()
+	case s.ExpectJQ != nil:
+		return "expect-jq " + s.ExpectJQ.Resource.String()
+	case s.ExpectAbsent != nil:
+		return "expect-absent " + s.ExpectAbsent.Resource.String()
+	case s.WaitCondition != nil:
+		return fmt.Sprintf("wait-condition %s %s", s.WaitCondition.Type, s.WaitCondition.Resource.String())
+	default:
+		return "empty step"
+	}
+}
+
+// GroupVersionKind returns the GroupVersionKind of the resource.
+func (r Resource) GroupVersionKind() schema.GroupVersionKind {
+	return schema.FromAPIVersionAndKind(r.APIVersion, r.Kind)
+}
+
+// NamespacedName returns the namespace and name of the resource.
+func (r Resource) NamespacedName() types.NamespacedName {
+	return types.NamespacedName{Namespace: r.Namespace, Name: r.Name}
+}
+
+func (r Resource) String() string {
+	return fmt.Sprintf("%s %s", r.Kind, r.NamespacedName())
+}
+
+// Ref references the resource of the given GroupVersionKind, namespace and name.
+func Ref(gvk schema.GroupVersionKind, nn types.NamespacedName) Resource {
+	apiVersion, kind := gvk.ToAPIVersionAndKind()
+
+	return Resource{
+		APIVersion: apiVersion,
+		Kind:       kind,
+		Name:       nn.Name,
+		Namespace:  nn.Namespace,
+	}
+}
+
+// Apply creates the object, or merges it into the existing one.
+func Apply(obj map[string]any) Step {
+	return Step{Apply: &ApplyStep{Object: obj}}
+}
+
+// PatchMerge patches a resource with a JSON merge patch.
+func PatchMerge(r Resource, patch map[string]any) Step {
+	return Step{Patch: &PatchStep{Resource: r, Merge: patch}}
+}
+
+// PatchJQ patches a resource with a jq transformation, e.g. `.spec.replicas = 2`.
+func PatchJQ(r Resource, expression string) Step {
+	return Step{Patch: &PatchStep{Resource: r, JQ: expression}}
+}
+
+// Delete deletes a resource.
+func Delete(r Resource) Step {
+	return Step{Delete: &DeleteStep{Resource: r}}
+}
+
+// ExpectJQ expects all the jq expressions to eventually match a resource.
+func ExpectJQ(r Resource, expressions ...string) Step {
+	return Step{ExpectJQ: &ExpectJQStep{Resource: r, Expressions: expressions}}
+}
+
+// ExpectAbsent expects a resource to eventually not exist.
+func ExpectAbsent(r Resource) Step {
+	return Step{ExpectAbsent: &ExpectAbsentStep{Resource: r}}
+}
+
+// WaitCondition waits for a status condition of a resource to have the given status.
+func WaitCondition(r Resource, conditionType string, status metav1.ConditionStatus) Step {
+	return Step{WaitCondition: &WaitConditionStep{Resource: r, Type: conditionType, Status: status}}
+}
+
+// Named sets the name of the step.
+func (s Step) Named(name string) Step {
+	s.Name = name
+	return s
+}
+
+// WithTimeout overrides the default Eventually timeout of the step.
+func (s Step) WithTimeout(d time.Duration) Step {
+	s.Timeout = &metav1.Duration{Duration: d}
+	return s
+}
@@ -0,0 +1,203 @@
+package scenario_test
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/envt"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/fakeclient"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/scenario"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/testf"
+
+	. "github.com/onsi/gomega"
+)
+
+const configMapScenario = `
+name: config map lifecycle
+vars:
+  ns: default
+  value: bar
+steps:
+- name: create
+  apply:
+    object:
+      apiVersion: v1
+      kind: ConfigMap
+      metadata:
+        name: foo
+        namespace: "${ns}"
+      data:
+        foo: "${value}"
+        removed: "yes"
+- name: patch merge
+  patch:
+    resource: {apiVersion: v1, kind: ConfigMap, name: foo, namespace: "${ns}"}
+    merge:
+      data:
+        removed: null
+        merged: "true"
+- name: patch jq
+  patch:
+    resource: {apiVersion: v1, kind: ConfigMap, name: foo, namespace: "${ns}"}
+    jq: .data.transformed = "${value}"
+- name: expect data
+  expect-jq:
+    resource: {apiVersion: v1, kind: ConfigMap, name: foo, namespace: "${ns}"}
+    expressions:
+    - .data.foo == "${value}"
+    - .data.merged == "true"
+    - .data.transformed == "${value}"
+    - .data | has("removed") | not
+- wait-condition:
+    resource: {apiVersion: v1, kind: Pod, name: pod, namespace: "${ns}"}
+    type: Ready
+- name: delete
+  delete:
+    resource: {apiVersion: v1, kind: ConfigMap, name: foo, namespace: "${ns}"}
+- expect-absent:
+    resource: {apiVersion: v1, kind: ConfigMap, name: foo, namespace: "${ns}"}
+`
+
+// readyPod is the pod the scenario waits to be ready.
+func readyPod() *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pod"},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "app", Image: "app"}},
+		},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+		},
+	}
+}
+
+func newTestContext(t *testing.T, cl client.Client) *testf.TestContext {
+	t.Helper()
+
+	g := NewWithT(t)
+
+	tc, err := testf.NewTestContext(
+		testf.WithClient(cl),
+		testf.WithTOptions(testf.WithEventuallyTimeout(2*time.Second)),
+	)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	return tc
+}
+
+func newFakeTestContext(t *testing.T) *testf.TestContext {
+	t.Helper()
+
+	cl, err := fakeclient.New(fakeclient.WithObjects(readyPod()))
+	NewWithT(t).Expect(err).ShouldNot(HaveOccurred())
+
+	return newTestContext(t, cl)
+}
+
+func TestParse(t *testing.T) {
+	g := NewWithT(t)
+
+	s, err := scenario.Parse([]byte(configMapScenario))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(s.Name).Should(Equal("config map lifecycle"))
+	g.Expect(s.Steps).Should(HaveLen(7))
+	g.Expect(s.Steps[4].String()).Should(Equal("wait-condition Ready Pod ${ns}/pod"))
+
+	_, err = scenario.Parse([]byte(`
+name: invalid
+steps:
+- name: two actions
+  delete: {resource: {apiVersion: v1, kind: ConfigMap, name: foo}}
+  expect-absent: {resource: {apiVersion: v1, kind: ConfigMap, name: foo}}
+- name: no resource name
+  delete: {resource: {apiVersion: v1, kind: ConfigMap}}
+- name: merge and jq
+  patch: {resource: {apiVersion: v1, kind: ConfigMap, name: foo}, jq: ., merge: {data: {}}}
+`))
+	g.Expect(err).Should(HaveOccurred())
+	g.Expect(err.Error()).Should(And(
+		ContainSubstring("step 1 (two actions): exactly one action is required, got 2"),
+		ContainSubstring("step 2 (no resource name): resource: apiVersion, kind and name are required"),
+		ContainSubstring("step 3 (merge and jq): patch: exactly one of merge or jq is required"),
+	))
+
+	_, err = scenario.Parse([]byte("name: unknown\nsteps:\n- unknown: {}\n"))
+	g.Expect(err).Should(HaveOccurred())
+}
+
+func TestRun(t *testing.T) {
+	g := NewWithT(t)
+
+	tc := newFakeTestContext(t)
+
+	s, err := scenario.Parse([]byte(configMapScenario))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	s.Run(t, tc, scenario.WithVar("value", "baz"))
+}
+
+// TestRunEnvTest runs the scenario against the API server of an envtest environment.
+func TestRunEnvTest(t *testing.T) {
+	g := NewWithT(t)
+
+	envTest, err := envt.New()
+	g.Expect(err).NotTo(HaveOccurred())
+	t.Cleanup(func() {
+		_ = envTest.Stop()
+	})
+
+	cl := envTest.Client()
+
+	// the status of the pod is set by the kubelet, which envtest does not run
+	pod := readyPod()
+	status := pod.Status
+	g.Expect(cl.Create(t.Context(), pod)).To(Succeed())
+	pod.Status = status
+	g.Expect(cl.Status().Update(t.Context(), pod)).To(Succeed())
+
+	s, err := scenario.Parse([]byte(configMapScenario))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	s.Run(t, newTestContext(t, cl), scenario.WithVar("value", "baz"))
+}
+
+func TestRunGo(t *testing.T) {
+	g := NewWithT(t)
+
+	tc := newFakeTestContext(t)
+	nn := types.NamespacedName{Namespace: "default", Name: "${name}"}
+	cm := scenario.Ref(gvk.ConfigMap, nn)
+
+	s := scenario.Scenario{
+		Name: "go",
+		Vars: map[string]string{"name": "foo"},
+		Steps: []scenario.Step{
+			scenario.Apply(map[string]any{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata":   map[string]any{"name": nn.Name, "namespace": nn.Namespace},
+				"data":       map[string]any{"foo": "bar"},
+			}),
+			scenario.PatchJQ(cm, `.data.foo = "baz"`).Named("patch"),
+			scenario.ExpectJQ(cm, `.data.foo == "baz"`).WithTimeout(time.Second),
+		},
+		Cleanup: []scenario.Step{
+			scenario.Delete(cm),
+		},
+	}
+
+	g.Expect(s.Validate()).Should(Succeed())
+
+	t.Run("scenario", func(t *testing.T) {
+		s.Run(t, tc)
+	})
+
+	// the cleanup steps run once the scenario completes
+	tc.NewWithT(t).Get(gvk.ConfigMap, types.NamespacedName{Namespace: "default", Name: "foo"}).Eventually().Should(BeNil())
+}
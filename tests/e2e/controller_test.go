@@ -85,6 +85,7 @@ type TestContextConfig struct {
 	webhookTest            bool
 	v2tov3upgradeTest      bool
 	hardwareProfileTest    bool
+	scenariosTest          bool
 	TestTimeouts           TestTimeouts
 }
 
@@ -318,6 +319,12 @@ func TestOdhOperator(t *testing.T) {
 		mustRun(t, "Hardware Profile E2E Tests", hardwareProfileTestSuite)
 		mustRun(t, "Hardware Profile Workload E2E Tests", hardwareProfileWorkloadTestSuite)
 	}
+
+	// Run declarative scenarios
+	if testOpts.scenariosTest {
+		mustRun(t, "Scenarios E2E Tests", scenariosTestSuite)
+	}
+
 	// Deletion logic based on deletionPolicy
 	switch testOpts.deletionPolicy {
 	case DeletionPolicyAlways:
@@ -393,6 +400,8 @@ func TestMain(m *testing.M) {
 	checkEnvVarBindingError(viper.BindEnv("test-operator-v2tov3upgrade", viper.GetEnvPrefix()+"_OPERATOR_V2TOV3UPGRADE"))
 	pflag.Bool("test-hardware-profile", true, "run hardware profile tests")
 	checkEnvVarBindingError(viper.BindEnv("test-hardware-profile", viper.GetEnvPrefix()+"_HARDWARE_PROFILE"))
+	pflag.Bool("test-scenarios", true, "run the declarative scenarios of the scenarios directory")
+	checkEnvVarBindingError(viper.BindEnv("test-scenarios", viper.GetEnvPrefix()+"_SCENARIOS"))
 	pflag.Bool("test-webhook", true, "run webhook tests")
 	checkEnvVarBindingError(viper.BindEnv("test-webhook", viper.GetEnvPrefix()+"_WEBHOOK"))
 
@@ -446,6 +455,7 @@ func TestMain(m *testing.M) {
 	testOpts.operatorResilienceTest = viper.GetBool("test-operator-resilience")
 	testOpts.v2tov3upgradeTest = viper.GetBool("test-operator-v2tov3upgrade")
 	testOpts.hardwareProfileTest = viper.GetBool("test-hardware-profile")
+	testOpts.scenariosTest = viper.GetBool("test-scenarios")
 	testOpts.webhookTest = viper.GetBool("test-webhook")
 	Components.enabled = viper.GetBool("test-components")
 	Components.flags = viper.GetStringSlice("test-component")
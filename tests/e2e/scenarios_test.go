@@ -0,0 +1,51 @@
+package e2e_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/utils/test/scenario"
+)
+
+// scenariosDir holds the declarative scenarios, see the scenario package for their format.
+const scenariosDir = "scenarios"
+
+// scenariosTestSuite runs the declarative scenarios, each one as a test case. The scenarios
+// can reference the namespaces and the names of the DSCI and DSC as variables.
+func scenariosTestSuite(t *testing.T) {
+	t.Helper()
+
+	tc, err := NewTestContext(t)
+	require.NoError(t, err)
+
+	paths, err := filepath.Glob(filepath.Join(scenariosDir, "*.yaml"))
+	require.NoError(t, err)
+
+	vars := map[string]string{
+		"operatorNamespace":    tc.OperatorNamespace,
+		"appsNamespace":        tc.AppsNamespace,
+		"workbenchesNamespace": tc.WorkbenchesNamespace,
+		"monitoringNamespace":  tc.MonitoringNamespace,
+		"dsciName":             tc.DSCInitializationNamespacedName.Name,
+		"dscName":              tc.DataScienceClusterNamespacedName.Name,
+	}
+
+	testCases := make([]TestCase, 0, len(paths))
+
+	for _, path := range paths {
+		s, err := scenario.Load(path)
+		require.NoError(t, err)
+
+		testCases = append(testCases, TestCase{
+			name: s.Name,
+			testFn: func(t *testing.T) {
+				t.Helper()
+				s.Run(t, tc.TestContext, scenario.WithVars(vars))
+			},
+		})
+	}
+
+	RunTestCases(t, testCases)
+}